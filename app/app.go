@@ -111,7 +111,9 @@ import (
 	"github.com/0glabs/0g-chain/app/ante"
 	chainparams "github.com/0glabs/0g-chain/app/params"
 	"github.com/0glabs/0g-chain/chaincfg"
+	bankprecompile "github.com/0glabs/0g-chain/precompiles/bank"
 	dasignersprecompile "github.com/0glabs/0g-chain/precompiles/dasigners"
+	stakingprecompile "github.com/0glabs/0g-chain/precompiles/staking"
 
 	"github.com/0glabs/0g-chain/x/bep3"
 	bep3keeper "github.com/0glabs/0g-chain/x/bep3/keeper"
@@ -204,6 +206,7 @@ var (
 		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:             {authtypes.Burner},
+		committeetypes.ModuleName:       {authtypes.Burner}, // escrows the proposal deposits
 		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
 		evmtypes.ModuleName:             {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
 		evmutiltypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
@@ -510,7 +513,7 @@ func NewApp(
 	)
 
 	// dasigners keeper
-	app.dasignersKeeper = dasignerskeeper.NewKeeper(keys[dasignerstypes.StoreKey], appCodec, app.stakingKeeper, govAuthAddrStr)
+	app.dasignersKeeper = dasignerskeeper.NewKeeper(keys[dasignerstypes.StoreKey], appCodec, app.stakingKeeper, app.bankKeeper, govAuthAddrStr)
 	// precopmiles
 	precompiles := make(map[common.Address]vm.PrecompiledContract)
 	daSignersPrecompile, err := dasignersprecompile.NewDASignersPrecompile(app.dasignersKeeper)
@@ -518,6 +521,16 @@ func NewApp(
 		panic("initialize precompile failed")
 	}
 	precompiles[daSignersPrecompile.Address()] = daSignersPrecompile
+	stakingPrecompile, err := stakingprecompile.NewStakingPrecompile(app.stakingKeeper, app.distrKeeper)
+	if err != nil {
+		panic("initialize precompile failed")
+	}
+	precompiles[stakingPrecompile.Address()] = stakingPrecompile
+	bankPrecompile, err := bankprecompile.NewBankPrecompile(app.precisebankKeeper, app.bankKeeper)
+	if err != nil {
+		panic("initialize precompile failed")
+	}
+	precompiles[bankPrecompile.Address()] = bankPrecompile
 
 	app.evmKeeper = evmkeeper.NewKeeper(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey],
@@ -647,6 +660,10 @@ app.WasmKeeper = wasmKeeper
 		govAuthAddrStr,
 	)
 
+	app.CouncilKeeper = councilkeeper.NewKeeper(
+		keys[counciltypes.StoreKey], appCodec, app.stakingKeeper,
+	)
+
 	// create committee keeper with router
 	committeeGovRouter := govv1beta1.NewRouter()
 	committeeGovRouter.
@@ -659,9 +676,13 @@ app.WasmKeeper = wasmKeeper
 		appCodec,
 		keys[committeetypes.StoreKey],
 		committeeGovRouter,
+		app.MsgServiceRouter(),
 		app.paramsKeeper,
 		app.accountKeeper,
 		app.bankKeeper,
+		app.CouncilKeeper,
+		app.stakingKeeper,
+		govAuthAddrStr,
 	)
 
 	// register the staking hooks
@@ -669,6 +690,8 @@ app.WasmKeeper = wasmKeeper
 		stakingtypes.NewMultiStakingHooks(
 			app.distrKeeper.Hooks(),
 			app.slashingKeeper.Hooks(),
+			app.dasignersKeeper.Hooks(),
+			app.CouncilKeeper.Hooks(),
 		))
 
 	// create gov keeper with router
@@ -701,10 +724,6 @@ app.WasmKeeper = wasmKeeper
 	)
 	app.govKeeper.SetTallyHandler(tallyHandler)
 
-	app.CouncilKeeper = councilkeeper.NewKeeper(
-		keys[counciltypes.StoreKey], appCodec, app.stakingKeeper,
-	)
-
 	// create the module manager (Note: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.)
 	app.mm = module.NewManager(
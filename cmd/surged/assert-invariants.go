@@ -33,6 +33,9 @@ func AssertInvariantsCmd(config params.EncodingConfig) *cobra.Command {
 				return fmt.Errorf("failed to marshal app state from genesis doc: %s: %w", importGenesis, err)
 			}
 			err = app.ModuleBasics.ValidateGenesis(config.Marshaler, config.TxConfig, newAppState)
+			if err == nil {
+				err = validateGenesisWithChainID(app.ModuleBasics, config.Marshaler, newAppState, genDoc.ChainID)
+			}
 			if err != nil {
 				return fmt.Errorf("genesis doc did not pass validate genesis: %s: %w", importGenesis, err)
 			}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/spf13/cobra"
+)
+
+// chainGenesisValidator is implemented by the modules whose genesis state is bound to the chain ID.
+type chainGenesisValidator interface {
+	ValidateGenesisWithChainID(cdc codec.JSONCodec, bz json.RawMessage, chainID string) error
+}
+
+// ValidateGenesisCmd extends the validate-genesis command of genutil with the validation against the chain ID of the
+// genesis doc, which is not passed to the modules by the basic manager.
+func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := genutilcli.ValidateGenesisCmd(mbm)
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		genesis := server.GetServerContextFromCmd(cmd).Config.GenesisFile()
+		if len(args) > 0 {
+			genesis = args[0]
+		}
+		genDoc, err := validateGenDoc(genesis)
+		if err != nil {
+			return err
+		}
+		var genState map[string]json.RawMessage
+		if err := json.Unmarshal(genDoc.AppState, &genState); err != nil {
+			return fmt.Errorf("error unmarshalling genesis doc %s: %w", genesis, err)
+		}
+		cdc := client.GetClientContextFromCmd(cmd).Codec
+		if err := validateGenesisWithChainID(mbm, cdc, genState, genDoc.ChainID); err != nil {
+			return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+		}
+		return runE(cmd, args)
+	}
+	return cmd
+}
+
+// validateGenesisWithChainID validates the genesis states of the modules bound to the chain ID.
+func validateGenesisWithChainID(mbm module.BasicManager, cdc codec.JSONCodec, genState map[string]json.RawMessage, chainID string) error {
+	for name, b := range mbm {
+		validator, ok := b.(chainGenesisValidator)
+		if !ok {
+			continue
+		}
+		bz, ok := genState[name]
+		if !ok {
+			continue
+		}
+		if err := validator.ValidateGenesisWithChainID(cdc, bz, chainID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
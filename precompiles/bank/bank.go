@@ -0,0 +1,59 @@
+package bank
+
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	precisebankkeeper "github.com/0glabs/0g-chain/x/precisebank/keeper"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	PrecompileAddress = "0x0000000000000000000000000000000000001002"
+
+	BankFunctionBalanceOf         = "balanceOf"
+	BankFunctionExtendedBalanceOf = "extendedBalanceOf"
+	BankFunctionExtendedDenom     = "extendedDenom"
+	BankFunctionTransfer          = "transfer"
+)
+
+var RequiredGasBasic = map[string]uint64{
+	BankFunctionBalanceOf:         1000,
+	BankFunctionExtendedBalanceOf: 1000,
+	BankFunctionExtendedDenom:     100,
+	BankFunctionTransfer:          5000,
+}
+
+// KVGasConfig charges the store accesses with the default costs of the cosmos transactions, so that reading and
+// moving coins through the precompile costs as much as it does through the bank module.
+var KVGasConfig storetypes.GasConfig = storetypes.KVGasConfig()
+
+var _ vm.PrecompiledContract = &BankPrecompile{}
+
+// BankPrecompile exposes the coin balances of the accounts and lets the caller transfer its coins, the extended
+// denom is backed by x/precisebank with 18 decimals.
+type BankPrecompile struct {
+	*precopmiles_common.Precompile
+	precisebankKeeper precisebankkeeper.Keeper
+	bankKeeper        bankkeeper.Keeper
+}
+
+func NewBankPrecompile(precisebankKeeper precisebankkeeper.Keeper, bankKeeper bankkeeper.Keeper) (*BankPrecompile, error) {
+	b := &BankPrecompile{
+		precisebankKeeper: precisebankKeeper,
+		bankKeeper:        bankKeeper,
+	}
+	methods := map[string]precopmiles_common.Method{
+		BankFunctionBalanceOf:         precopmiles_common.NewQueryMethod(RequiredGasBasic[BankFunctionBalanceOf], b.BalanceOf),
+		BankFunctionExtendedBalanceOf: precopmiles_common.NewQueryMethod(RequiredGasBasic[BankFunctionExtendedBalanceOf], b.ExtendedBalanceOf),
+		BankFunctionExtendedDenom:     precopmiles_common.NewQueryMethod(RequiredGasBasic[BankFunctionExtendedDenom], b.ExtendedDenom),
+		BankFunctionTransfer:          precopmiles_common.NewTxMethod(RequiredGasBasic[BankFunctionTransfer], b.Transfer),
+	}
+	precompile, err := precopmiles_common.NewPrecompile(common.HexToAddress(PrecompileAddress), BankABI, KVGasConfig, methods)
+	if err != nil {
+		return nil, err
+	}
+	b.Precompile = precompile
+	return b, nil
+}
@@ -0,0 +1,198 @@
+package bank_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	bankprecompile "github.com/0glabs/0g-chain/precompiles/bank"
+	precompiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	"github.com/0glabs/0g-chain/precompiles/testutil"
+	precisebanktypes "github.com/0glabs/0g-chain/x/precisebank/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/suite"
+)
+
+type BankTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	abi    abi.ABI
+	bank   *bankprecompile.BankPrecompile
+	sender *testutil.TestSigner
+}
+
+func (suite *BankTestSuite) SetupTest() {
+	suite.PrecompileTestSuite.SetupTest()
+
+	precompile, ok := suite.EvmKeeper.GetPrecompiles()[common.HexToAddress(bankprecompile.PrecompileAddress)]
+	suite.Require().True(ok)
+	suite.bank = precompile.(*bankprecompile.BankPrecompile)
+	abi, err := abi.JSON(strings.NewReader(bankprecompile.BankABI))
+	suite.Require().NoError(err)
+	suite.abi = abi
+
+	// the gas denom is the extended denom, so that transfers change the balances cached by the StateDB
+	params := suite.EvmKeeper.GetParams(suite.Ctx)
+	params.EvmDenom = precisebanktypes.ExtendedCoinDenom
+	suite.Require().NoError(suite.EvmKeeper.SetParams(suite.Ctx, params))
+
+	suite.sender = testutil.GenSigner()
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, suite.sender.Addr.Bytes(), sdk.NewCoins(
+		sdk.NewInt64Coin(precisebanktypes.IntegerCoinDenom, 10),
+		sdk.NewInt64Coin("test", 1000),
+	)))
+}
+
+func (suite *BankTestSuite) runTx(input []byte, signer *testutil.TestSigner, gas uint64) ([]byte, error) {
+	return suite.RunPrecompile(suite.bank, input, signer, signer.Addr, gas, false)
+}
+
+func (suite *BankTestSuite) call(signer *testutil.TestSigner, name string, args ...interface{}) []interface{} {
+	input, err := suite.abi.Pack(name, args...)
+	suite.Require().NoError(err)
+	bz, err := suite.runTx(input, signer, 10000000)
+	suite.Require().NoError(err)
+	out, err := suite.abi.Methods[name].Outputs.Unpack(bz)
+	suite.Require().NoError(err)
+	return out
+}
+
+func (suite *BankTestSuite) Test_Query() {
+	out := suite.call(suite.sender, "extendedDenom")
+	suite.Assert().Equal(precisebanktypes.ExtendedCoinDenom, out[0])
+
+	integer := precisebanktypes.ConversionFactor().MulRaw(10).BigInt()
+	out = suite.call(suite.sender, "extendedBalanceOf", suite.sender.Addr)
+	suite.Assert().Equal(integer, out[0])
+	out = suite.call(suite.sender, "balanceOf", suite.sender.Addr, precisebanktypes.ExtendedCoinDenom)
+	suite.Assert().Equal(integer, out[0])
+	out = suite.call(suite.sender, "balanceOf", suite.sender.Addr, precisebanktypes.IntegerCoinDenom)
+	suite.Assert().Equal(big.NewInt(10), out[0])
+	out = suite.call(suite.sender, "balanceOf", suite.sender.Addr, "test")
+	suite.Assert().Equal(big.NewInt(1000), out[0])
+	out = suite.call(suite.sender, "balanceOf", common.Address{}, "test")
+	suite.Assert().Zero(out[0].(*big.Int).Sign())
+
+	input, err := suite.abi.Pack("balanceOf", suite.sender.Addr, "!")
+	suite.Require().NoError(err)
+	_, err = suite.runTx(input, suite.sender, 10000000)
+	suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+}
+
+func (suite *BankTestSuite) Test_TransferExtended() {
+	// the account is dirty in the StateDB, so its cached balance is written back on commit
+	suite.Statedb.SetNonce(suite.sender.Addr, 1)
+	recipient := testutil.GenSigner().Addr
+
+	conversion := precisebanktypes.ConversionFactor()
+	amount := conversion.MulRaw(3).AddRaw(conversion.QuoRaw(2).Int64())
+	out := suite.call(suite.sender, "transfer", recipient, precisebanktypes.ExtendedCoinDenom, amount.BigInt())
+	suite.Assert().Equal(true, out[0])
+
+	logs := suite.Statedb.Logs()
+	suite.Require().Len(logs, 1)
+	suite.Assert().Equal(common.BytesToHash(suite.sender.Addr.Bytes()), logs[0].Topics[1])
+	suite.Assert().Equal(common.BytesToHash(recipient.Bytes()), logs[0].Topics[2])
+	event, err := suite.abi.Unpack(bankprecompile.TransferEvent, logs[0].Data)
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]interface{}{precisebanktypes.ExtendedCoinDenom, amount.BigInt()}, event)
+
+	remaining := conversion.MulRaw(10).Sub(amount)
+	out = suite.call(suite.sender, "extendedBalanceOf", suite.sender.Addr)
+	suite.Assert().Equal(remaining.BigInt(), out[0])
+	out = suite.call(suite.sender, "extendedBalanceOf", recipient)
+	suite.Assert().Equal(amount.BigInt(), out[0])
+
+	// the StateDB keeps the balances changed by the transfer
+	suite.Assert().Equal(remaining.BigInt(), suite.Statedb.GetBalance(suite.sender.Addr))
+	suite.Assert().Equal(amount.BigInt(), suite.Statedb.GetBalance(recipient))
+	suite.Require().NoError(suite.Statedb.Commit())
+	suite.Assert().Equal(remaining, suite.App.GetPrecisebankKeeper().GetBalance(suite.Ctx, suite.sender.Addr.Bytes(), precisebanktypes.ExtendedCoinDenom).Amount)
+}
+
+func (suite *BankTestSuite) Test_Transfer() {
+	recipient := testutil.GenSigner().Addr
+	suite.call(suite.sender, "transfer", recipient, "test", big.NewInt(400))
+	out := suite.call(suite.sender, "balanceOf", suite.sender.Addr, "test")
+	suite.Assert().Equal(big.NewInt(600), out[0])
+	out = suite.call(suite.sender, "balanceOf", recipient, "test")
+	suite.Assert().Equal(big.NewInt(400), out[0])
+
+	testCases := []struct {
+		name   string
+		to     common.Address
+		denom  string
+		amount *big.Int
+		err    *errorsmod.Error
+	}{
+		{"insufficient funds", recipient, "test", big.NewInt(601), sdkerrors.ErrInsufficientFunds},
+		{"zero amount", recipient, "test", big.NewInt(0), sdkerrors.ErrInvalidCoins},
+		{"invalid denom", recipient, "!", big.NewInt(1), sdkerrors.ErrInvalidCoins},
+		{"module account", common.BytesToAddress(suite.App.GetAccountKeeper().GetModuleAddress(precisebanktypes.ModuleName)), precisebanktypes.ExtendedCoinDenom, big.NewInt(1), sdkerrors.ErrUnauthorized},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			input, err := suite.abi.Pack("transfer", tc.to, tc.denom, tc.amount)
+			suite.Require().NoError(err)
+			ret, err := suite.runTx(input, suite.sender, 10000000)
+			suite.AssertCosmosRevert(ret, err, tc.err)
+		})
+	}
+}
+
+func (suite *BankTestSuite) Test_GasAndStaticCall() {
+	recipient := testutil.GenSigner().Addr
+	input, err := suite.abi.Pack("transfer", recipient, "test", big.NewInt(1))
+	suite.Require().NoError(err)
+
+	// the store accesses are charged on top of the basic gas
+	_, err = suite.runTx(input, suite.sender, 1000)
+	suite.Require().ErrorIs(err, vm.ErrOutOfGas)
+
+	ret, err := suite.RunPrecompile(suite.bank, input, suite.sender, suite.sender.Addr, 10000000, true)
+	suite.AssertRevert(ret, err, precompiles_common.ErrWriteProtection("transfer"))
+}
+
+func (suite *BankTestSuite) Test_ContractCaller() {
+	// the contract transfers its own funds, the account is dirty in the StateDB once deployed
+	caller := testutil.GenSigner().Addr
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, caller.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(precisebanktypes.IntegerCoinDenom, 10))))
+	suite.DeployCaller(suite.bank, caller)
+	recipient := testutil.GenSigner().Addr
+
+	amount := precisebanktypes.ConversionFactor().MulRaw(3)
+	input, err := suite.abi.Pack("transfer", recipient, precisebanktypes.ExtendedCoinDenom, amount.BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.RunContract(caller, input, suite.sender, 10000000)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(precisebanktypes.ConversionFactor().MulRaw(7).BigInt(), suite.Statedb.GetBalance(caller))
+	suite.Assert().Equal(amount.BigInt(), suite.Statedb.GetBalance(recipient))
+	suite.Require().NoError(suite.Statedb.Commit())
+
+	precisebankKeeper := suite.App.GetPrecisebankKeeper()
+	suite.Assert().Equal(precisebanktypes.ConversionFactor().MulRaw(7), precisebankKeeper.GetBalance(suite.Ctx, caller.Bytes(), precisebanktypes.ExtendedCoinDenom).Amount)
+	suite.Assert().Equal(amount, precisebankKeeper.GetBalance(suite.Ctx, recipient.Bytes(), precisebanktypes.ExtendedCoinDenom).Amount)
+
+	// no coin is transferred if the contract reverts
+	suite.DeployRevertingCaller(suite.bank, caller)
+	recipient = testutil.GenSigner().Addr
+	input, err = suite.abi.Pack("transfer", recipient, precisebanktypes.ExtendedCoinDenom, amount.BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.RunContract(caller, input, suite.sender, 10000000)
+	suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+	suite.Assert().Equal(precisebanktypes.ConversionFactor().MulRaw(7).BigInt(), suite.Statedb.GetBalance(caller))
+	suite.Assert().Zero(suite.Statedb.GetBalance(recipient).Sign())
+	suite.Require().NoError(suite.Statedb.Commit())
+
+	suite.Assert().Equal(precisebanktypes.ConversionFactor().MulRaw(7), precisebankKeeper.GetBalance(suite.Ctx, caller.Bytes(), precisebanktypes.ExtendedCoinDenom).Amount)
+	suite.Assert().True(precisebankKeeper.GetBalance(suite.Ctx, recipient.Bytes(), precisebanktypes.ExtendedCoinDenom).IsZero())
+}
+
+func TestBankSuite(t *testing.T) {
+	suite.Run(t, new(BankTestSuite))
+}
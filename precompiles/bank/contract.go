@@ -0,0 +1,449 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bank
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// BankMetaData contains all meta data concerning the Bank contract.
+var BankMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"codespace\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"code\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"message\",\"type\":\"string\"}],\"name\":\"CosmosError\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"GetStateDBFailed\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"reason\",\"type\":\"string\"}],\"name\":\"InvalidArguments\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"selector\",\"type\":\"bytes4\"}],\"name\":\"InvalidMethod\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"expected\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"got\",\"type\":\"uint256\"}],\"name\":\"InvalidNumberOfArgs\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"method\",\"type\":\"string\"}],\"name\":\"WriteProtection\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"extendedBalanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"extendedDenom\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// BankABI is the input ABI used to generate the binding from.
+// Deprecated: Use BankMetaData.ABI instead.
+var BankABI = BankMetaData.ABI
+
+// Bank is an auto generated Go binding around an Ethereum contract.
+type Bank struct {
+	BankCaller     // Read-only binding to the contract
+	BankTransactor // Write-only binding to the contract
+	BankFilterer   // Log filterer for contract events
+}
+
+// BankCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BankCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BankTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BankTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BankFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BankFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BankSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BankSession struct {
+	Contract     *Bank             // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BankCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BankCallerSession struct {
+	Contract *BankCaller   // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// BankTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BankTransactorSession struct {
+	Contract     *BankTransactor   // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BankRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BankRaw struct {
+	Contract *Bank // Generic contract binding to access the raw methods on
+}
+
+// BankCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BankCallerRaw struct {
+	Contract *BankCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BankTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BankTransactorRaw struct {
+	Contract *BankTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBank creates a new instance of Bank, bound to a specific deployed contract.
+func NewBank(address common.Address, backend bind.ContractBackend) (*Bank, error) {
+	contract, err := bindBank(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Bank{BankCaller: BankCaller{contract: contract}, BankTransactor: BankTransactor{contract: contract}, BankFilterer: BankFilterer{contract: contract}}, nil
+}
+
+// NewBankCaller creates a new read-only instance of Bank, bound to a specific deployed contract.
+func NewBankCaller(address common.Address, caller bind.ContractCaller) (*BankCaller, error) {
+	contract, err := bindBank(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BankCaller{contract: contract}, nil
+}
+
+// NewBankTransactor creates a new write-only instance of Bank, bound to a specific deployed contract.
+func NewBankTransactor(address common.Address, transactor bind.ContractTransactor) (*BankTransactor, error) {
+	contract, err := bindBank(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BankTransactor{contract: contract}, nil
+}
+
+// NewBankFilterer creates a new log filterer instance of Bank, bound to a specific deployed contract.
+func NewBankFilterer(address common.Address, filterer bind.ContractFilterer) (*BankFilterer, error) {
+	contract, err := bindBank(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BankFilterer{contract: contract}, nil
+}
+
+// bindBank binds a generic wrapper to an already deployed contract.
+func bindBank(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(BankABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Bank *BankRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Bank.Contract.BankCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Bank *BankRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Bank.Contract.BankTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Bank *BankRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Bank.Contract.BankTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Bank *BankCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Bank.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Bank *BankTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Bank.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Bank *BankTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Bank.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0xb9b092c8.
+//
+// Solidity: function balanceOf(address account, string denom) view returns(uint256 balance)
+func (_Bank *BankCaller) BalanceOf(opts *bind.CallOpts, account common.Address, denom string) (*big.Int, error) {
+	var out []interface{}
+	err := _Bank.contract.Call(opts, &out, "balanceOf", account, denom)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0xb9b092c8.
+//
+// Solidity: function balanceOf(address account, string denom) view returns(uint256 balance)
+func (_Bank *BankSession) BalanceOf(account common.Address, denom string) (*big.Int, error) {
+	return _Bank.Contract.BalanceOf(&_Bank.CallOpts, account, denom)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0xb9b092c8.
+//
+// Solidity: function balanceOf(address account, string denom) view returns(uint256 balance)
+func (_Bank *BankCallerSession) BalanceOf(account common.Address, denom string) (*big.Int, error) {
+	return _Bank.Contract.BalanceOf(&_Bank.CallOpts, account, denom)
+}
+
+// ExtendedBalanceOf is a free data retrieval call binding the contract method 0xa1d04c6c.
+//
+// Solidity: function extendedBalanceOf(address account) view returns(uint256 balance)
+func (_Bank *BankCaller) ExtendedBalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Bank.contract.Call(opts, &out, "extendedBalanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExtendedBalanceOf is a free data retrieval call binding the contract method 0xa1d04c6c.
+//
+// Solidity: function extendedBalanceOf(address account) view returns(uint256 balance)
+func (_Bank *BankSession) ExtendedBalanceOf(account common.Address) (*big.Int, error) {
+	return _Bank.Contract.ExtendedBalanceOf(&_Bank.CallOpts, account)
+}
+
+// ExtendedBalanceOf is a free data retrieval call binding the contract method 0xa1d04c6c.
+//
+// Solidity: function extendedBalanceOf(address account) view returns(uint256 balance)
+func (_Bank *BankCallerSession) ExtendedBalanceOf(account common.Address) (*big.Int, error) {
+	return _Bank.Contract.ExtendedBalanceOf(&_Bank.CallOpts, account)
+}
+
+// ExtendedDenom is a free data retrieval call binding the contract method 0x5a716fe4.
+//
+// Solidity: function extendedDenom() view returns(string)
+func (_Bank *BankCaller) ExtendedDenom(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Bank.contract.Call(opts, &out, "extendedDenom")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// ExtendedDenom is a free data retrieval call binding the contract method 0x5a716fe4.
+//
+// Solidity: function extendedDenom() view returns(string)
+func (_Bank *BankSession) ExtendedDenom() (string, error) {
+	return _Bank.Contract.ExtendedDenom(&_Bank.CallOpts)
+}
+
+// ExtendedDenom is a free data retrieval call binding the contract method 0x5a716fe4.
+//
+// Solidity: function extendedDenom() view returns(string)
+func (_Bank *BankCallerSession) ExtendedDenom() (string, error) {
+	return _Bank.Contract.ExtendedDenom(&_Bank.CallOpts)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xfff3a01b.
+//
+// Solidity: function transfer(address to, string denom, uint256 amount) returns(bool)
+func (_Bank *BankTransactor) Transfer(opts *bind.TransactOpts, to common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Bank.contract.Transact(opts, "transfer", to, denom, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xfff3a01b.
+//
+// Solidity: function transfer(address to, string denom, uint256 amount) returns(bool)
+func (_Bank *BankSession) Transfer(to common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Bank.Contract.Transfer(&_Bank.TransactOpts, to, denom, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xfff3a01b.
+//
+// Solidity: function transfer(address to, string denom, uint256 amount) returns(bool)
+func (_Bank *BankTransactorSession) Transfer(to common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Bank.Contract.Transfer(&_Bank.TransactOpts, to, denom, amount)
+}
+
+// BankTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the Bank contract.
+type BankTransferIterator struct {
+	Event *BankTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BankTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BankTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BankTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BankTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BankTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BankTransfer represents a Transfer event raised by the Bank contract.
+type BankTransfer struct {
+	From   common.Address
+	To     common.Address
+	Denom  string
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0x1d30d3db8e01fa0d5626c471596f822f597e720c26a2930ef20d3387313c3d78.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, string denom, uint256 amount)
+func (_Bank *BankFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*BankTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Bank.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BankTransferIterator{contract: _Bank.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0x1d30d3db8e01fa0d5626c471596f822f597e720c26a2930ef20d3387313c3d78.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, string denom, uint256 amount)
+func (_Bank *BankFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *BankTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Bank.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BankTransfer)
+				if err := _Bank.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0x1d30d3db8e01fa0d5626c471596f822f597e720c26a2930ef20d3387313c3d78.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, string denom, uint256 amount)
+func (_Bank *BankFilterer) ParseTransfer(log types.Log) (*BankTransfer, error) {
+	event := new(BankTransfer)
+	if err := _Bank.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
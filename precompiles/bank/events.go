@@ -0,0 +1,35 @@
+package bank
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const (
+	TransferEvent = "Transfer"
+)
+
+func (b *BankPrecompile) EmitTransferEvent(ctx sdk.Context, stateDB *statedb.StateDB, from common.Address, to common.Address, denom string, amount *big.Int) error {
+	event := b.ABI.Events[TransferEvent]
+	topics, err := abi.MakeTopics([]interface{}{event.ID, from, to})
+	if err != nil {
+		return err
+	}
+	arguments := event.Inputs.NonIndexed()
+	data, err := arguments.Pack(denom, amount)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     b.Address(),
+		Topics:      topics[0],
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
@@ -0,0 +1,37 @@
+package bank
+
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	precisebanktypes "github.com/0glabs/0g-chain/x/precisebank/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func (b *BankPrecompile) BalanceOf(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(2, len(args))
+	}
+	denom := args[1].(string)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, precopmiles_common.ErrInvalidArguments(err)
+	}
+	balance := b.precisebankKeeper.GetBalance(ctx, ToAccAddress(args[0].(common.Address)), denom)
+	return method.Outputs.Pack(balance.Amount.BigInt())
+}
+
+func (b *BankPrecompile) ExtendedBalanceOf(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(1, len(args))
+	}
+	balance := b.precisebankKeeper.GetBalance(ctx, ToAccAddress(args[0].(common.Address)), precisebanktypes.ExtendedCoinDenom)
+	return method.Outputs.Pack(balance.Amount.BigInt())
+}
+
+func (b *BankPrecompile) ExtendedDenom(_ sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(0, len(args))
+	}
+	return method.Outputs.Pack(precisebanktypes.ExtendedCoinDenom)
+}
@@ -0,0 +1,37 @@
+package bank
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+func (b *BankPrecompile) Transfer(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	to, coins, err := parseTransfer(args)
+	if err != nil {
+		return nil, err
+	}
+	from := contract.Caller()
+	// same checks as MsgSend of x/bank
+	if err := b.precisebankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+		return nil, err
+	}
+	if b.bankKeeper.BlockedAddr(ToAccAddress(to)) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", ToAccAddress(to))
+	}
+	// execute
+	balances := precopmiles_common.NewBalanceTracker(ctx, stateDB, from, to)
+	if err := b.precisebankKeeper.SendCoins(ctx, ToAccAddress(from), ToAccAddress(to), coins); err != nil {
+		return nil, err
+	}
+	balances.Sync(ctx)
+	// emit events
+	if err := b.EmitTransferEvent(ctx, stateDB, from, to, coins[0].Denom, coins[0].Amount.BigInt()); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,35 @@
+package bank
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func ToAccAddress(addr common.Address) sdk.AccAddress {
+	return sdk.AccAddress(addr.Bytes())
+}
+
+// parseTransfer returns the recipient and the coins of the transfer arguments.
+func parseTransfer(args []interface{}) (common.Address, sdk.Coins, error) {
+	if len(args) != 3 {
+		return common.Address{}, nil, precopmiles_common.ErrInvalidNumberOfArgs(3, len(args))
+	}
+	to := args[0].(common.Address)
+	coin := sdk.Coin{
+		Denom:  args[1].(string),
+		Amount: sdkmath.NewIntFromBigInt(args[2].(*big.Int)),
+	}
+	if err := coin.Validate(); err != nil {
+		return common.Address{}, nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if !coin.IsPositive() {
+		return common.Address{}, nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, coin.String())
+	}
+	return to, sdk.NewCoins(coin), nil
+}
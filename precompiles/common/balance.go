@@ -0,0 +1,60 @@
+package common
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// BalanceTracker mirrors the balance changes made by the cosmos modules during a precompile call into the StateDB,
+// otherwise the accounts cached by the StateDB overwrite them when it commits.
+type BalanceTracker struct {
+	stateDB  *statedb.StateDB
+	addrs    []common.Address
+	balances []*big.Int
+}
+
+// NewBalanceTracker loads the accounts into the StateDB and records their balances before they change. Accounts
+// unknown to the StateDB are created so that they are dropped, instead of cached with the changed balances, if the
+// call is reverted.
+func NewBalanceTracker(ctx sdk.Context, stateDB *statedb.StateDB, addrs ...common.Address) *BalanceTracker {
+	t := &BalanceTracker{stateDB: stateDB}
+	seen := make(map[common.Address]struct{})
+	for _, addr := range addrs {
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+		if !stateDB.Exist(addr) {
+			stateDB.CreateAccount(addr)
+		}
+		t.addrs = append(t.addrs, addr)
+		t.balances = append(t.balances, t.balance(ctx, addr))
+	}
+	return t
+}
+
+// Sync applies the balance changes of the tracked accounts to the StateDB.
+func (t *BalanceTracker) Sync(ctx sdk.Context) {
+	for i, addr := range t.addrs {
+		balance := t.balance(ctx, addr)
+		switch delta := new(big.Int).Sub(balance, t.balances[i]); delta.Sign() {
+		case 1:
+			t.stateDB.AddBalance(addr, delta)
+		case -1:
+			t.stateDB.SubBalance(addr, delta.Neg(delta))
+		}
+		t.balances[i] = balance
+	}
+}
+
+// balance returns the balance stored by the cosmos modules
+func (t *BalanceTracker) balance(ctx sdk.Context, addr common.Address) *big.Int {
+	account := t.stateDB.Keeper().GetAccount(ctx, addr)
+	if account == nil {
+		return new(big.Int)
+	}
+	return account.Balance
+}
@@ -1,6 +1,108 @@
 package common
 
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
 const (
-	ErrGetStateDB          = "get EVM StateDB failed"
-	ErrInvalidNumberOfArgs = "invalid number of arguments; expected %d; got: %d"
+	ErrorInvalidMethod       = "InvalidMethod"
+	ErrorInvalidArguments    = "InvalidArguments"
+	ErrorInvalidNumberOfArgs = "InvalidNumberOfArgs"
+	ErrorWriteProtection     = "WriteProtection"
+	ErrorGetStateDB          = "GetStateDBFailed"
+	ErrorCosmos              = "CosmosError"
 )
+
+// ErrorsABI defines the custom errors shared by all precompiles, precompile ABIs should declare them as well
+// so that callers are able to decode the revert data.
+const ErrorsABI = `[
+	{"type":"error","name":"InvalidMethod","inputs":[{"name":"selector","type":"bytes4","internalType":"bytes4"}]},
+	{"type":"error","name":"InvalidArguments","inputs":[{"name":"reason","type":"string","internalType":"string"}]},
+	{"type":"error","name":"InvalidNumberOfArgs","inputs":[{"name":"expected","type":"uint256","internalType":"uint256"},{"name":"got","type":"uint256","internalType":"uint256"}]},
+	{"type":"error","name":"WriteProtection","inputs":[{"name":"method","type":"string","internalType":"string"}]},
+	{"type":"error","name":"GetStateDBFailed","inputs":[]},
+	{"type":"error","name":"CosmosError","inputs":[{"name":"codespace","type":"string","internalType":"string"},{"name":"code","type":"uint32","internalType":"uint32"},{"name":"message","type":"string","internalType":"string"}]}
+]`
+
+var errorsABI = mustParseABI(ErrorsABI)
+
+var ErrGetStateDB = NewRevertError(errorsABI.Errors[ErrorGetStateDB])
+
+// RevertError is an error reverting the precompile call with an ABI-encoded custom error as the revert data.
+type RevertError struct {
+	reason string
+	data   []byte
+}
+
+// NewRevertError packs the custom error with its arguments.
+func NewRevertError(customError abi.Error, args ...interface{}) *RevertError {
+	data := append([]byte{}, customError.ID[:4]...)
+	bz, err := customError.Inputs.Pack(args...)
+	if err == nil {
+		data = append(data, bz...)
+	}
+	return &RevertError{
+		reason: fmt.Sprintf("%s%v", customError.Name, args),
+		data:   data,
+	}
+}
+
+// Error implements error.
+func (e *RevertError) Error() string {
+	return e.reason
+}
+
+// Data returns the ABI-encoded custom error.
+func (e *RevertError) Data() []byte {
+	return e.data
+}
+
+func ErrInvalidMethod(input []byte) *RevertError {
+	var selector [4]byte
+	copy(selector[:], input)
+	return NewRevertError(errorsABI.Errors[ErrorInvalidMethod], selector)
+}
+
+func ErrInvalidArguments(err error) *RevertError {
+	return NewRevertError(errorsABI.Errors[ErrorInvalidArguments], err.Error())
+}
+
+func ErrInvalidNumberOfArgs(expected int, got int) *RevertError {
+	return NewRevertError(errorsABI.Errors[ErrorInvalidNumberOfArgs], big.NewInt(int64(expected)), big.NewInt(int64(got)))
+}
+
+func ErrWriteProtection(method string) *RevertError {
+	return NewRevertError(errorsABI.Errors[ErrorWriteProtection], method)
+}
+
+// ErrCosmos wraps the error returned by the cosmos modules along with its codespace and code.
+func ErrCosmos(err error) *RevertError {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	revertErr := NewRevertError(errorsABI.Errors[ErrorCosmos], codespace, code, err.Error())
+	revertErr.reason = err.Error()
+	return revertErr
+}
+
+// UnpackCosmosError decodes the codespace, code and message of the revert data packed by ErrCosmos.
+func UnpackCosmosError(data []byte) (string, uint32, string, error) {
+	customError := errorsABI.Errors[ErrorCosmos]
+	values, err := customError.Unpack(data)
+	if err != nil {
+		return "", 0, "", err
+	}
+	args := values.([]interface{})
+	return args[0].(string), args[1].(uint32), args[2].(string), nil
+}
+
+func mustParseABI(abiJSON string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
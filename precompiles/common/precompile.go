@@ -0,0 +1,195 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// RequiredGasMax is charged for the inputs not matching any method.
+const RequiredGasMax uint64 = 1000_000_000
+
+// QueryHandler executes a method which only reads the state.
+type QueryHandler func(ctx sdk.Context, evm *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error)
+
+// TxHandler executes a method which may change the state, it is rejected in static context.
+type TxHandler func(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error)
+
+// GasFunc returns the gas charged according to the arguments on top of the basic gas, it is charged before the
+// method executes so that expensive calls run out of gas early.
+type GasFunc func(ctx sdk.Context, args []interface{}) (uint64, error)
+
+// Method binds the handler of a precompile method with its basic gas.
+type Method struct {
+	RequiredGas uint64
+
+	query QueryHandler
+	tx    TxHandler
+	gas   GasFunc
+}
+
+func NewQueryMethod(requiredGas uint64, handler QueryHandler) Method {
+	return Method{RequiredGas: requiredGas, query: handler}
+}
+
+func NewTxMethod(requiredGas uint64, handler TxHandler) Method {
+	return Method{RequiredGas: requiredGas, tx: handler}
+}
+
+// WithGas returns the method charging the gas returned by gasFunc before executing.
+func (m Method) WithGas(gasFunc GasFunc) Method {
+	m.gas = gasFunc
+	return m
+}
+
+// IsTx returns whether the method may change the state.
+func (m Method) IsTx() bool {
+	return m.tx != nil
+}
+
+// Precompile implements vm.PrecompiledContract with the method dispatch, gas accounting and revert encoding,
+// precompiles embed it and register their methods by the ABI method names.
+type Precompile struct {
+	ABI abi.ABI
+
+	address     common.Address
+	methods     map[string]Method
+	kvGasConfig storetypes.GasConfig
+}
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// NewPrecompile checks every method is declared in the ABI with the matching mutability.
+func NewPrecompile(address common.Address, abiJSON string, kvGasConfig storetypes.GasConfig, methods map[string]Method) (*Precompile, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+	for name, method := range methods {
+		abiMethod, ok := parsed.Methods[name]
+		if !ok {
+			return nil, fmt.Errorf("method %s not found in ABI", name)
+		}
+		if abiMethod.IsConstant() == method.IsTx() {
+			return nil, fmt.Errorf("mutability of method %s mismatches ABI", name)
+		}
+	}
+	return &Precompile{
+		ABI:         parsed,
+		address:     address,
+		methods:     methods,
+		kvGasConfig: kvGasConfig,
+	}, nil
+}
+
+// Address implements vm.PrecompiledContract.
+func (p *Precompile) Address() common.Address {
+	return p.address
+}
+
+// RequiredGas implements vm.PrecompiledContract.
+func (p *Precompile) RequiredGas(input []byte) uint64 {
+	_, method, err := p.method(input)
+	if err != nil {
+		return RequiredGasMax
+	}
+	return method.RequiredGas
+}
+
+// Run implements vm.PrecompiledContract, errors are returned as the revert data of vm.ErrExecutionReverted
+// except vm.ErrOutOfGas which consumes all the gas left.
+func (p *Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	abiMethod, method, err := p.method(contract.Input)
+	if err != nil {
+		return Revert(err)
+	}
+	if readonly && method.IsTx() {
+		return Revert(ErrWriteProtection(abiMethod.Name))
+	}
+	args, err := abiMethod.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return Revert(ErrInvalidArguments(err))
+	}
+	// get state db and context
+	stateDB, ok := GetStateDB(evm)
+	if !ok {
+		return Revert(ErrGetStateDB)
+	}
+	ctx := stateDB.GetContext().WithKVGasConfig(p.kvGasConfig)
+	initialGas := ctx.GasMeter().GasConsumed()
+
+	bz, err := p.execute(ctx, evm, contract, stateDB, abiMethod, method, args)
+
+	// the store accesses are charged even if the call reverts
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+	if err != nil {
+		return Revert(err)
+	}
+	return bz, nil
+}
+
+// execute charges the gas of the arguments and runs the handler of the method.
+func (p *Precompile) execute(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, stateDB *StateDB, abiMethod *abi.Method, method Method, args []interface{}) ([]byte, error) {
+	if method.gas != nil {
+		gas, err := method.gas(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if !contract.UseGas(gas) {
+			return nil, vm.ErrOutOfGas
+		}
+	}
+	if method.IsTx() {
+		// the changes are journaled to be reverted along with the EVM frames, the events are emitted only if the call
+		// succeeds
+		txCtx := ctx.WithMultiStore(stateDB.JournalMultiStore(ctx.MultiStore())).WithEventManager(sdk.NewEventManager())
+		snapshot := stateDB.Snapshot()
+		bz, err := method.tx(txCtx, contract, stateDB.StateDB, abiMethod, args)
+		if err != nil {
+			stateDB.RevertToSnapshot(snapshot)
+			return nil, err
+		}
+		ctx.EventManager().EmitEvents(txCtx.EventManager().Events())
+		return bz, nil
+	}
+	return method.query(ctx, evm, abiMethod, args)
+}
+
+// method returns the ABI method and registered handler selected by the input.
+func (p *Precompile) method(input []byte) (*abi.Method, Method, error) {
+	if len(input) < 4 {
+		return nil, Method{}, ErrInvalidMethod(input)
+	}
+	abiMethod, err := p.ABI.MethodById(input[:4])
+	if err != nil {
+		return nil, Method{}, ErrInvalidMethod(input)
+	}
+	method, ok := p.methods[abiMethod.Name]
+	if !ok {
+		return nil, Method{}, ErrInvalidMethod(input)
+	}
+	return abiMethod, method, nil
+}
+
+// Revert returns the revert data of err along with vm.ErrExecutionReverted, errors other than RevertError are
+// encoded as CosmosError.
+func Revert(err error) ([]byte, error) {
+	if errors.Is(err, vm.ErrOutOfGas) {
+		return nil, err
+	}
+	var revertErr *RevertError
+	if !errors.As(err, &revertErr) {
+		revertErr = ErrCosmos(err)
+	}
+	return revertErr.Data(), vm.ErrExecutionReverted
+}
@@ -0,0 +1,83 @@
+package common_test
+
+import (
+	"errors"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	precompiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testABI = `[
+	{"type":"function","name":"get","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"},
+	{"type":"function","name":"set","inputs":[{"name":"_value","type":"uint256"}],"outputs":[],"stateMutability":"nonpayable"}
+]`
+
+func query(sdk.Context, *vm.EVM, *abi.Method, []interface{}) ([]byte, error) {
+	return nil, nil
+}
+
+func tx(sdk.Context, *vm.Contract, *statedb.StateDB, *abi.Method, []interface{}) ([]byte, error) {
+	return nil, nil
+}
+
+func Test_NewPrecompile(t *testing.T) {
+	address := common.HexToAddress("0x0000000000000000000000000000000000001001")
+	p, err := precompiles_common.NewPrecompile(address, testABI, storetypes.GasConfig{}, map[string]precompiles_common.Method{
+		"get": precompiles_common.NewQueryMethod(100, query),
+		"set": precompiles_common.NewTxMethod(200, tx),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, address, p.Address())
+	assert.EqualValues(t, 100, p.RequiredGas(p.ABI.Methods["get"].ID))
+	assert.EqualValues(t, 200, p.RequiredGas(p.ABI.Methods["set"].ID))
+	assert.Equal(t, precompiles_common.RequiredGasMax, p.RequiredGas([]byte{1, 2, 3}))
+	assert.Equal(t, precompiles_common.RequiredGasMax, p.RequiredGas([]byte{1, 2, 3, 4}))
+
+	_, err = precompiles_common.NewPrecompile(address, testABI, storetypes.GasConfig{}, map[string]precompiles_common.Method{
+		"set": precompiles_common.NewQueryMethod(200, query),
+	})
+	assert.Error(t, err)
+	_, err = precompiles_common.NewPrecompile(address, testABI, storetypes.GasConfig{}, map[string]precompiles_common.Method{
+		"unknown": precompiles_common.NewTxMethod(200, tx),
+	})
+	assert.Error(t, err)
+}
+
+func Test_Revert(t *testing.T) {
+	errTest := errorsmod.Register("precompile_test", 2, "test error")
+
+	bz, err := precompiles_common.Revert(errorsmod.Wrap(errTest, "wrapped"))
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	codespace, code, message, err := precompiles_common.UnpackCosmosError(bz)
+	require.NoError(t, err)
+	assert.Equal(t, "precompile_test", codespace)
+	assert.EqualValues(t, 2, code)
+	assert.Equal(t, "wrapped: test error", message)
+
+	revertErr := precompiles_common.ErrWriteProtection("set")
+	bz, err = precompiles_common.Revert(revertErr)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	assert.Equal(t, revertErr.Data(), bz)
+
+	// unregistered errors are reported as undefined
+	bz, err = precompiles_common.Revert(errors.New("failure"))
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	codespace, code, message, err = precompiles_common.UnpackCosmosError(bz)
+	require.NoError(t, err)
+	assert.Equal(t, errorsmod.UndefinedCodespace, codespace)
+	assert.EqualValues(t, 1, code)
+	assert.Equal(t, "failure", message)
+
+	bz, err = precompiles_common.Revert(vm.ErrOutOfGas)
+	assert.Nil(t, bz)
+	assert.ErrorIs(t, err, vm.ErrOutOfGas)
+}
@@ -0,0 +1,187 @@
+package common
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// StateDB wraps the StateDB of ethermint, whose journal cannot be extended, to revert the cosmos state changed by the
+// precompiles along with the EVM state. It replaces the StateDB of the EVM on the first precompile call of a
+// transaction, so that the following snapshots and reverts of the EVM go through it.
+type StateDB struct {
+	*statedb.StateDB
+
+	// revision is the last snapshot taken, the changes are reverted along with the snapshots taken before them
+	revision int
+	changes  []storeChange
+}
+
+var _ vm.StateDB = &StateDB{}
+
+// GetStateDB returns the StateDB of the EVM, which is wrapped on the first call.
+func GetStateDB(evm *vm.EVM) (*StateDB, bool) {
+	switch db := evm.StateDB.(type) {
+	case *StateDB:
+		return db, true
+	case *statedb.StateDB:
+		// the snapshots taken before are older than the revision of any change
+		s := &StateDB{StateDB: db, revision: db.Snapshot()}
+		evm.StateDB = s
+		return s, true
+	default:
+		return nil, false
+	}
+}
+
+// Snapshot implements vm.StateDB.
+func (s *StateDB) Snapshot() int {
+	s.revision = s.StateDB.Snapshot()
+	return s.revision
+}
+
+// RevertToSnapshot implements vm.StateDB, the cosmos state changed since the snapshot is restored as well.
+func (s *StateDB) RevertToSnapshot(revid int) {
+	s.StateDB.RevertToSnapshot(revid)
+	i := len(s.changes)
+	for ; i > 0 && s.changes[i-1].revision >= revid; i-- {
+		s.changes[i-1].revert()
+	}
+	s.changes = s.changes[:i]
+}
+
+// JournalMultiStore returns a branch of ms writing through to it and recording the overwritten values, so that they
+// are restored once the current revision is reverted.
+func (s *StateDB) JournalMultiStore(ms storetypes.MultiStore) storetypes.MultiStore {
+	return &journalMultiStore{MultiStore: ms, stateDB: s, stores: make(map[storetypes.StoreKey]*journalKVStore)}
+}
+
+// storeChange records the value of a key before it is overwritten, nil if the key did not exist.
+type storeChange struct {
+	revision int
+	store    storetypes.KVStore
+	key      []byte
+	value    []byte
+}
+
+func (c storeChange) revert() {
+	if c.value == nil {
+		c.store.Delete(c.key)
+	} else {
+		c.store.Set(c.key, c.value)
+	}
+}
+
+// journalMultiStore returns the journaled stores of the underlying multistore, the writes of its branches are
+// journaled once written.
+type journalMultiStore struct {
+	storetypes.MultiStore
+	stateDB *StateDB
+	stores  map[storetypes.StoreKey]*journalKVStore
+}
+
+func (ms *journalMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+func (ms *journalMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := ms.stores[key]
+	if !ok {
+		store = &journalKVStore{KVStore: ms.MultiStore.GetKVStore(key), stateDB: ms.stateDB}
+		ms.stores[key] = store
+	}
+	return store
+}
+
+func (ms *journalMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(ms)
+}
+
+func (ms *journalMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+func (ms *journalMultiStore) CacheWrapWithTrace(io.Writer, storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// journalKVStore records the value of the keys before they are set or deleted.
+type journalKVStore struct {
+	storetypes.KVStore
+	stateDB *StateDB
+}
+
+func (s *journalKVStore) Set(key, value []byte) {
+	s.record(key)
+	s.KVStore.Set(key, value)
+}
+
+func (s *journalKVStore) Delete(key []byte) {
+	s.record(key)
+	s.KVStore.Delete(key)
+}
+
+func (s *journalKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *journalKVStore) CacheWrapWithTrace(io.Writer, storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *journalKVStore) record(key []byte) {
+	s.stateDB.changes = append(s.stateDB.changes, storeChange{
+		revision: s.stateDB.revision,
+		store:    s.KVStore,
+		key:      append([]byte{}, key...),
+		value:    s.KVStore.Get(key),
+	})
+}
+
+// cacheMultiStore branches the stores of a multistore as they are accessed, so that the writes of the cache
+// contexts created by the modules go through the journaled stores.
+type cacheMultiStore struct {
+	storetypes.MultiStore
+	keys   []storetypes.StoreKey
+	stores map[storetypes.StoreKey]storetypes.CacheKVStore
+}
+
+func newCacheMultiStore(parent storetypes.MultiStore) *cacheMultiStore {
+	return &cacheMultiStore{MultiStore: parent, stores: make(map[storetypes.StoreKey]storetypes.CacheKVStore)}
+}
+
+func (ms *cacheMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+func (ms *cacheMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := ms.stores[key]
+	if !ok {
+		store = cachekv.NewStore(ms.MultiStore.GetKVStore(key))
+		ms.keys = append(ms.keys, key)
+		ms.stores[key] = store
+	}
+	return store
+}
+
+func (ms *cacheMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(ms)
+}
+
+func (ms *cacheMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+func (ms *cacheMultiStore) CacheWrapWithTrace(io.Writer, storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// Write writes the branches in the order they were accessed.
+func (ms *cacheMultiStore) Write() {
+	for _, key := range ms.keys {
+		ms.stores[key].Write()
+	}
+}
@@ -0,0 +1,51 @@
+package common_test
+
+import (
+	"testing"
+
+	precompiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StateDBJournal(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(key)
+	store.Set([]byte("a"), []byte{1})
+
+	evm := &vm.EVM{StateDB: statedb.New(ctx, nil, statedb.TxConfig{})}
+	stateDB, ok := precompiles_common.GetStateDB(evm)
+	require.True(t, ok)
+	assert.Same(t, stateDB, evm.StateDB)
+	wrapped, ok := precompiles_common.GetStateDB(evm)
+	require.True(t, ok)
+	assert.Same(t, stateDB, wrapped)
+
+	// the writes go through to the underlying store
+	first := stateDB.Snapshot()
+	ms := stateDB.JournalMultiStore(ctx.MultiStore())
+	ms.GetKVStore(key).Set([]byte("a"), []byte{2})
+	ms.GetKVStore(key).Set([]byte("b"), []byte{1})
+	assert.Equal(t, []byte{2}, store.Get([]byte("a")))
+
+	// the writes of the cache contexts are journaled once written
+	second := stateDB.Snapshot()
+	cacheCtx, write := ctx.WithMultiStore(ms).CacheContext()
+	cacheCtx.KVStore(key).Delete([]byte("a"))
+	assert.True(t, store.Has([]byte("a")))
+	write()
+	assert.False(t, store.Has([]byte("a")))
+
+	stateDB.RevertToSnapshot(second)
+	assert.Equal(t, []byte{2}, store.Get([]byte("a")))
+	assert.Equal(t, []byte{1}, store.Get([]byte("b")))
+
+	stateDB.RevertToSnapshot(first)
+	assert.Equal(t, []byte{1}, store.Get([]byte("a")))
+	assert.False(t, store.Has([]byte("b")))
+}
@@ -30,7 +30,7 @@ var (
 
 // DASignersMetaData contains all meta data concerning the DASigners contract.
 var DASignersMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"indexed\":false,\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"name\":\"NewSigner\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"}],\"name\":\"SocketUpdated\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"epochNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_quorumBitmap\",\"type\":\"bytes\"}],\"name\":\"getAggPkG1\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"aggPkG1\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"hit\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"}],\"name\":\"getQuorum\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"_rowIndex\",\"type\":\"uint32\"}],\"name\":\"getQuorumRow\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_account\",\"type\":\"address[]\"}],\"name\":\"getSigner\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isSigner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"params\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"tokensPerVote\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxVotesPerSigner\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxQuorums\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"epochBlocks\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"encodedSlices\",\"type\":\"uint256\"}],\"internalType\":\"structIDASigners.Params\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"quorumCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerNextEpoch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail\",\"name\":\"_signer\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerSigner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"registeredEpoch\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_socket\",\"type\":\"string\"}],\"name\":\"updateSocket\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"codespace\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"code\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"message\",\"type\":\"string\"}],\"name\":\"CosmosError\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"GetStateDBFailed\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"reason\",\"type\":\"string\"}],\"name\":\"InvalidArguments\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"selector\",\"type\":\"bytes4\"}],\"name\":\"InvalidMethod\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"expected\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"got\",\"type\":\"uint256\"}],\"name\":\"InvalidNumberOfArgs\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"}],\"name\":\"InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"method\",\"type\":\"string\"}],\"name\":\"WriteProtection\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"indexed\":false,\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"name\":\"NewSigner\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"OperatorUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"}],\"name\":\"SignerDeregistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"indexed\":false,\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"name\":\"SignerKeyRotated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"}],\"name\":\"SocketUpdated\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"deregisterSigner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_quorumBitmap\",\"type\":\"bytes\"}],\"name\":\"getAggPkG1\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"aggPkG1\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"hit\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"}],\"name\":\"getQuorum\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"_rowIndex\",\"type\":\"uint32\"}],\"name\":\"getQuorumRow\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_account\",\"type\":\"address[]\"}],\"name\":\"getSigner\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_account\",\"type\":\"address[]\"}],\"name\":\"getSignerEndpoints\",\"outputs\":[{\"components\":[{\"internalType\":\"uint8\",\"name\":\"kind\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"protocol\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"host\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"port\",\"type\":\"uint16\"},{\"internalType\":\"bytes\",\"name\":\"tlsFingerprint\",\"type\":\"bytes\"}],\"internalType\":\"structIDASigners.Endpoint[][]\",\"name\":\"\",\"type\":\"tuple[][]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isSigner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"operatorOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"params\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"tokensPerVote\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxVotesPerSigner\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxQuorums\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"epochBlocks\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"encodedSlices\",\"type\":\"uint256\"}],\"internalType\":\"structIDASigners.Params\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"quorumCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerNextEpoch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerNextEpochFor\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail\",\"name\":\"_signer\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerSigner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail\",\"name\":\"_signer\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint8\",\"name\":\"kind\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"protocol\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"host\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"port\",\"type\":\"uint16\"},{\"internalType\":\"bytes\",\"name\":\"tlsFingerprint\",\"type\":\"bytes\"}],\"internalType\":\"structIDASigners.Endpoint[]\",\"name\":\"_endpoints\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerSignerWithEndpoints\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"registeredEpoch\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"_pkG2\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_newKeySignature\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_oldKeySignature\",\"type\":\"tuple\"}],\"name\":\"rotateSignerKey\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_operator\",\"type\":\"address\"}],\"name\":\"setOperator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint8\",\"name\":\"kind\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"protocol\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"host\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"port\",\"type\":\"uint16\"},{\"internalType\":\"bytes\",\"name\":\"tlsFingerprint\",\"type\":\"bytes\"}],\"internalType\":\"structIDASigners.Endpoint[]\",\"name\":\"_endpoints\",\"type\":\"tuple[]\"}],\"name\":\"updateEndpoints\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint8\",\"name\":\"kind\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"protocol\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"host\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"port\",\"type\":\"uint16\"},{\"internalType\":\"bytes\",\"name\":\"tlsFingerprint\",\"type\":\"bytes\"}],\"internalType\":\"structIDASigners.Endpoint[]\",\"name\":\"_endpoints\",\"type\":\"tuple[]\"}],\"name\":\"updateEndpointsFor\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_socket\",\"type\":\"string\"}],\"name\":\"updateSocket\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_quorumBitmap\",\"type\":\"bytes\"},{\"internalType\":\"bytes32\",\"name\":\"_messageHash\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_aggSigG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"_aggPkG2\",\"type\":\"tuple\"}],\"name\":\"verifyQuorumSignature\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"valid\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"hit\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
 }
 
 // DASignersABI is the input ABI used to generate the binding from.
@@ -353,6 +353,37 @@ func (_DASigners *DASignersCallerSession) GetSigner(_account []common.Address) (
 	return _DASigners.Contract.GetSigner(&_DASigners.CallOpts, _account)
 }
 
+// GetSignerEndpoints is a free data retrieval call binding the contract method 0xdd7669c3.
+//
+// Solidity: function getSignerEndpoints(address[] _account) view returns((uint8,string,string,uint16,bytes)[][])
+func (_DASigners *DASignersCaller) GetSignerEndpoints(opts *bind.CallOpts, _account []common.Address) ([][]IDASignersEndpoint, error) {
+	var out []interface{}
+	err := _DASigners.contract.Call(opts, &out, "getSignerEndpoints", _account)
+
+	if err != nil {
+		return *new([][]IDASignersEndpoint), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([][]IDASignersEndpoint)).(*[][]IDASignersEndpoint)
+
+	return out0, err
+
+}
+
+// GetSignerEndpoints is a free data retrieval call binding the contract method 0xdd7669c3.
+//
+// Solidity: function getSignerEndpoints(address[] _account) view returns((uint8,string,string,uint16,bytes)[][])
+func (_DASigners *DASignersSession) GetSignerEndpoints(_account []common.Address) ([][]IDASignersEndpoint, error) {
+	return _DASigners.Contract.GetSignerEndpoints(&_DASigners.CallOpts, _account)
+}
+
+// GetSignerEndpoints is a free data retrieval call binding the contract method 0xdd7669c3.
+//
+// Solidity: function getSignerEndpoints(address[] _account) view returns((uint8,string,string,uint16,bytes)[][])
+func (_DASigners *DASignersCallerSession) GetSignerEndpoints(_account []common.Address) ([][]IDASignersEndpoint, error) {
+	return _DASigners.Contract.GetSignerEndpoints(&_DASigners.CallOpts, _account)
+}
+
 // IsSigner is a free data retrieval call binding the contract method 0x7df73e27.
 //
 // Solidity: function isSigner(address _account) view returns(bool)
@@ -384,6 +415,37 @@ func (_DASigners *DASignersCallerSession) IsSigner(_account common.Address) (boo
 	return _DASigners.Contract.IsSigner(&_DASigners.CallOpts, _account)
 }
 
+// OperatorOf is a free data retrieval call binding the contract method 0x636f35d3.
+//
+// Solidity: function operatorOf(address _account) view returns(address)
+func (_DASigners *DASignersCaller) OperatorOf(opts *bind.CallOpts, _account common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _DASigners.contract.Call(opts, &out, "operatorOf", _account)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OperatorOf is a free data retrieval call binding the contract method 0x636f35d3.
+//
+// Solidity: function operatorOf(address _account) view returns(address)
+func (_DASigners *DASignersSession) OperatorOf(_account common.Address) (common.Address, error) {
+	return _DASigners.Contract.OperatorOf(&_DASigners.CallOpts, _account)
+}
+
+// OperatorOf is a free data retrieval call binding the contract method 0x636f35d3.
+//
+// Solidity: function operatorOf(address _account) view returns(address)
+func (_DASigners *DASignersCallerSession) OperatorOf(_account common.Address) (common.Address, error) {
+	return _DASigners.Contract.OperatorOf(&_DASigners.CallOpts, _account)
+}
+
 // Params is a free data retrieval call binding the contract method 0xcff0ab96.
 //
 // Solidity: function params() view returns((uint256,uint256,uint256,uint256,uint256))
@@ -477,6 +539,77 @@ func (_DASigners *DASignersCallerSession) RegisteredEpoch(_account common.Addres
 	return _DASigners.Contract.RegisteredEpoch(&_DASigners.CallOpts, _account, _epoch)
 }
 
+// VerifyQuorumSignature is a free data retrieval call binding the contract method 0xdfe359f8.
+//
+// Solidity: function verifyQuorumSignature(uint256 _epoch, uint256 _quorumId, bytes _quorumBitmap, bytes32 _messageHash, (uint256,uint256) _aggSigG1, (uint256[2],uint256[2]) _aggPkG2) view returns(bool valid, uint256 total, uint256 hit)
+func (_DASigners *DASignersCaller) VerifyQuorumSignature(opts *bind.CallOpts, _epoch *big.Int, _quorumId *big.Int, _quorumBitmap []byte, _messageHash [32]byte, _aggSigG1 BN254G1Point, _aggPkG2 BN254G2Point) (struct {
+	Valid bool
+	Total *big.Int
+	Hit   *big.Int
+}, error) {
+	var out []interface{}
+	err := _DASigners.contract.Call(opts, &out, "verifyQuorumSignature", _epoch, _quorumId, _quorumBitmap, _messageHash, _aggSigG1, _aggPkG2)
+
+	outstruct := new(struct {
+		Valid bool
+		Total *big.Int
+		Hit   *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Valid = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Total = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Hit = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// VerifyQuorumSignature is a free data retrieval call binding the contract method 0xdfe359f8.
+//
+// Solidity: function verifyQuorumSignature(uint256 _epoch, uint256 _quorumId, bytes _quorumBitmap, bytes32 _messageHash, (uint256,uint256) _aggSigG1, (uint256[2],uint256[2]) _aggPkG2) view returns(bool valid, uint256 total, uint256 hit)
+func (_DASigners *DASignersSession) VerifyQuorumSignature(_epoch *big.Int, _quorumId *big.Int, _quorumBitmap []byte, _messageHash [32]byte, _aggSigG1 BN254G1Point, _aggPkG2 BN254G2Point) (struct {
+	Valid bool
+	Total *big.Int
+	Hit   *big.Int
+}, error) {
+	return _DASigners.Contract.VerifyQuorumSignature(&_DASigners.CallOpts, _epoch, _quorumId, _quorumBitmap, _messageHash, _aggSigG1, _aggPkG2)
+}
+
+// VerifyQuorumSignature is a free data retrieval call binding the contract method 0xdfe359f8.
+//
+// Solidity: function verifyQuorumSignature(uint256 _epoch, uint256 _quorumId, bytes _quorumBitmap, bytes32 _messageHash, (uint256,uint256) _aggSigG1, (uint256[2],uint256[2]) _aggPkG2) view returns(bool valid, uint256 total, uint256 hit)
+func (_DASigners *DASignersCallerSession) VerifyQuorumSignature(_epoch *big.Int, _quorumId *big.Int, _quorumBitmap []byte, _messageHash [32]byte, _aggSigG1 BN254G1Point, _aggPkG2 BN254G2Point) (struct {
+	Valid bool
+	Total *big.Int
+	Hit   *big.Int
+}, error) {
+	return _DASigners.Contract.VerifyQuorumSignature(&_DASigners.CallOpts, _epoch, _quorumId, _quorumBitmap, _messageHash, _aggSigG1, _aggPkG2)
+}
+
+// DeregisterSigner is a paid mutator transaction binding the contract method 0xa544bb9f.
+//
+// Solidity: function deregisterSigner() returns()
+func (_DASigners *DASignersTransactor) DeregisterSigner(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "deregisterSigner")
+}
+
+// DeregisterSigner is a paid mutator transaction binding the contract method 0xa544bb9f.
+//
+// Solidity: function deregisterSigner() returns()
+func (_DASigners *DASignersSession) DeregisterSigner() (*types.Transaction, error) {
+	return _DASigners.Contract.DeregisterSigner(&_DASigners.TransactOpts)
+}
+
+// DeregisterSigner is a paid mutator transaction binding the contract method 0xa544bb9f.
+//
+// Solidity: function deregisterSigner() returns()
+func (_DASigners *DASignersTransactorSession) DeregisterSigner() (*types.Transaction, error) {
+	return _DASigners.Contract.DeregisterSigner(&_DASigners.TransactOpts)
+}
+
 // RegisterNextEpoch is a paid mutator transaction binding the contract method 0x56a32372.
 //
 // Solidity: function registerNextEpoch((uint256,uint256) _signature) returns()
@@ -498,6 +631,27 @@ func (_DASigners *DASignersTransactorSession) RegisterNextEpoch(_signature BN254
 	return _DASigners.Contract.RegisterNextEpoch(&_DASigners.TransactOpts, _signature)
 }
 
+// RegisterNextEpochFor is a paid mutator transaction binding the contract method 0xca8371f1.
+//
+// Solidity: function registerNextEpochFor(address _account, (uint256,uint256) _signature) returns()
+func (_DASigners *DASignersTransactor) RegisterNextEpochFor(opts *bind.TransactOpts, _account common.Address, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "registerNextEpochFor", _account, _signature)
+}
+
+// RegisterNextEpochFor is a paid mutator transaction binding the contract method 0xca8371f1.
+//
+// Solidity: function registerNextEpochFor(address _account, (uint256,uint256) _signature) returns()
+func (_DASigners *DASignersSession) RegisterNextEpochFor(_account common.Address, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.Contract.RegisterNextEpochFor(&_DASigners.TransactOpts, _account, _signature)
+}
+
+// RegisterNextEpochFor is a paid mutator transaction binding the contract method 0xca8371f1.
+//
+// Solidity: function registerNextEpochFor(address _account, (uint256,uint256) _signature) returns()
+func (_DASigners *DASignersTransactorSession) RegisterNextEpochFor(_account common.Address, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.Contract.RegisterNextEpochFor(&_DASigners.TransactOpts, _account, _signature)
+}
+
 // RegisterSigner is a paid mutator transaction binding the contract method 0x7ca4dd5e.
 //
 // Solidity: function registerSigner((address,string,(uint256,uint256),(uint256[2],uint256[2])) _signer, (uint256,uint256) _signature) returns()
@@ -519,6 +673,111 @@ func (_DASigners *DASignersTransactorSession) RegisterSigner(_signer IDASignersS
 	return _DASigners.Contract.RegisterSigner(&_DASigners.TransactOpts, _signer, _signature)
 }
 
+// RegisterSignerWithEndpoints is a paid mutator transaction binding the contract method 0x9f5282b6.
+//
+// Solidity: function registerSignerWithEndpoints((address,string,(uint256,uint256),(uint256[2],uint256[2])) _signer, (uint8,string,string,uint16,bytes)[] _endpoints, (uint256,uint256) _signature) returns()
+func (_DASigners *DASignersTransactor) RegisterSignerWithEndpoints(opts *bind.TransactOpts, _signer IDASignersSignerDetail, _endpoints []IDASignersEndpoint, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "registerSignerWithEndpoints", _signer, _endpoints, _signature)
+}
+
+// RegisterSignerWithEndpoints is a paid mutator transaction binding the contract method 0x9f5282b6.
+//
+// Solidity: function registerSignerWithEndpoints((address,string,(uint256,uint256),(uint256[2],uint256[2])) _signer, (uint8,string,string,uint16,bytes)[] _endpoints, (uint256,uint256) _signature) returns()
+func (_DASigners *DASignersSession) RegisterSignerWithEndpoints(_signer IDASignersSignerDetail, _endpoints []IDASignersEndpoint, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.Contract.RegisterSignerWithEndpoints(&_DASigners.TransactOpts, _signer, _endpoints, _signature)
+}
+
+// RegisterSignerWithEndpoints is a paid mutator transaction binding the contract method 0x9f5282b6.
+//
+// Solidity: function registerSignerWithEndpoints((address,string,(uint256,uint256),(uint256[2],uint256[2])) _signer, (uint8,string,string,uint16,bytes)[] _endpoints, (uint256,uint256) _signature) returns()
+func (_DASigners *DASignersTransactorSession) RegisterSignerWithEndpoints(_signer IDASignersSignerDetail, _endpoints []IDASignersEndpoint, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.Contract.RegisterSignerWithEndpoints(&_DASigners.TransactOpts, _signer, _endpoints, _signature)
+}
+
+// RotateSignerKey is a paid mutator transaction binding the contract method 0x4ed9c4fa.
+//
+// Solidity: function rotateSignerKey((uint256,uint256) _pkG1, (uint256[2],uint256[2]) _pkG2, (uint256,uint256) _newKeySignature, (uint256,uint256) _oldKeySignature) returns()
+func (_DASigners *DASignersTransactor) RotateSignerKey(opts *bind.TransactOpts, _pkG1 BN254G1Point, _pkG2 BN254G2Point, _newKeySignature BN254G1Point, _oldKeySignature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "rotateSignerKey", _pkG1, _pkG2, _newKeySignature, _oldKeySignature)
+}
+
+// RotateSignerKey is a paid mutator transaction binding the contract method 0x4ed9c4fa.
+//
+// Solidity: function rotateSignerKey((uint256,uint256) _pkG1, (uint256[2],uint256[2]) _pkG2, (uint256,uint256) _newKeySignature, (uint256,uint256) _oldKeySignature) returns()
+func (_DASigners *DASignersSession) RotateSignerKey(_pkG1 BN254G1Point, _pkG2 BN254G2Point, _newKeySignature BN254G1Point, _oldKeySignature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.Contract.RotateSignerKey(&_DASigners.TransactOpts, _pkG1, _pkG2, _newKeySignature, _oldKeySignature)
+}
+
+// RotateSignerKey is a paid mutator transaction binding the contract method 0x4ed9c4fa.
+//
+// Solidity: function rotateSignerKey((uint256,uint256) _pkG1, (uint256[2],uint256[2]) _pkG2, (uint256,uint256) _newKeySignature, (uint256,uint256) _oldKeySignature) returns()
+func (_DASigners *DASignersTransactorSession) RotateSignerKey(_pkG1 BN254G1Point, _pkG2 BN254G2Point, _newKeySignature BN254G1Point, _oldKeySignature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.Contract.RotateSignerKey(&_DASigners.TransactOpts, _pkG1, _pkG2, _newKeySignature, _oldKeySignature)
+}
+
+// SetOperator is a paid mutator transaction binding the contract method 0xb3ab15fb.
+//
+// Solidity: function setOperator(address _operator) returns()
+func (_DASigners *DASignersTransactor) SetOperator(opts *bind.TransactOpts, _operator common.Address) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "setOperator", _operator)
+}
+
+// SetOperator is a paid mutator transaction binding the contract method 0xb3ab15fb.
+//
+// Solidity: function setOperator(address _operator) returns()
+func (_DASigners *DASignersSession) SetOperator(_operator common.Address) (*types.Transaction, error) {
+	return _DASigners.Contract.SetOperator(&_DASigners.TransactOpts, _operator)
+}
+
+// SetOperator is a paid mutator transaction binding the contract method 0xb3ab15fb.
+//
+// Solidity: function setOperator(address _operator) returns()
+func (_DASigners *DASignersTransactorSession) SetOperator(_operator common.Address) (*types.Transaction, error) {
+	return _DASigners.Contract.SetOperator(&_DASigners.TransactOpts, _operator)
+}
+
+// UpdateEndpoints is a paid mutator transaction binding the contract method 0x8e94d290.
+//
+// Solidity: function updateEndpoints((uint8,string,string,uint16,bytes)[] _endpoints) returns()
+func (_DASigners *DASignersTransactor) UpdateEndpoints(opts *bind.TransactOpts, _endpoints []IDASignersEndpoint) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "updateEndpoints", _endpoints)
+}
+
+// UpdateEndpoints is a paid mutator transaction binding the contract method 0x8e94d290.
+//
+// Solidity: function updateEndpoints((uint8,string,string,uint16,bytes)[] _endpoints) returns()
+func (_DASigners *DASignersSession) UpdateEndpoints(_endpoints []IDASignersEndpoint) (*types.Transaction, error) {
+	return _DASigners.Contract.UpdateEndpoints(&_DASigners.TransactOpts, _endpoints)
+}
+
+// UpdateEndpoints is a paid mutator transaction binding the contract method 0x8e94d290.
+//
+// Solidity: function updateEndpoints((uint8,string,string,uint16,bytes)[] _endpoints) returns()
+func (_DASigners *DASignersTransactorSession) UpdateEndpoints(_endpoints []IDASignersEndpoint) (*types.Transaction, error) {
+	return _DASigners.Contract.UpdateEndpoints(&_DASigners.TransactOpts, _endpoints)
+}
+
+// UpdateEndpointsFor is a paid mutator transaction binding the contract method 0x097af18b.
+//
+// Solidity: function updateEndpointsFor(address _account, (uint8,string,string,uint16,bytes)[] _endpoints) returns()
+func (_DASigners *DASignersTransactor) UpdateEndpointsFor(opts *bind.TransactOpts, _account common.Address, _endpoints []IDASignersEndpoint) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "updateEndpointsFor", _account, _endpoints)
+}
+
+// UpdateEndpointsFor is a paid mutator transaction binding the contract method 0x097af18b.
+//
+// Solidity: function updateEndpointsFor(address _account, (uint8,string,string,uint16,bytes)[] _endpoints) returns()
+func (_DASigners *DASignersSession) UpdateEndpointsFor(_account common.Address, _endpoints []IDASignersEndpoint) (*types.Transaction, error) {
+	return _DASigners.Contract.UpdateEndpointsFor(&_DASigners.TransactOpts, _account, _endpoints)
+}
+
+// UpdateEndpointsFor is a paid mutator transaction binding the contract method 0x097af18b.
+//
+// Solidity: function updateEndpointsFor(address _account, (uint8,string,string,uint16,bytes)[] _endpoints) returns()
+func (_DASigners *DASignersTransactorSession) UpdateEndpointsFor(_account common.Address, _endpoints []IDASignersEndpoint) (*types.Transaction, error) {
+	return _DASigners.Contract.UpdateEndpointsFor(&_DASigners.TransactOpts, _account, _endpoints)
+}
+
 // UpdateSocket is a paid mutator transaction binding the contract method 0x0cf4b767.
 //
 // Solidity: function updateSocket(string _socket) returns()
@@ -686,6 +945,442 @@ func (_DASigners *DASignersFilterer) ParseNewSigner(log types.Log) (*DASignersNe
 	return event, nil
 }
 
+// DASignersOperatorUpdatedIterator is returned from FilterOperatorUpdated and is used to iterate over the raw logs and unpacked data for OperatorUpdated events raised by the DASigners contract.
+type DASignersOperatorUpdatedIterator struct {
+	Event *DASignersOperatorUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DASignersOperatorUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DASignersOperatorUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DASignersOperatorUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DASignersOperatorUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DASignersOperatorUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DASignersOperatorUpdated represents a OperatorUpdated event raised by the DASigners contract.
+type DASignersOperatorUpdated struct {
+	Signer   common.Address
+	Operator common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterOperatorUpdated is a free log retrieval operation binding the contract event 0xfbe5b6cbafb274f445d7fed869dc77a838d8243a22c460de156560e8857cad03.
+//
+// Solidity: event OperatorUpdated(address indexed signer, address operator)
+func (_DASigners *DASignersFilterer) FilterOperatorUpdated(opts *bind.FilterOpts, signer []common.Address) (*DASignersOperatorUpdatedIterator, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.FilterLogs(opts, "OperatorUpdated", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DASignersOperatorUpdatedIterator{contract: _DASigners.contract, event: "OperatorUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchOperatorUpdated is a free log subscription operation binding the contract event 0xfbe5b6cbafb274f445d7fed869dc77a838d8243a22c460de156560e8857cad03.
+//
+// Solidity: event OperatorUpdated(address indexed signer, address operator)
+func (_DASigners *DASignersFilterer) WatchOperatorUpdated(opts *bind.WatchOpts, sink chan<- *DASignersOperatorUpdated, signer []common.Address) (event.Subscription, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.WatchLogs(opts, "OperatorUpdated", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DASignersOperatorUpdated)
+				if err := _DASigners.contract.UnpackLog(event, "OperatorUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOperatorUpdated is a log parse operation binding the contract event 0xfbe5b6cbafb274f445d7fed869dc77a838d8243a22c460de156560e8857cad03.
+//
+// Solidity: event OperatorUpdated(address indexed signer, address operator)
+func (_DASigners *DASignersFilterer) ParseOperatorUpdated(log types.Log) (*DASignersOperatorUpdated, error) {
+	event := new(DASignersOperatorUpdated)
+	if err := _DASigners.contract.UnpackLog(event, "OperatorUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DASignersSignerDeregisteredIterator is returned from FilterSignerDeregistered and is used to iterate over the raw logs and unpacked data for SignerDeregistered events raised by the DASigners contract.
+type DASignersSignerDeregisteredIterator struct {
+	Event *DASignersSignerDeregistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DASignersSignerDeregisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DASignersSignerDeregistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DASignersSignerDeregistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DASignersSignerDeregisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DASignersSignerDeregisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DASignersSignerDeregistered represents a SignerDeregistered event raised by the DASigners contract.
+type DASignersSignerDeregistered struct {
+	Signer common.Address
+	Epoch  *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterSignerDeregistered is a free log retrieval operation binding the contract event 0x7e557e6be19f33d8e701018112ed15477885e56b5c9ecef9c68435c5f3759c51.
+//
+// Solidity: event SignerDeregistered(address indexed signer, uint256 epoch)
+func (_DASigners *DASignersFilterer) FilterSignerDeregistered(opts *bind.FilterOpts, signer []common.Address) (*DASignersSignerDeregisteredIterator, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.FilterLogs(opts, "SignerDeregistered", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DASignersSignerDeregisteredIterator{contract: _DASigners.contract, event: "SignerDeregistered", logs: logs, sub: sub}, nil
+}
+
+// WatchSignerDeregistered is a free log subscription operation binding the contract event 0x7e557e6be19f33d8e701018112ed15477885e56b5c9ecef9c68435c5f3759c51.
+//
+// Solidity: event SignerDeregistered(address indexed signer, uint256 epoch)
+func (_DASigners *DASignersFilterer) WatchSignerDeregistered(opts *bind.WatchOpts, sink chan<- *DASignersSignerDeregistered, signer []common.Address) (event.Subscription, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.WatchLogs(opts, "SignerDeregistered", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DASignersSignerDeregistered)
+				if err := _DASigners.contract.UnpackLog(event, "SignerDeregistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSignerDeregistered is a log parse operation binding the contract event 0x7e557e6be19f33d8e701018112ed15477885e56b5c9ecef9c68435c5f3759c51.
+//
+// Solidity: event SignerDeregistered(address indexed signer, uint256 epoch)
+func (_DASigners *DASignersFilterer) ParseSignerDeregistered(log types.Log) (*DASignersSignerDeregistered, error) {
+	event := new(DASignersSignerDeregistered)
+	if err := _DASigners.contract.UnpackLog(event, "SignerDeregistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DASignersSignerKeyRotatedIterator is returned from FilterSignerKeyRotated and is used to iterate over the raw logs and unpacked data for SignerKeyRotated events raised by the DASigners contract.
+type DASignersSignerKeyRotatedIterator struct {
+	Event *DASignersSignerKeyRotated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DASignersSignerKeyRotatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DASignersSignerKeyRotated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DASignersSignerKeyRotated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DASignersSignerKeyRotatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DASignersSignerKeyRotatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DASignersSignerKeyRotated represents a SignerKeyRotated event raised by the DASigners contract.
+type DASignersSignerKeyRotated struct {
+	Signer common.Address
+	PkG1   BN254G1Point
+	PkG2   BN254G2Point
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterSignerKeyRotated is a free log retrieval operation binding the contract event 0x8b31024ea8dd19b3f1455a0bdcfcb8d1ef1b2ce4d176191e3b9fc278c2db5457.
+//
+// Solidity: event SignerKeyRotated(address indexed signer, (uint256,uint256) pkG1, (uint256[2],uint256[2]) pkG2)
+func (_DASigners *DASignersFilterer) FilterSignerKeyRotated(opts *bind.FilterOpts, signer []common.Address) (*DASignersSignerKeyRotatedIterator, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.FilterLogs(opts, "SignerKeyRotated", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DASignersSignerKeyRotatedIterator{contract: _DASigners.contract, event: "SignerKeyRotated", logs: logs, sub: sub}, nil
+}
+
+// WatchSignerKeyRotated is a free log subscription operation binding the contract event 0x8b31024ea8dd19b3f1455a0bdcfcb8d1ef1b2ce4d176191e3b9fc278c2db5457.
+//
+// Solidity: event SignerKeyRotated(address indexed signer, (uint256,uint256) pkG1, (uint256[2],uint256[2]) pkG2)
+func (_DASigners *DASignersFilterer) WatchSignerKeyRotated(opts *bind.WatchOpts, sink chan<- *DASignersSignerKeyRotated, signer []common.Address) (event.Subscription, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.WatchLogs(opts, "SignerKeyRotated", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DASignersSignerKeyRotated)
+				if err := _DASigners.contract.UnpackLog(event, "SignerKeyRotated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSignerKeyRotated is a log parse operation binding the contract event 0x8b31024ea8dd19b3f1455a0bdcfcb8d1ef1b2ce4d176191e3b9fc278c2db5457.
+//
+// Solidity: event SignerKeyRotated(address indexed signer, (uint256,uint256) pkG1, (uint256[2],uint256[2]) pkG2)
+func (_DASigners *DASignersFilterer) ParseSignerKeyRotated(log types.Log) (*DASignersSignerKeyRotated, error) {
+	event := new(DASignersSignerKeyRotated)
+	if err := _DASigners.contract.UnpackLog(event, "SignerKeyRotated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // DASignersSocketUpdatedIterator is returned from FilterSocketUpdated and is used to iterate over the raw logs and unpacked data for SocketUpdated events raised by the DASigners contract.
 type DASignersSocketUpdatedIterator struct {
 	Event *DASignersSocketUpdated // Event containing the contract specifics and raw log
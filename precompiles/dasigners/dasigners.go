@@ -1,23 +1,16 @@
 package dasigners
 
 import (
-	"fmt"
-	"strings"
-
 	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
 	dasignerskeeper "github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
-	"github.com/evmos/ethermint/x/evm/statedb"
 )
 
 const (
 	PrecompileAddress = "0x0000000000000000000000000000000000001000"
 
-	RequiredGasMax uint64 = 1000_000_000
-
 	DASignersFunctionParams            = "params"
 	DASignersFunctionEpochNumber       = "epochNumber"
 	DASignersFunctionQuorumCount       = "quorumCount"
@@ -30,130 +23,113 @@ const (
 	DASignersFunctionGetAggPkG1        = "getAggPkG1"
 	DASignersFunctionIsSigner          = "isSigner"
 	DASignersFunctionRegisteredEpoch   = "registeredEpoch"
+	DASignersFunctionRotateSignerKey   = "rotateSignerKey"
+	DASignersFunctionDeregisterSigner  = "deregisterSigner"
+
+	DASignersFunctionVerifyQuorumSignature = "verifyQuorumSignature"
+	DASignersFunctionUpdateEndpoints       = "updateEndpoints"
+
+	DASignersFunctionSetOperator          = "setOperator"
+	DASignersFunctionOperatorOf           = "operatorOf"
+	DASignersFunctionRegisterNextEpochFor = "registerNextEpochFor"
+	DASignersFunctionUpdateEndpointsFor   = "updateEndpointsFor"
+
+	DASignersFunctionRegisterSignerWithEndpoints = "registerSignerWithEndpoints"
+	DASignersFunctionGetSignerEndpoints          = "getSignerEndpoints"
 )
 
+// RequiredGasBasic defines the gas of each method besides the store accesses and the gas scaling with the inputs,
+// which is configured by the gas params of the module.
 var RequiredGasBasic = map[string]uint64{
 	DASignersFunctionParams:            1000,
 	DASignersFunctionEpochNumber:       1000,
 	DASignersFunctionQuorumCount:       1000,
-	DASignersFunctionGetSigner:         100000,
-	DASignersFunctionGetQuorum:         100000,
+	DASignersFunctionGetSigner:         10000,
+	DASignersFunctionGetQuorum:         10000,
 	DASignersFunctionGetQuorumRow:      10000,
 	DASignersFunctionRegisterSigner:    100000,
 	DASignersFunctionUpdateSocket:      50000,
 	DASignersFunctionRegisterNextEpoch: 100000,
-	DASignersFunctionGetAggPkG1:        1000000,
+	DASignersFunctionGetAggPkG1:        10000,
 	DASignersFunctionIsSigner:          10000,
 	DASignersFunctionRegisteredEpoch:   10000,
-}
+	DASignersFunctionRotateSignerKey:   200000,
+	DASignersFunctionDeregisterSigner:  50000,
+
+	DASignersFunctionVerifyQuorumSignature: 50000,
+	DASignersFunctionUpdateEndpoints:       50000,
 
-var KVGasConfig storetypes.GasConfig = storetypes.GasConfig{
-	HasCost:          0,
-	DeleteCost:       0,
-	ReadCostFlat:     0,
-	ReadCostPerByte:  0,
-	WriteCostFlat:    0,
-	WriteCostPerByte: 0,
-	IterNextCostFlat: 0,
+	DASignersFunctionSetOperator:          50000,
+	DASignersFunctionOperatorOf:           10000,
+	DASignersFunctionRegisterNextEpochFor: 100000,
+	DASignersFunctionUpdateEndpointsFor:   50000,
+
+	DASignersFunctionRegisterSignerWithEndpoints: 100000,
+	DASignersFunctionGetSignerEndpoints:          10000,
 }
 
+// KVGasConfig charges the store accesses with the default costs of the cosmos transactions.
+var KVGasConfig storetypes.GasConfig = storetypes.KVGasConfig()
+
 var _ vm.PrecompiledContract = &DASignersPrecompile{}
 
 type DASignersPrecompile struct {
-	abi             abi.ABI
+	*precopmiles_common.Precompile
 	dasignersKeeper dasignerskeeper.Keeper
 }
 
 func NewDASignersPrecompile(dasignersKeeper dasignerskeeper.Keeper) (*DASignersPrecompile, error) {
-	abi, err := abi.JSON(strings.NewReader(DASignersABI))
-	if err != nil {
-		return nil, err
-	}
-	return &DASignersPrecompile{
-		abi:             abi,
+	d := &DASignersPrecompile{
 		dasignersKeeper: dasignersKeeper,
-	}, nil
-}
-
-// Address implements vm.PrecompiledContract.
-func (d *DASignersPrecompile) Address() common.Address {
-	return common.HexToAddress(PrecompileAddress)
-}
-
-// RequiredGas implements vm.PrecompiledContract.
-func (d *DASignersPrecompile) RequiredGas(input []byte) uint64 {
-	method, err := d.abi.MethodById(input[:4])
-	if err != nil {
-		return RequiredGasMax
 	}
-	if gas, ok := RequiredGasBasic[method.Name]; ok {
-		return gas
+	queries := map[string]precopmiles_common.QueryHandler{
+		DASignersFunctionParams:                d.Params,
+		DASignersFunctionEpochNumber:           d.EpochNumber,
+		DASignersFunctionQuorumCount:           d.QuorumCount,
+		DASignersFunctionGetSigner:             d.GetSigner,
+		DASignersFunctionGetQuorum:             d.GetQuorum,
+		DASignersFunctionGetQuorumRow:          d.GetQuorumRow,
+		DASignersFunctionGetAggPkG1:            d.GetAggPkG1,
+		DASignersFunctionIsSigner:              d.IsSigner,
+		DASignersFunctionRegisteredEpoch:       d.RegisteredEpoch,
+		DASignersFunctionVerifyQuorumSignature: d.VerifyQuorumSignature,
+		DASignersFunctionOperatorOf:            d.OperatorOf,
+		DASignersFunctionGetSignerEndpoints:    d.GetSignerEndpoints,
 	}
-	return RequiredGasMax
-}
-
-// Run implements vm.PrecompiledContract.
-func (d *DASignersPrecompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
-	// parse input
-	if len(contract.Input) < 4 {
-		return nil, vm.ErrExecutionReverted
+	txs := map[string]precopmiles_common.TxHandler{
+		DASignersFunctionRegisterSigner:       d.RegisterSigner,
+		DASignersFunctionRegisterNextEpoch:    d.RegisterNextEpoch,
+		DASignersFunctionRegisterNextEpochFor: d.RegisterNextEpochFor,
+		DASignersFunctionUpdateSocket:         d.UpdateSocket,
+		DASignersFunctionUpdateEndpoints:      d.UpdateEndpoints,
+		DASignersFunctionUpdateEndpointsFor:   d.UpdateEndpointsFor,
+		DASignersFunctionRotateSignerKey:      d.RotateSignerKey,
+		DASignersFunctionDeregisterSigner:     d.DeregisterSigner,
+		DASignersFunctionSetOperator:          d.SetOperator,
+
+		DASignersFunctionRegisterSignerWithEndpoints: d.RegisterSignerWithEndpoints,
 	}
-	method, err := d.abi.MethodById(contract.Input[:4])
-	if err != nil {
-		return nil, vm.ErrExecutionReverted
+	methods := make(map[string]precopmiles_common.Method)
+	for name, handler := range queries {
+		methods[name] = precopmiles_common.NewQueryMethod(RequiredGasBasic[name], handler)
 	}
-	args, err := method.Inputs.Unpack(contract.Input[4:])
-	if err != nil {
-		return nil, err
+	for name, handler := range txs {
+		methods[name] = precopmiles_common.NewTxMethod(RequiredGasBasic[name], handler)
 	}
-	// get state db and context
-	stateDB, ok := evm.StateDB.(*statedb.StateDB)
-	if !ok {
-		return nil, fmt.Errorf(precopmiles_common.ErrGetStateDB)
+	gasFuncs := map[string]precopmiles_common.GasFunc{
+		DASignersFunctionGetSigner:             d.GetSignerGas,
+		DASignersFunctionGetSignerEndpoints:    d.GetSignerGas,
+		DASignersFunctionGetQuorum:             d.GetQuorumGas,
+		DASignersFunctionGetAggPkG1:            d.GetAggPkG1Gas,
+		DASignersFunctionVerifyQuorumSignature: d.VerifyQuorumSignatureGas,
 	}
-	ctx := stateDB.GetContext()
-	// reset gas config
-	ctx = ctx.WithKVGasConfig(KVGasConfig)
-	initialGas := ctx.GasMeter().GasConsumed()
-
-	var bz []byte
-	switch method.Name {
-	// queries
-	case DASignersFunctionParams:
-		bz, err = d.Params(ctx, evm, method, args)
-	case DASignersFunctionEpochNumber:
-		bz, err = d.EpochNumber(ctx, evm, method, args)
-	case DASignersFunctionQuorumCount:
-		bz, err = d.QuorumCount(ctx, evm, method, args)
-	case DASignersFunctionGetSigner:
-		bz, err = d.GetSigner(ctx, evm, method, args)
-	case DASignersFunctionGetQuorum:
-		bz, err = d.GetQuorum(ctx, evm, method, args)
-	case DASignersFunctionGetQuorumRow:
-		bz, err = d.GetQuorumRow(ctx, evm, method, args)
-	case DASignersFunctionGetAggPkG1:
-		bz, err = d.GetAggPkG1(ctx, evm, method, args)
-	case DASignersFunctionIsSigner:
-		bz, err = d.IsSigner(ctx, evm, method, args)
-	case DASignersFunctionRegisteredEpoch:
-		bz, err = d.RegisteredEpoch(ctx, evm, method, args)
-	// txs
-	case DASignersFunctionRegisterSigner:
-		bz, err = d.RegisterSigner(ctx, evm, stateDB, method, args)
-	case DASignersFunctionRegisterNextEpoch:
-		bz, err = d.RegisterNextEpoch(ctx, evm, stateDB, method, args)
-	case DASignersFunctionUpdateSocket:
-		bz, err = d.UpdateSocket(ctx, evm, stateDB, method, args)
+	for name, gasFunc := range gasFuncs {
+		methods[name] = methods[name].WithGas(gasFunc)
 	}
-
+	precompile, err := precopmiles_common.NewPrecompile(common.HexToAddress(PrecompileAddress), DASignersABI, KVGasConfig, methods)
 	if err != nil {
 		return nil, err
 	}
-
-	cost := ctx.GasMeter().GasConsumed() - initialGas
-
-	if !contract.UseGas(cost) {
-		return nil, vm.ErrOutOfGas
-	}
-	return bz, nil
+	d.Precompile = precompile
+	return d, nil
 }
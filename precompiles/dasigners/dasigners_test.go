@@ -1,11 +1,13 @@
 package dasigners_test
 
 import (
+	"encoding/hex"
 	"math/big"
 	"strings"
 	"testing"
 
 	"github.com/0glabs/0g-chain/crypto/bn254util"
+	precompiles_common "github.com/0glabs/0g-chain/precompiles/common"
 	dasignersprecompile "github.com/0glabs/0g-chain/precompiles/dasigners"
 	"github.com/0glabs/0g-chain/precompiles/testutil"
 	"github.com/0glabs/0g-chain/x/dasigners/v1"
@@ -17,6 +19,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	etherminttypes "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/stretchr/testify/suite"
 
@@ -53,12 +57,16 @@ func (suite *DASignersTestSuite) AddDelegation(from string, to string, amount ma
 	validator.Tokens = validator.Tokens.Add(amount)
 	validator.DelegatorShares = validator.DelegatorShares.Add(amount.ToLegacyDec())
 	suite.StakingKeeper.SetValidator(suite.Ctx, validator)
-	bonded := suite.dasignerskeeper.GetDelegatorBonded(suite.Ctx, accAddr)
+	shares := amount.ToLegacyDec()
+	if delegation, found := suite.StakingKeeper.GetDelegation(suite.Ctx, accAddr, valAddr); found {
+		shares = shares.Add(delegation.Shares)
+	}
 	suite.StakingKeeper.SetDelegation(suite.Ctx, stakingtypes.Delegation{
 		DelegatorAddress: accAddr.String(),
 		ValidatorAddress: valAddr.String(),
-		Shares:           bonded.Add(amount).ToLegacyDec(),
+		Shares:           shares,
 	})
+	suite.Require().NoError(suite.dasignerskeeper.Hooks().AfterDelegationModified(suite.Ctx, accAddr, valAddr))
 }
 
 func (suite *DASignersTestSuite) SetupTest() {
@@ -81,7 +89,21 @@ func (suite *DASignersTestSuite) SetupTest() {
 }
 
 func (suite *DASignersTestSuite) runTx(input []byte, signer *testutil.TestSigner, gas uint64) ([]byte, error) {
-	contract := vm.NewPrecompile(vm.AccountRef(signer.Addr), vm.AccountRef(suite.addr), big.NewInt(0), gas)
+	return suite.runTxFrom(input, signer, signer.Addr, gas)
+}
+
+// runTxFrom runs the precompile in a tx signed by signer with caller as the direct caller, e.g. a contract wallet
+func (suite *DASignersTestSuite) runTxFrom(input []byte, signer *testutil.TestSigner, caller common.Address, gas uint64) ([]byte, error) {
+	return suite.run(input, signer, caller, gas, false)
+}
+
+// runStaticCall runs the precompile in static context as STATICCALL does
+func (suite *DASignersTestSuite) runStaticCall(input []byte, signer *testutil.TestSigner, gas uint64) ([]byte, error) {
+	return suite.run(input, signer, signer.Addr, gas, true)
+}
+
+func (suite *DASignersTestSuite) run(input []byte, signer *testutil.TestSigner, caller common.Address, gas uint64, readonly bool) ([]byte, error) {
+	contract := vm.NewPrecompile(vm.AccountRef(caller), vm.AccountRef(suite.addr), big.NewInt(0), gas)
 	contract.Input = input
 
 	msgEthereumTx := evmtypes.NewTx(suite.EvmKeeper.ChainID(), 0, &suite.addr, big.NewInt(0), gas, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil)
@@ -100,7 +122,7 @@ func (suite *DASignersTestSuite) runTx(input []byte, signer *testutil.TestSigner
 	precompiles := suite.EvmKeeper.GetPrecompiles()
 	evm.WithPrecompiles(precompiles, []common.Address{suite.addr})
 
-	return suite.dasigners.Run(evm, contract, false)
+	return suite.dasigners.Run(evm, contract, readonly)
 }
 
 func (suite *DASignersTestSuite) registerSigner(testSigner *testutil.TestSigner, sk *big.Int) *types.Signer {
@@ -132,6 +154,7 @@ func (suite *DASignersTestSuite) registerSigner(testSigner *testutil.TestSigner,
 	suite.Assert().NoError(err)
 	_, err = suite.abi.Unpack("NewSigner", logs[len(logs)-2].Data)
 	suite.Assert().NoError(err)
+	suite.Require().NoError(signer.SetEndpoints(signer.Socket, nil))
 	return signer
 }
 
@@ -151,7 +174,7 @@ func (suite *DASignersTestSuite) updateSocket(testSigner *testutil.TestSigner, s
 	_, err = suite.abi.Unpack("SocketUpdated", logs[len(logs)-1].Data)
 	suite.Assert().NoError(err)
 
-	signer.Socket = "0.0.0.0:2345"
+	suite.Require().NoError(signer.SetEndpoints("0.0.0.0:2345", nil))
 }
 
 func (suite *DASignersTestSuite) registerEpoch(testSigner *testutil.TestSigner, sk *big.Int) {
@@ -389,6 +412,330 @@ func (suite *DASignersTestSuite) Test_Params() {
 	suite.Assert().EqualValues(expected.EncodedSlices, params.EncodedSlices.Uint64())
 }
 
+func (suite *DASignersTestSuite) Test_RotateAndDeregister() {
+	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
+	chainID, err := etherminttypes.ParseChainID(suite.Ctx.ChainID())
+	suite.Require().NoError(err)
+	sk, newSk := big.NewInt(1), big.NewInt(2)
+	suite.Require().NoError(suite.dasignerskeeper.SetSigner(suite.Ctx, types.Signer{
+		Account:  suite.signerOne.HexAddr,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+		PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+	}))
+
+	// rotate key
+	pkG1 := bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), newSk))
+	pkG2 := bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), newSk))
+	hash := types.PubkeyRegistrationHash(suite.signerOne.Addr, chainID)
+	rotationHash := bn254util.MapToCurve(types.KeyRotationHash(suite.signerOne.Addr, pkG1, pkG2, 0, chainID))
+	input, err := suite.abi.Pack(
+		"rotateSignerKey",
+		dasignersprecompile.NewBN254G1Point(pkG1),
+		dasignersprecompile.NewBN254G2Point(pkG2),
+		dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, newSk))),
+		dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(rotationHash, sk))),
+	)
+	suite.Require().NoError(err)
+	oldLogs := suite.Statedb.Logs()
+	_, err = suite.runTx(input, suite.signerOne, 10000000)
+	suite.Require().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+	_, err = suite.abi.Unpack("SignerKeyRotated", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	signer, _, err := suite.dasignerskeeper.GetSigner(suite.Ctx, suite.signerOne.HexAddr)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(pkG1, signer.PubkeyG1)
+
+	// deregister
+	input, err = suite.abi.Pack("deregisterSigner")
+	suite.Require().NoError(err)
+	_, err = suite.runTx(input, suite.signerOne, 10000000)
+	suite.Require().NoError(err)
+	logs = suite.Statedb.Logs()
+	out, err := suite.abi.Unpack("SignerDeregistered", logs[len(logs)-1].Data)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(uint64(0), out[0].(*big.Int).Uint64())
+	ret, err := suite.runTx(input, suite.signerOne, 10000000)
+	suite.AssertCosmosRevert(ret, err, types.ErrSignerDeregistered)
+	ret, err = suite.runTx(input, suite.signerTwo, 10000000)
+	suite.AssertCosmosRevert(ret, err, types.ErrSignerNotFound)
+}
+
+func (suite *DASignersTestSuite) Test_VerifyQuorumSignature() {
+	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
+	sks := map[string]*big.Int{suite.signerOne.HexAddr: big.NewInt(1), suite.signerTwo.HexAddr: big.NewInt(11)}
+	for account, sk := range sks {
+		suite.Require().NoError(suite.dasignerskeeper.SetSigner(suite.Ctx, types.Signer{
+			Account:  account,
+			Socket:   "0.0.0.0:1234",
+			PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+			PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+		}))
+	}
+	suite.dasignerskeeper.SetEpochQuorums(suite.Ctx, 1, types.Quorums{
+		Quorums: []*types.Quorum{{Signers: []string{suite.signerOne.HexAddr, suite.signerTwo.HexAddr, suite.signerTwo.HexAddr}}},
+	})
+	messageHash := crypto.Keccak256Hash([]byte("blob"))
+	sk := sks[suite.signerTwo.HexAddr]
+	aggSig := new(bn254.G1Affine).ScalarMultiplication(bn254util.MapToCurve(messageHash), sk)
+	aggPkG2 := new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)
+
+	verify := func(hash common.Hash) []interface{} {
+		input, err := suite.abi.Pack(
+			"verifyQuorumSignature",
+			big.NewInt(1),
+			big.NewInt(0),
+			[]byte{0b010},
+			hash,
+			dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(aggSig)),
+			dasignersprecompile.NewBN254G2Point(bn254util.SerializeG2(aggPkG2)),
+		)
+		suite.Require().NoError(err)
+		bz, err := suite.runTx(input, suite.signerOne, 10000000)
+		suite.Require().NoError(err)
+		out, err := suite.abi.Methods["verifyQuorumSignature"].Outputs.Unpack(bz)
+		suite.Require().NoError(err)
+		return out
+	}
+	suite.Assert().EqualValues([]interface{}{true, big.NewInt(3), big.NewInt(2)}, verify(messageHash))
+	suite.Assert().EqualValues([]interface{}{false, big.NewInt(3), big.NewInt(2)}, verify(crypto.Keccak256Hash([]byte("other"))))
+}
+
+func (suite *DASignersTestSuite) Test_UpdateEndpoints() {
+	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
+	sk := big.NewInt(1)
+	signer := types.Signer{
+		Account:  suite.signerOne.HexAddr,
+		PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+		PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+	}
+	suite.Require().NoError(signer.SetEndpoints("0.0.0.0:1234", nil))
+	suite.Require().NoError(suite.dasignerskeeper.SetSigner(suite.Ctx, signer))
+
+	endpoints := []dasignersprecompile.IDASignersEndpoint{
+		{Kind: uint8(types.ENDPOINT_KIND_RETRIEVAL), Protocol: "https", Host: "retrieval.example.com", Port: 443, TlsFingerprint: common.LeftPadBytes([]byte{1}, 32)},
+		{Kind: uint8(types.ENDPOINT_KIND_DISPERSAL), Protocol: "grpc", Host: "dispersal.example.com", Port: 9000, TlsFingerprint: []byte{}},
+	}
+	input, err := suite.abi.Pack("updateEndpoints", endpoints)
+	suite.Require().NoError(err)
+	_, err = suite.runTx(input, suite.signerOne, 10000000)
+	suite.Require().NoError(err)
+	logs := suite.Statedb.Logs()
+	out, err := suite.abi.Unpack("SocketUpdated", logs[len(logs)-1].Data)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("dispersal.example.com:9000", out[0].(string))
+
+	suite.Assert().Equal([][]dasignersprecompile.IDASignersEndpoint{endpoints}, suite.queryGetSignerEndpoints(suite.signerOne.Addr))
+
+	// invalid endpoints are rejected
+	endpoints[1].Port = 0
+	input, err = suite.abi.Pack("updateEndpoints", endpoints)
+	suite.Require().NoError(err)
+	ret, err := suite.runTx(input, suite.signerOne, 10000000)
+	suite.AssertCosmosRevert(ret, err, types.ErrInvalidEndpoint)
+}
+
+func (suite *DASignersTestSuite) queryGetSignerEndpoints(accounts ...common.Address) [][]dasignersprecompile.IDASignersEndpoint {
+	input, err := suite.abi.Pack("getSignerEndpoints", accounts)
+	suite.Require().NoError(err)
+	bz, err := suite.runStaticCall(input, suite.signerOne, 10000000)
+	suite.Require().NoError(err)
+	out, err := suite.abi.Methods["getSignerEndpoints"].Outputs.Unpack(bz)
+	suite.Require().NoError(err)
+	return out[0].([][]dasignersprecompile.IDASignersEndpoint)
+}
+
+func (suite *DASignersTestSuite) Test_RegisterSignerWithEndpoints() {
+	// the signer detail is kept as deployed, the endpoints have their own methods
+	suite.Assert().Equal("7ca4dd5e", hex.EncodeToString(suite.abi.Methods["registerSigner"].ID))
+	suite.Assert().Equal("d1f5e5f8", hex.EncodeToString(suite.abi.Methods["getSigner"].ID))
+
+	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
+	params := suite.dasignerskeeper.GetParams(suite.Ctx)
+	suite.AddDelegation(suite.signerOne.HexAddr, suite.signerOne.HexAddr, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	sk := big.NewInt(1)
+	signer := &types.Signer{
+		Account:  suite.signerOne.HexAddr,
+		PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+		PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+	}
+	chainID, err := etherminttypes.ParseChainID(suite.Ctx.ChainID())
+	suite.Require().NoError(err)
+	signature := new(bn254.G1Affine).ScalarMultiplication(types.PubkeyRegistrationHash(suite.signerOne.Addr, chainID), sk)
+	endpoints := []dasignersprecompile.IDASignersEndpoint{
+		{Kind: uint8(types.ENDPOINT_KIND_DISPERSAL), Protocol: "grpc", Host: "dispersal.example.com", Port: 9000, TlsFingerprint: []byte{}},
+	}
+	input, err := suite.abi.Pack(
+		"registerSignerWithEndpoints",
+		dasignersprecompile.NewIDASignersSignerDetail(signer),
+		endpoints,
+		dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(signature)),
+	)
+	suite.Require().NoError(err)
+	_, err = suite.runTx(input, suite.signerOne, 10000000)
+	suite.Require().NoError(err)
+	logs := suite.Statedb.Logs()
+	out, err := suite.abi.Unpack("SocketUpdated", logs[len(logs)-1].Data)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("dispersal.example.com:9000", out[0].(string))
+
+	suite.Assert().Equal([][]dasignersprecompile.IDASignersEndpoint{endpoints}, suite.queryGetSignerEndpoints(suite.signerOne.Addr))
+
+	// the signer detail carries the socket derived from the endpoints
+	input, err = suite.abi.Pack("getSigner", []common.Address{suite.signerOne.Addr})
+	suite.Require().NoError(err)
+	bz, err := suite.runStaticCall(input, suite.signerOne, 10000000)
+	suite.Require().NoError(err)
+	out, err = suite.abi.Methods["getSigner"].Outputs.Unpack(bz)
+	suite.Require().NoError(err)
+	suite.Require().NoError(signer.SetEndpoints("", dasignersprecompile.ToSignerEndpoints(endpoints)))
+	suite.Assert().Equal([]dasignersprecompile.IDASignersSignerDetail{dasignersprecompile.NewIDASignersSignerDetail(signer)}, out[0])
+}
+
+func (suite *DASignersTestSuite) Test_ContractCallerAndOperator() {
+	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
+	sk := big.NewInt(1)
+	signer := types.Signer{
+		Account:  suite.signerOne.HexAddr,
+		PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+		PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+	}
+	suite.Require().NoError(signer.SetEndpoints("0.0.0.0:1234", nil))
+	suite.Require().NoError(suite.dasignerskeeper.SetSigner(suite.Ctx, signer))
+	pool := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	endpoints := []dasignersprecompile.IDASignersEndpoint{
+		{Kind: uint8(types.ENDPOINT_KIND_DISPERSAL), Protocol: "grpc", Host: "dispersal.example.com", Port: 9000, TlsFingerprint: []byte{}},
+	}
+	updateInput, err := suite.abi.Pack("updateEndpointsFor", suite.signerOne.Addr, endpoints)
+	suite.Require().NoError(err)
+
+	// the caller is authorized instead of the tx origin
+	ret, err := suite.runTxFrom(updateInput, suite.signerOne, pool, 10000000)
+	suite.AssertCosmosRevert(ret, err, types.ErrUnauthorizedOperator)
+	input, err := suite.abi.Pack("updateEndpoints", endpoints)
+	suite.Require().NoError(err)
+	ret, err = suite.runTxFrom(input, suite.signerOne, pool, 10000000)
+	suite.AssertCosmosRevert(ret, err, types.ErrSignerNotFound)
+
+	// only signers can set an operator
+	input, err = suite.abi.Pack("setOperator", suite.signerTwo.Addr)
+	suite.Require().NoError(err)
+	ret, err = suite.runTxFrom(input, suite.signerOne, pool, 10000000)
+	suite.AssertCosmosRevert(ret, err, types.ErrSignerNotFound)
+	input, err = suite.abi.Pack("setOperator", suite.signerOne.Addr)
+	suite.Require().NoError(err)
+	ret, err = suite.runTx(input, suite.signerOne, 10000000)
+	suite.AssertCosmosRevert(ret, err, types.ErrInvalidOperator)
+
+	// the signer authorizes the pool contract as its operator
+	input, err = suite.abi.Pack("setOperator", pool)
+	suite.Require().NoError(err)
+	_, err = suite.runTx(input, suite.signerOne, 10000000)
+	suite.Require().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().Equal(common.BytesToHash(suite.signerOne.Addr.Bytes()), logs[len(logs)-1].Topics[1])
+	out, err := suite.abi.Unpack("OperatorUpdated", logs[len(logs)-1].Data)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(pool, out[0].(common.Address))
+	suite.Assert().Equal(pool, suite.queryOperatorOf(suite.signerOne.Addr))
+
+	// the pool acts on behalf of the signer, whoever originates the tx
+	_, err = suite.runTxFrom(updateInput, suite.signerTwo, pool, 10000000)
+	suite.Require().NoError(err)
+	logs = suite.Statedb.Logs()
+	suite.Assert().Equal(common.BytesToHash(suite.signerOne.Addr.Bytes()), logs[len(logs)-1].Topics[1])
+	stored, found, err := suite.dasignerskeeper.GetSigner(suite.Ctx, suite.signerOne.HexAddr)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Assert().Equal("dispersal.example.com:9000", stored.Socket)
+
+	// the update is reverted along with the pool
+	endpoints[0].Port = 9001
+	revertedInput, err := suite.abi.Pack("updateEndpointsFor", suite.signerOne.Addr, endpoints)
+	suite.Require().NoError(err)
+	suite.DeployRevertingCaller(suite.dasigners, pool)
+	_, err = suite.RunContract(pool, revertedInput, suite.signerTwo, 10000000)
+	suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+	stored, found, err = suite.dasignerskeeper.GetSigner(suite.Ctx, suite.signerOne.HexAddr)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Assert().Equal("dispersal.example.com:9000", stored.Socket)
+
+	// the operator cannot change the operator
+	input, err = suite.abi.Pack("setOperator", suite.signerTwo.Addr)
+	suite.Require().NoError(err)
+	ret, err = suite.runTxFrom(input, suite.signerOne, pool, 10000000)
+	suite.AssertCosmosRevert(ret, err, types.ErrSignerNotFound)
+
+	// the zero address revokes the operator
+	input, err = suite.abi.Pack("setOperator", common.Address{})
+	suite.Require().NoError(err)
+	_, err = suite.runTx(input, suite.signerOne, 10000000)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(common.Address{}, suite.queryOperatorOf(suite.signerOne.Addr))
+	ret, err = suite.runTxFrom(updateInput, suite.signerTwo, pool, 10000000)
+	suite.AssertCosmosRevert(ret, err, types.ErrUnauthorizedOperator)
+}
+
+func (suite *DASignersTestSuite) queryOperatorOf(account common.Address) common.Address {
+	input, err := suite.abi.Pack("operatorOf", account)
+	suite.Require().NoError(err)
+	bz, err := suite.runTx(input, suite.signerOne, 10000000)
+	suite.Require().NoError(err)
+	out, err := suite.abi.Methods["operatorOf"].Outputs.Unpack(bz)
+	suite.Require().NoError(err)
+	return out[0].(common.Address)
+}
+
+func (suite *DASignersTestSuite) Test_StaticCallAndRevert() {
+	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
+
+	// mutating methods are rejected in static context before the arguments are decoded
+	for name, method := range suite.abi.Methods {
+		if method.IsConstant() {
+			continue
+		}
+		ret, err := suite.runStaticCall(method.ID, suite.signerOne, 10000000)
+		suite.AssertRevert(ret, err, precompiles_common.ErrWriteProtection(name))
+	}
+	input, err := suite.abi.Pack("isSigner", suite.signerOne.Addr)
+	suite.Require().NoError(err)
+	bz, err := suite.runStaticCall(input, suite.signerOne, 10000000)
+	suite.Require().NoError(err)
+	out, err := suite.abi.Methods["isSigner"].Outputs.Unpack(bz)
+	suite.Require().NoError(err)
+	suite.Assert().False(out[0].(bool))
+
+	// unknown selectors and malformed arguments
+	ret, err := suite.runTx([]byte{1, 2, 3}, suite.signerOne, 10000000)
+	suite.AssertRevert(ret, err, precompiles_common.ErrInvalidMethod([]byte{1, 2, 3}))
+	ret, err = suite.runTx([]byte{1, 2, 3, 4}, suite.signerOne, 10000000)
+	suite.AssertRevert(ret, err, precompiles_common.ErrInvalidMethod([]byte{1, 2, 3, 4}))
+	ret, err = suite.runTx(suite.abi.Methods["isSigner"].ID, suite.signerOne, 10000000)
+	suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+	suite.Assert().Equal(suite.abi.Errors["InvalidArguments"].ID.Bytes()[:4], ret[:4])
+
+	// the revert data decodes as the custom errors of the ABI
+	sk := big.NewInt(1)
+	signer := &types.Signer{
+		Account:  suite.signerOne.HexAddr,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+		PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+	}
+	input, err = suite.abi.Pack("registerSigner", dasignersprecompile.NewIDASignersSignerDetail(signer), dasignersprecompile.NewBN254G1Point(make([]byte, 64)))
+	suite.Require().NoError(err)
+	ret, err = suite.runTx(input, suite.signerTwo, 10000000)
+	suite.AssertRevert(ret, err, suite.dasigners.ErrInvalidSender(suite.signerTwo.Addr, suite.signerOne.Addr))
+	invalidSender := suite.abi.Errors["InvalidSender"]
+	unpacked, err := invalidSender.Unpack(ret)
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]interface{}{suite.signerTwo.Addr, suite.signerOne.Addr}, unpacked)
+}
+
 func TestKeeperSuite(t *testing.T) {
 	suite.Run(t, new(DASignersTestSuite))
 }
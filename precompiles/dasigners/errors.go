@@ -1,5 +1,14 @@
 package dasigners
 
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 const (
-	ErrInvalidSender = "sender address %s is not the same as signer address %s"
+	ErrorInvalidSender = "InvalidSender"
 )
+
+func (d *DASignersPrecompile) ErrInvalidSender(sender common.Address, signer common.Address) *precopmiles_common.RevertError {
+	return precopmiles_common.NewRevertError(d.ABI.Errors[ErrorInvalidSender], sender, signer)
+}
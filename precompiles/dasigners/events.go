@@ -1,6 +1,8 @@
 package dasigners
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -9,12 +11,15 @@ import (
 )
 
 const (
-	NewSignerEvent     = "NewSigner"
-	SocketUpdatedEvent = "SocketUpdated"
+	NewSignerEvent          = "NewSigner"
+	SocketUpdatedEvent      = "SocketUpdated"
+	SignerKeyRotatedEvent   = "SignerKeyRotated"
+	SignerDeregisteredEvent = "SignerDeregistered"
+	OperatorUpdatedEvent    = "OperatorUpdated"
 )
 
 func (d *DASignersPrecompile) EmitNewSignerEvent(ctx sdk.Context, stateDB *statedb.StateDB, signer IDASignersSignerDetail) error {
-	event := d.abi.Events[NewSignerEvent]
+	event := d.ABI.Events[NewSignerEvent]
 	quries := make([]interface{}, 2)
 	quries[0] = event.ID
 	quries[1] = signer.Signer
@@ -37,7 +42,7 @@ func (d *DASignersPrecompile) EmitNewSignerEvent(ctx sdk.Context, stateDB *state
 }
 
 func (d *DASignersPrecompile) EmitSocketUpdatedEvent(ctx sdk.Context, stateDB *statedb.StateDB, signer common.Address, socket string) error {
-	event := d.abi.Events[SocketUpdatedEvent]
+	event := d.ABI.Events[SocketUpdatedEvent]
 	quries := make([]interface{}, 2)
 	quries[0] = event.ID
 	quries[1] = signer
@@ -58,3 +63,72 @@ func (d *DASignersPrecompile) EmitSocketUpdatedEvent(ctx sdk.Context, stateDB *s
 	})
 	return nil
 }
+
+func (d *DASignersPrecompile) EmitSignerKeyRotatedEvent(ctx sdk.Context, stateDB *statedb.StateDB, signer common.Address, pkG1 BN254G1Point, pkG2 BN254G2Point) error {
+	event := d.ABI.Events[SignerKeyRotatedEvent]
+	quries := make([]interface{}, 2)
+	quries[0] = event.ID
+	quries[1] = signer
+	topics, err := abi.MakeTopics(quries)
+	if err != nil {
+		return err
+	}
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	b, err := arguments.Pack(pkG1, pkG2)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     d.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func (d *DASignersPrecompile) EmitSignerDeregisteredEvent(ctx sdk.Context, stateDB *statedb.StateDB, signer common.Address, epoch uint64) error {
+	event := d.ABI.Events[SignerDeregisteredEvent]
+	quries := make([]interface{}, 2)
+	quries[0] = event.ID
+	quries[1] = signer
+	topics, err := abi.MakeTopics(quries)
+	if err != nil {
+		return err
+	}
+	arguments := abi.Arguments{event.Inputs[1]}
+	b, err := arguments.Pack(new(big.Int).SetUint64(epoch))
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     d.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func (d *DASignersPrecompile) EmitOperatorUpdatedEvent(ctx sdk.Context, stateDB *statedb.StateDB, signer common.Address, operator common.Address) error {
+	event := d.ABI.Events[OperatorUpdatedEvent]
+	quries := make([]interface{}, 2)
+	quries[0] = event.ID
+	quries[1] = signer
+	topics, err := abi.MakeTopics(quries)
+	if err != nil {
+		return err
+	}
+	arguments := abi.Arguments{event.Inputs[1]}
+	b, err := arguments.Pack(operator)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     d.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
@@ -0,0 +1,84 @@
+package dasigners
+
+import (
+	"math"
+	"math/bits"
+
+	dasignerstypes "github.com/0glabs/0g-chain/x/dasigners/v1/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// pairingChecks is the number of pairing checks done by verifyQuorumSignature, one for the equality of the
+// aggregated public keys and one for the signature.
+const pairingChecks = 2
+
+func (d *DASignersPrecompile) gasParams(ctx sdk.Context) dasignerstypes.GasParams {
+	return d.dasignersKeeper.GetParams(ctx).GasParams
+}
+
+// GetSignerGas charges each requested account.
+func (d *DASignersPrecompile) GetSignerGas(ctx sdk.Context, args []interface{}) (uint64, error) {
+	if len(args) != 1 {
+		return 0, nil
+	}
+	accounts := args[0].([]common.Address)
+	return mulGas(d.gasParams(ctx).PerAccount, uint64(len(accounts))), nil
+}
+
+// GetQuorumGas charges each member of the quorum returned, the errors of unknown quorums are left to the query.
+func (d *DASignersPrecompile) GetQuorumGas(ctx sdk.Context, args []interface{}) (uint64, error) {
+	req, err := NewQueryEpochQuorumRequest(args)
+	if err != nil {
+		return 0, nil
+	}
+	quorum, err := d.dasignersKeeper.GetEpochQuorum(ctx, req.EpochNumber, req.QuorumId)
+	if err != nil {
+		return 0, nil
+	}
+	return mulGas(d.gasParams(ctx).PerQuorumMember, uint64(len(quorum.Signers))), nil
+}
+
+// GetAggPkG1Gas charges each byte of the bitmap and each quorum member aggregated.
+func (d *DASignersPrecompile) GetAggPkG1Gas(ctx sdk.Context, args []interface{}) (uint64, error) {
+	if len(args) != 3 {
+		return 0, nil
+	}
+	return bitmapGas(d.gasParams(ctx), args[2].([]byte)), nil
+}
+
+// VerifyQuorumSignatureGas charges the aggregation of the bitmap and the pairing checks.
+func (d *DASignersPrecompile) VerifyQuorumSignatureGas(ctx sdk.Context, args []interface{}) (uint64, error) {
+	if len(args) != 6 {
+		return 0, nil
+	}
+	params := d.gasParams(ctx)
+	return addGas(bitmapGas(params, args[2].([]byte)), mulGas(params.PerPairing, pairingChecks)), nil
+}
+
+// bitmapGas returns the gas of reading the bitmap and aggregating the public keys of the members marked in it.
+func bitmapGas(params dasignerstypes.GasParams, bitmap []byte) uint64 {
+	members := 0
+	for _, b := range bitmap {
+		members += bits.OnesCount8(b)
+	}
+	return addGas(mulGas(params.PerBitmapByte, uint64(len(bitmap))), mulGas(params.PerQuorumMember, uint64(members)))
+}
+
+// mulGas multiplies the gas saturating at the max uint64, the call runs out of gas instead of overflowing.
+func mulGas(gas uint64, count uint64) uint64 {
+	hi, lo := bits.Mul64(gas, count)
+	if hi != 0 {
+		return math.MaxUint64
+	}
+	return lo
+}
+
+// addGas adds the gas saturating at the max uint64.
+func addGas(a uint64, b uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return math.MaxUint64
+	}
+	return sum
+}
@@ -0,0 +1,187 @@
+package dasigners_test
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/chaincfg"
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	dasignersprecompile "github.com/0glabs/0g-chain/precompiles/dasigners"
+	"github.com/0glabs/0g-chain/precompiles/testutil"
+	dasignerskeeper "github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+)
+
+var gasTestParams = types.GasParams{
+	PerAccount:      1000,
+	PerBitmapByte:   10,
+	PerQuorumMember: 100,
+	PerPairing:      10000,
+}
+
+// quorumFixture holds a quorum of epoch 1 whose signers have the secret keys 1..n.
+type quorumFixture struct {
+	signers []common.Address
+	bitmap  []byte
+	hash    common.Hash
+	aggSig  dasignersprecompile.BN254G1Point
+	aggPkG2 dasignersprecompile.BN254G2Point
+}
+
+func setupQuorum(ctx sdk.Context, k dasignerskeeper.Keeper, size int) (quorumFixture, error) {
+	fixture := quorumFixture{
+		signers: make([]common.Address, size),
+		bitmap:  make([]byte, (size+7)/8),
+		hash:    crypto.Keccak256Hash([]byte("blob")),
+	}
+	accounts := make([]string, size)
+	skSum := new(big.Int)
+	for i := 0; i < size; i++ {
+		sk := big.NewInt(int64(i + 1))
+		skSum.Add(skSum, sk)
+		fixture.signers[i] = testutil.GenSigner().Addr
+		fixture.bitmap[i/8] |= 1 << (i % 8)
+		accounts[i] = dasignersprecompile.ToLowerHexWithoutPrefix(fixture.signers[i])
+		if err := k.SetSigner(ctx, types.Signer{
+			Account:  accounts[i],
+			Socket:   "0.0.0.0:1234",
+			PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+			PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+		}); err != nil {
+			return quorumFixture{}, err
+		}
+	}
+	k.SetEpochQuorums(ctx, 1, types.Quorums{Quorums: []*types.Quorum{{Signers: accounts}}})
+	aggSig := new(bn254.G1Affine).ScalarMultiplication(bn254util.MapToCurve(fixture.hash), skSum)
+	aggPkG2 := new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), skSum)
+	fixture.aggSig = dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(aggSig))
+	fixture.aggPkG2 = dasignersprecompile.NewBN254G2Point(bn254util.SerializeG2(aggPkG2))
+	return fixture, nil
+}
+
+// inputs returns the packed calls of the methods whose gas scales with the quorum size.
+func (f quorumFixture) inputs(contractABI abi.ABI) (map[string][]byte, error) {
+	args := map[string][]interface{}{
+		"getSigner":             {f.signers},
+		"getQuorum":             {big.NewInt(1), big.NewInt(0)},
+		"getAggPkG1":            {big.NewInt(1), big.NewInt(0), f.bitmap},
+		"verifyQuorumSignature": {big.NewInt(1), big.NewInt(0), f.bitmap, f.hash, f.aggSig, f.aggPkG2},
+	}
+	inputs := make(map[string][]byte)
+	for name, methodArgs := range args {
+		input, err := contractABI.Pack(name, methodArgs...)
+		if err != nil {
+			return nil, err
+		}
+		inputs[name] = input
+	}
+	return inputs, nil
+}
+
+func (suite *DASignersTestSuite) Test_Gas() {
+	params := suite.dasignerskeeper.GetParams(suite.Ctx)
+	suite.Assert().Equal(types.DefaultGasParams(), params.GasParams)
+	params.GasParams = gasTestParams
+	suite.dasignerskeeper.SetParams(suite.Ctx, params)
+
+	size := 16
+	fixture, err := setupQuorum(suite.Ctx, suite.dasignerskeeper, size)
+	suite.Require().NoError(err)
+	inputs, err := fixture.inputs(suite.abi)
+	suite.Require().NoError(err)
+
+	// gas charged before the execution according to the inputs
+	bitmapGas := uint64(len(fixture.bitmap))*gasTestParams.PerBitmapByte + uint64(size)*gasTestParams.PerQuorumMember
+	inputGas := map[string]uint64{
+		"getSigner":             uint64(size) * gasTestParams.PerAccount,
+		"getQuorum":             uint64(size) * gasTestParams.PerQuorumMember,
+		"getAggPkG1":            bitmapGas,
+		"verifyQuorumSignature": bitmapGas + 2*gasTestParams.PerPairing,
+	}
+	for name, gas := range inputGas {
+		suite.Run(name, func() {
+			_, err := suite.runTx(inputs[name], suite.signerOne, gas-1)
+			suite.Require().ErrorIs(err, vm.ErrOutOfGas)
+			_, err = suite.runTx(inputs[name], suite.signerOne, gas+1000000)
+			suite.Require().NoError(err)
+		})
+	}
+
+	// the store accesses are charged as well
+	_, err = suite.runTx(inputs["getSigner"], suite.signerOne, inputGas["getSigner"])
+	suite.Require().ErrorIs(err, vm.ErrOutOfGas)
+}
+
+// newBenchmarkQuorum returns the precompile and the inputs over a quorum of the given size.
+func newBenchmarkQuorum(b *testing.B, size int) (*dasignersprecompile.DASignersPrecompile, *vm.EVM, map[string][]byte) {
+	chaincfg.SetSDKConfig()
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, ChainID: app.TestChainId})
+
+	fixture, err := setupQuorum(ctx, tApp.GetDASignersKeeper(), size)
+	require.NoError(b, err)
+	contractABI, err := abi.JSON(strings.NewReader(dasignersprecompile.DASignersABI))
+	require.NoError(b, err)
+	inputs, err := fixture.inputs(contractABI)
+	require.NoError(b, err)
+
+	evmKeeper := tApp.GetEvmKeeper()
+	precompile := evmKeeper.GetPrecompiles()[common.HexToAddress(dasignersprecompile.PrecompileAddress)]
+	stateDB := statedb.New(ctx, evmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes())))
+	evm := vm.NewEVM(vm.BlockContext{}, vm.TxContext{}, stateDB, params.TestChainConfig, vm.Config{})
+	return precompile.(*dasignersprecompile.DASignersPrecompile), evm, inputs
+}
+
+// benchmarkGas runs the method over quorums of increasing sizes, the time per gas should stay about the same
+// as the gas scales with the work.
+func benchmarkGas(b *testing.B, name string) {
+	for _, size := range []int{1, 16, 128, 1024} {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			precompile, evm, inputs := newBenchmarkQuorum(b, size)
+			input := inputs[name]
+			gasLimit := uint64(1_000_000_000)
+			var gasUsed uint64
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				contract := vm.NewPrecompile(vm.AccountRef(common.Address{}), vm.AccountRef(precompile.Address()), big.NewInt(0), gasLimit)
+				contract.Input = input
+				if _, err := precompile.Run(evm, contract, true); err != nil {
+					b.Fatal(err)
+				}
+				gasUsed = precompile.RequiredGas(input) + gasLimit - contract.Gas
+			}
+			b.ReportMetric(float64(gasUsed), "gas/op")
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N)/float64(gasUsed), "ns/gas")
+		})
+	}
+}
+
+func BenchmarkGetSigner(b *testing.B) {
+	benchmarkGas(b, "getSigner")
+}
+
+func BenchmarkGetQuorum(b *testing.B) {
+	benchmarkGas(b, "getQuorum")
+}
+
+func BenchmarkGetAggPkG1(b *testing.B) {
+	benchmarkGas(b, "getAggPkG1")
+}
+
+func BenchmarkVerifyQuorumSignature(b *testing.B) {
+	benchmarkGas(b, "verifyQuorumSignature")
+}
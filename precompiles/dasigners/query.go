@@ -1,7 +1,6 @@
 package dasigners
 
 import (
-	"fmt"
 	"math/big"
 
 	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
@@ -58,9 +57,25 @@ func (d *DASignersPrecompile) GetSigner(ctx sdk.Context, _ *vm.EVM, method *abi.
 	return method.Outputs.Pack(signers)
 }
 
+func (d *DASignersPrecompile) GetSignerEndpoints(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQuerySignerRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	response, err := d.dasignersKeeper.Signer(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	endpoints := make([][]IDASignersEndpoint, len(response.Signer))
+	for i, signer := range response.Signer {
+		endpoints[i] = NewIDASignersEndpoints(signer.Endpoints)
+	}
+	return method.Outputs.Pack(endpoints)
+}
+
 func (d *DASignersPrecompile) IsSigner(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
 	if len(args) != 1 {
-		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(1, len(args))
 	}
 	account := ToLowerHexWithoutPrefix(args[0].(common.Address))
 	_, found, err := d.dasignersKeeper.GetSigner(ctx, account)
@@ -70,9 +85,24 @@ func (d *DASignersPrecompile) IsSigner(ctx sdk.Context, _ *vm.EVM, method *abi.M
 	return method.Outputs.Pack(found)
 }
 
+func (d *DASignersPrecompile) OperatorOf(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(1, len(args))
+	}
+	account := ToLowerHexWithoutPrefix(args[0].(common.Address))
+	operator, found, err := d.dasignersKeeper.GetSignerOperator(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return method.Outputs.Pack(common.Address{})
+	}
+	return method.Outputs.Pack(common.HexToAddress(operator))
+}
+
 func (d *DASignersPrecompile) RegisteredEpoch(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
 	if len(args) != 2 {
-		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(2, len(args))
 	}
 	account := ToLowerHexWithoutPrefix(args[0].(common.Address))
 	epoch := args[1].(*big.Int).Uint64()
@@ -122,3 +152,15 @@ func (d *DASignersPrecompile) GetAggPkG1(ctx sdk.Context, _ *vm.EVM, method *abi
 	}
 	return method.Outputs.Pack(NewBN254G1Point(response.AggregatePubkeyG1), big.NewInt(int64(response.Total)), big.NewInt(int64(response.Hit)))
 }
+
+func (d *DASignersPrecompile) VerifyQuorumSignature(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQueryVerifyQuorumSignatureRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	response, err := d.dasignersKeeper.VerifyQuorumSignature(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(response.Valid, big.NewInt(int64(response.Total)), big.NewInt(int64(response.Hit)))
+}
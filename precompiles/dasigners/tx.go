@@ -1,39 +1,67 @@
 package dasigners
 
 import (
-	"fmt"
-
+	dasignerstypes "github.com/0glabs/0g-chain/x/dasigners/v1/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/ethermint/x/evm/statedb"
 )
 
-func (d *DASignersPrecompile) RegisterSigner(ctx sdk.Context, evm *vm.EVM, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+func (d *DASignersPrecompile) RegisterSigner(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
 	msg, err := NewMsgRegisterSigner(args)
 	if err != nil {
 		return nil, err
 	}
+	return d.registerSigner(ctx, contract, stateDB, method, args[0].(IDASignersSignerDetail), msg)
+}
+
+func (d *DASignersPrecompile) RegisterSignerWithEndpoints(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgRegisterSignerWithEndpoints(args)
+	if err != nil {
+		return nil, err
+	}
+	return d.registerSigner(ctx, contract, stateDB, method, args[0].(IDASignersSignerDetail), msg)
+}
+
+func (d *DASignersPrecompile) registerSigner(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, signer IDASignersSignerDetail, msg *dasignerstypes.MsgRegisterSigner) ([]byte, error) {
 	// validation
-	sender := ToLowerHexWithoutPrefix(evm.Origin)
-	if sender != msg.Signer.Account {
-		return nil, fmt.Errorf(ErrInvalidSender, sender, msg.Signer.Account)
+	if ToLowerHexWithoutPrefix(contract.Caller()) != msg.Signer.Account {
+		return nil, d.ErrInvalidSender(contract.Caller(), common.HexToAddress(msg.Signer.Account))
 	}
 	// execute
-	_, err = d.dasignersKeeper.RegisterSigner(sdk.WrapSDKContext(ctx), msg)
+	_, err := d.dasignersKeeper.RegisterSigner(sdk.WrapSDKContext(ctx), msg)
 	if err != nil {
 		return nil, err
 	}
-	// emit events
-	err = d.EmitNewSignerEvent(ctx, stateDB, args[0].(IDASignersSignerDetail))
+	// emit events, the socket may be derived from the endpoints
+	signer.Socket, err = d.getSocket(ctx, msg.Signer.Account)
+	if err != nil {
+		return nil, err
+	}
+	err = d.EmitNewSignerEvent(ctx, stateDB, signer)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (d *DASignersPrecompile) RegisterNextEpoch(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgRegisterNextEpoch(args, ToLowerHexWithoutPrefix(contract.Caller()))
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = d.dasignersKeeper.RegisterNextEpoch(sdk.WrapSDKContext(ctx), msg)
 	if err != nil {
 		return nil, err
 	}
 	return method.Outputs.Pack()
 }
 
-func (d *DASignersPrecompile) RegisterNextEpoch(ctx sdk.Context, evm *vm.EVM, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
-	msg, err := NewMsgRegisterNextEpoch(args, ToLowerHexWithoutPrefix(evm.Origin))
+func (d *DASignersPrecompile) RegisterNextEpochFor(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgRegisterNextEpochFor(args, ToLowerHexWithoutPrefix(contract.Caller()))
 	if err != nil {
 		return nil, err
 	}
@@ -45,18 +73,112 @@ func (d *DASignersPrecompile) RegisterNextEpoch(ctx sdk.Context, evm *vm.EVM, st
 	return method.Outputs.Pack()
 }
 
-func (d *DASignersPrecompile) UpdateSocket(ctx sdk.Context, evm *vm.EVM, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
-	msg, err := NewMsgUpdateSocket(args, ToLowerHexWithoutPrefix(evm.Origin))
+func (d *DASignersPrecompile) UpdateSocket(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgUpdateSocket(args, ToLowerHexWithoutPrefix(contract.Caller()))
+	if err != nil {
+		return nil, err
+	}
+	return d.updateSocket(ctx, stateDB, method, msg)
+}
+
+func (d *DASignersPrecompile) UpdateEndpoints(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgUpdateEndpoints(args, ToLowerHexWithoutPrefix(contract.Caller()))
+	if err != nil {
+		return nil, err
+	}
+	return d.updateSocket(ctx, stateDB, method, msg)
+}
+
+func (d *DASignersPrecompile) UpdateEndpointsFor(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgUpdateEndpointsFor(args, ToLowerHexWithoutPrefix(contract.Caller()))
+	if err != nil {
+		return nil, err
+	}
+	return d.updateSocket(ctx, stateDB, method, msg)
+}
+
+func (d *DASignersPrecompile) updateSocket(ctx sdk.Context, stateDB *statedb.StateDB, method *abi.Method, msg *dasignerstypes.MsgUpdateSocket) ([]byte, error) {
+	// execute
+	_, err := d.dasignersKeeper.UpdateSocket(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	socket, err := d.getSocket(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	err = d.EmitSocketUpdatedEvent(ctx, stateDB, common.HexToAddress(msg.Account), socket)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+// getSocket returns the stored socket of the signer
+func (d *DASignersPrecompile) getSocket(ctx sdk.Context, account string) (string, error) {
+	signer, found, err := d.dasignersKeeper.GetSigner(ctx, account)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", dasignerstypes.ErrSignerNotFound
+	}
+	return signer.Socket, nil
+}
+
+func (d *DASignersPrecompile) RotateSignerKey(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgRotateSignerKey(args, ToLowerHexWithoutPrefix(contract.Caller()))
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = d.dasignersKeeper.RotateSignerKey(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = d.EmitSignerKeyRotatedEvent(ctx, stateDB, contract.Caller(), args[0].(BN254G1Point), args[1].(BN254G2Point))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (d *DASignersPrecompile) DeregisterSigner(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgDeregisterSigner(args, ToLowerHexWithoutPrefix(contract.Caller()))
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = d.dasignersKeeper.DeregisterSigner(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	epochNumber, err := d.dasignersKeeper.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = d.EmitSignerDeregisteredEvent(ctx, stateDB, contract.Caller(), epochNumber)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (d *DASignersPrecompile) SetOperator(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgSetOperator(args, ToLowerHexWithoutPrefix(contract.Caller()))
 	if err != nil {
 		return nil, err
 	}
 	// execute
-	_, err = d.dasignersKeeper.UpdateSocket(sdk.WrapSDKContext(ctx), msg)
+	_, err = d.dasignersKeeper.SetOperator(sdk.WrapSDKContext(ctx), msg)
 	if err != nil {
 		return nil, err
 	}
 	// emit events
-	err = d.EmitSocketUpdatedEvent(ctx, stateDB, evm.Origin, args[0].(string))
+	err = d.EmitOperatorUpdatedEvent(ctx, stateDB, contract.Caller(), args[0].(common.Address))
 	if err != nil {
 		return nil, err
 	}
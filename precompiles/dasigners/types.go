@@ -1,7 +1,6 @@
 package dasigners
 
 import (
-	"fmt"
 	"math/big"
 	"strings"
 
@@ -20,6 +19,14 @@ type BN254G2Point = struct {
 	Y [2]*big.Int "json:\"Y\""
 }
 
+type IDASignersEndpoint = struct {
+	Kind           uint8  "json:\"kind\""
+	Protocol       string "json:\"protocol\""
+	Host           string "json:\"host\""
+	Port           uint16 "json:\"port\""
+	TlsFingerprint []byte "json:\"tlsFingerprint\""
+}
+
 type IDASignersSignerDetail = struct {
 	Signer common.Address "json:\"signer\""
 	Socket string         "json:\"socket\""
@@ -73,7 +80,7 @@ func SerializeG2(p BN254G2Point) []byte {
 
 func NewQueryQuorumCountRequest(args []interface{}) (*dasignerstypes.QueryQuorumCountRequest, error) {
 	if len(args) != 1 {
-		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(1, len(args))
 	}
 
 	return &dasignerstypes.QueryQuorumCountRequest{
@@ -83,7 +90,7 @@ func NewQueryQuorumCountRequest(args []interface{}) (*dasignerstypes.QueryQuorum
 
 func NewQuerySignerRequest(args []interface{}) (*dasignerstypes.QuerySignerRequest, error) {
 	if len(args) != 1 {
-		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(1, len(args))
 	}
 	accounts := args[0].([]common.Address)
 	req := dasignerstypes.QuerySignerRequest{
@@ -97,7 +104,7 @@ func NewQuerySignerRequest(args []interface{}) (*dasignerstypes.QuerySignerReque
 
 func NewQueryEpochQuorumRequest(args []interface{}) (*dasignerstypes.QueryEpochQuorumRequest, error) {
 	if len(args) != 2 {
-		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(2, len(args))
 	}
 
 	return &dasignerstypes.QueryEpochQuorumRequest{
@@ -108,7 +115,7 @@ func NewQueryEpochQuorumRequest(args []interface{}) (*dasignerstypes.QueryEpochQ
 
 func NewQueryEpochQuorumRowRequest(args []interface{}) (*dasignerstypes.QueryEpochQuorumRowRequest, error) {
 	if len(args) != 3 {
-		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(3, len(args))
 	}
 
 	return &dasignerstypes.QueryEpochQuorumRowRequest{
@@ -120,7 +127,7 @@ func NewQueryEpochQuorumRowRequest(args []interface{}) (*dasignerstypes.QueryEpo
 
 func NewQueryAggregatePubkeyG1Request(args []interface{}) (*dasignerstypes.QueryAggregatePubkeyG1Request, error) {
 	if len(args) != 3 {
-		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(3, len(args))
 	}
 
 	return &dasignerstypes.QueryAggregatePubkeyG1Request{
@@ -130,6 +137,22 @@ func NewQueryAggregatePubkeyG1Request(args []interface{}) (*dasignerstypes.Query
 	}, nil
 }
 
+func NewQueryVerifyQuorumSignatureRequest(args []interface{}) (*dasignerstypes.QueryVerifyQuorumSignatureRequest, error) {
+	if len(args) != 6 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(6, len(args))
+	}
+
+	messageHash := args[3].([32]byte)
+	return &dasignerstypes.QueryVerifyQuorumSignatureRequest{
+		EpochNumber:        args[0].(*big.Int).Uint64(),
+		QuorumId:           args[1].(*big.Int).Uint64(),
+		QuorumBitmap:       args[2].([]byte),
+		MessageHash:        messageHash[:],
+		AggregateSignature: SerializeG1(args[4].(BN254G1Point)),
+		AggregatePubkeyG2:  SerializeG2(args[5].(BN254G2Point)),
+	}, nil
+}
+
 func NewIDASignersSignerDetail(signer *dasignerstypes.Signer) IDASignersSignerDetail {
 	return IDASignersSignerDetail{
 		Signer: common.HexToAddress(signer.Account),
@@ -139,30 +162,70 @@ func NewIDASignersSignerDetail(signer *dasignerstypes.Signer) IDASignersSignerDe
 	}
 }
 
+func NewIDASignersEndpoints(endpoints []*dasignerstypes.SignerEndpoint) []IDASignersEndpoint {
+	result := make([]IDASignersEndpoint, len(endpoints))
+	for i, endpoint := range endpoints {
+		result[i] = IDASignersEndpoint{
+			Kind:           uint8(endpoint.Kind),
+			Protocol:       endpoint.Protocol,
+			Host:           endpoint.Host,
+			Port:           uint16(endpoint.Port),
+			TlsFingerprint: endpoint.TlsFingerprint,
+		}
+	}
+	return result
+}
+
+func ToSignerEndpoints(endpoints []IDASignersEndpoint) []*dasignerstypes.SignerEndpoint {
+	result := make([]*dasignerstypes.SignerEndpoint, len(endpoints))
+	for i, endpoint := range endpoints {
+		result[i] = &dasignerstypes.SignerEndpoint{
+			Kind:           dasignerstypes.EndpointKind(endpoint.Kind),
+			Protocol:       endpoint.Protocol,
+			Host:           endpoint.Host,
+			Port:           uint32(endpoint.Port),
+			TlsFingerprint: endpoint.TlsFingerprint,
+		}
+	}
+	return result
+}
+
 func ToLowerHexWithoutPrefix(addr common.Address) string {
 	return strings.ToLower(addr.Hex()[2:])
 }
 
 func NewMsgRegisterSigner(args []interface{}) (*dasignerstypes.MsgRegisterSigner, error) {
 	if len(args) != 2 {
-		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(2, len(args))
+	}
+
+	return newMsgRegisterSigner(args[0].(IDASignersSignerDetail), nil, args[1].(BN254G1Point)), nil
+}
+
+func NewMsgRegisterSignerWithEndpoints(args []interface{}) (*dasignerstypes.MsgRegisterSigner, error) {
+	if len(args) != 3 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(3, len(args))
 	}
 
-	signer := args[0].(IDASignersSignerDetail)
+	return newMsgRegisterSigner(args[0].(IDASignersSignerDetail), args[1].([]IDASignersEndpoint), args[2].(BN254G1Point)), nil
+}
+
+func newMsgRegisterSigner(signer IDASignersSignerDetail, endpoints []IDASignersEndpoint, signature BN254G1Point) *dasignerstypes.MsgRegisterSigner {
 	return &dasignerstypes.MsgRegisterSigner{
 		Signer: &dasignerstypes.Signer{
-			Account:  ToLowerHexWithoutPrefix(signer.Signer),
-			Socket:   signer.Socket,
-			PubkeyG1: SerializeG1(signer.PkG1),
-			PubkeyG2: SerializeG2(signer.PkG2),
+			Account:   ToLowerHexWithoutPrefix(signer.Signer),
+			Socket:    signer.Socket,
+			PubkeyG1:  SerializeG1(signer.PkG1),
+			PubkeyG2:  SerializeG2(signer.PkG2),
+			Endpoints: ToSignerEndpoints(endpoints),
 		},
-		Signature: SerializeG1(args[1].(BN254G1Point)),
-	}, nil
+		Signature: SerializeG1(signature),
+	}
 }
 
 func NewMsgRegisterNextEpoch(args []interface{}, account string) (*dasignerstypes.MsgRegisterNextEpoch, error) {
 	if len(args) != 1 {
-		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(1, len(args))
 	}
 
 	return &dasignerstypes.MsgRegisterNextEpoch{
@@ -171,9 +234,22 @@ func NewMsgRegisterNextEpoch(args []interface{}, account string) (*dasignerstype
 	}, nil
 }
 
+func NewMsgRegisterNextEpochFor(args []interface{}, operator string) (*dasignerstypes.MsgRegisterNextEpoch, error) {
+	if len(args) != 2 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(2, len(args))
+	}
+
+	account := ToLowerHexWithoutPrefix(args[0].(common.Address))
+	return &dasignerstypes.MsgRegisterNextEpoch{
+		Account:   account,
+		Signature: SerializeG1(args[1].(BN254G1Point)),
+		Operator:  operatorOrEmpty(account, operator),
+	}, nil
+}
+
 func NewMsgUpdateSocket(args []interface{}, account string) (*dasignerstypes.MsgUpdateSocket, error) {
 	if len(args) != 1 {
-		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(1, len(args))
 	}
 
 	return &dasignerstypes.MsgUpdateSocket{
@@ -181,3 +257,73 @@ func NewMsgUpdateSocket(args []interface{}, account string) (*dasignerstypes.Msg
 		Socket:  args[0].(string),
 	}, nil
 }
+
+func NewMsgUpdateEndpoints(args []interface{}, account string) (*dasignerstypes.MsgUpdateSocket, error) {
+	if len(args) != 1 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(1, len(args))
+	}
+
+	return &dasignerstypes.MsgUpdateSocket{
+		Account:   account,
+		Endpoints: ToSignerEndpoints(args[0].([]IDASignersEndpoint)),
+	}, nil
+}
+
+func NewMsgUpdateEndpointsFor(args []interface{}, operator string) (*dasignerstypes.MsgUpdateSocket, error) {
+	if len(args) != 2 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(2, len(args))
+	}
+
+	account := ToLowerHexWithoutPrefix(args[0].(common.Address))
+	return &dasignerstypes.MsgUpdateSocket{
+		Account:   account,
+		Endpoints: ToSignerEndpoints(args[1].([]IDASignersEndpoint)),
+		Operator:  operatorOrEmpty(account, operator),
+	}, nil
+}
+
+func NewMsgRotateSignerKey(args []interface{}, account string) (*dasignerstypes.MsgRotateSignerKey, error) {
+	if len(args) != 4 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(4, len(args))
+	}
+
+	return &dasignerstypes.MsgRotateSignerKey{
+		Account:         account,
+		PubkeyG1:        SerializeG1(args[0].(BN254G1Point)),
+		PubkeyG2:        SerializeG2(args[1].(BN254G2Point)),
+		NewKeySignature: SerializeG1(args[2].(BN254G1Point)),
+		OldKeySignature: SerializeG1(args[3].(BN254G1Point)),
+	}, nil
+}
+
+func NewMsgDeregisterSigner(args []interface{}, account string) (*dasignerstypes.MsgDeregisterSigner, error) {
+	if len(args) != 0 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(0, len(args))
+	}
+
+	return &dasignerstypes.MsgDeregisterSigner{
+		Account: account,
+	}, nil
+}
+
+func NewMsgSetOperator(args []interface{}, account string) (*dasignerstypes.MsgSetOperator, error) {
+	if len(args) != 1 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(1, len(args))
+	}
+
+	// the zero address revokes the operator
+	operator := args[0].(common.Address)
+	msg := &dasignerstypes.MsgSetOperator{Account: account}
+	if operator != (common.Address{}) {
+		msg.Operator = ToLowerHexWithoutPrefix(operator)
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// operatorOrEmpty returns the caller as the operator unless it is the signer account itself
+func operatorOrEmpty(account string, caller string) string {
+	if account == caller {
+		return ""
+	}
+	return caller
+}
@@ -0,0 +1,55 @@
+package staking
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const (
+	DelegateEvent        = "Delegate"
+	UndelegateEvent      = "Undelegate"
+	RedelegateEvent      = "Redelegate"
+	WithdrawRewardsEvent = "WithdrawRewards"
+)
+
+// emitEvent adds the log of the event indexed by the delegator with the other inputs as data.
+func (s *StakingPrecompile) emitEvent(ctx sdk.Context, stateDB *statedb.StateDB, name string, delegator common.Address, data ...interface{}) error {
+	event := s.ABI.Events[name]
+	topics, err := abi.MakeTopics([]interface{}{event.ID, delegator})
+	if err != nil {
+		return err
+	}
+	arguments := event.Inputs.NonIndexed()
+	b, err := arguments.Pack(data...)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     s.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func (s *StakingPrecompile) EmitDelegateEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validator string, amount *big.Int) error {
+	return s.emitEvent(ctx, stateDB, DelegateEvent, delegator, validator, amount)
+}
+
+func (s *StakingPrecompile) EmitUndelegateEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validator string, amount *big.Int, completionTime int64) error {
+	return s.emitEvent(ctx, stateDB, UndelegateEvent, delegator, validator, amount, completionTime)
+}
+
+func (s *StakingPrecompile) EmitRedelegateEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validatorSrc string, validatorDst string, amount *big.Int, completionTime int64) error {
+	return s.emitEvent(ctx, stateDB, RedelegateEvent, delegator, validatorSrc, validatorDst, amount, completionTime)
+}
+
+func (s *StakingPrecompile) EmitWithdrawRewardsEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validator string, amount []IStakingCoin) error {
+	return s.emitEvent(ctx, stateDB, WithdrawRewardsEvent, delegator, validator, amount)
+}
@@ -0,0 +1,62 @@
+package staking
+
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func (s *StakingPrecompile) BondDenom(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(0, len(args))
+	}
+	return method.Outputs.Pack(s.stakingKeeper.BondDenom(ctx))
+}
+
+func (s *StakingPrecompile) Delegation(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	delAddr, valAddr, err := parseDelegation(args)
+	if err != nil {
+		return nil, err
+	}
+	balance := sdk.NewCoin(s.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	delegation, found := s.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return method.Outputs.Pack(sdk.ZeroDec().BigInt(), NewIStakingCoin(balance))
+	}
+	validator, found := s.stakingKeeper.GetValidator(ctx, valAddr)
+	if found {
+		balance.Amount = validator.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+	// shares are returned with 18 decimals
+	return method.Outputs.Pack(delegation.Shares.BigInt(), NewIStakingCoin(balance))
+}
+
+func (s *StakingPrecompile) UnbondingDelegation(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	delAddr, valAddr, err := parseDelegation(args)
+	if err != nil {
+		return nil, err
+	}
+	ubd, _ := s.stakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	return method.Outputs.Pack(NewIStakingUnbondingEntries(ubd.Entries))
+}
+
+func (s *StakingPrecompile) DelegationRewards(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	delAddr, valAddr, err := parseDelegation(args)
+	if err != nil {
+		return nil, err
+	}
+	validator := s.stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return method.Outputs.Pack(NewIStakingCoins(sdk.NewCoins()))
+	}
+	delegation := s.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if delegation == nil {
+		return method.Outputs.Pack(NewIStakingCoins(sdk.NewCoins()))
+	}
+	// ending the current period writes the state, which must be discarded in queries
+	cacheCtx, _ := ctx.CacheContext()
+	endingPeriod := s.distrKeeper.IncrementValidatorPeriod(cacheCtx, validator)
+	rewards, _ := s.distrKeeper.CalculateDelegationRewards(cacheCtx, validator, delegation, endingPeriod).TruncateDecimal()
+	return method.Outputs.Pack(NewIStakingCoins(rewards))
+}
@@ -0,0 +1,81 @@
+package staking
+
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	PrecompileAddress = "0x0000000000000000000000000000000000001001"
+
+	StakingFunctionBondDenom           = "bondDenom"
+	StakingFunctionDelegation          = "delegation"
+	StakingFunctionUnbondingDelegation = "unbondingDelegation"
+	StakingFunctionDelegationRewards   = "delegationRewards"
+	StakingFunctionDelegate            = "delegate"
+	StakingFunctionUndelegate          = "undelegate"
+	StakingFunctionRedelegate          = "redelegate"
+
+	StakingFunctionWithdrawDelegatorRewards = "withdrawDelegatorRewards"
+)
+
+var RequiredGasBasic = map[string]uint64{
+	StakingFunctionBondDenom:           1000,
+	StakingFunctionDelegation:          10000,
+	StakingFunctionUnbondingDelegation: 10000,
+	StakingFunctionDelegationRewards:   50000,
+	StakingFunctionDelegate:            200000,
+	StakingFunctionUndelegate:          200000,
+	StakingFunctionRedelegate:          300000,
+
+	StakingFunctionWithdrawDelegatorRewards: 100000,
+}
+
+// KVGasConfig charges the store accesses with the default costs of the cosmos transactions, so that staking through
+// the precompile costs as much as it does through the staking and distribution modules.
+var KVGasConfig storetypes.GasConfig = storetypes.KVGasConfig()
+
+var _ vm.PrecompiledContract = &StakingPrecompile{}
+
+// StakingPrecompile lets EVM accounts manage their delegations and rewards, the caller is always the delegator.
+type StakingPrecompile struct {
+	*precopmiles_common.Precompile
+	stakingKeeper *stakingkeeper.Keeper
+	distrKeeper   distrkeeper.Keeper
+}
+
+func NewStakingPrecompile(stakingKeeper *stakingkeeper.Keeper, distrKeeper distrkeeper.Keeper) (*StakingPrecompile, error) {
+	s := &StakingPrecompile{
+		stakingKeeper: stakingKeeper,
+		distrKeeper:   distrKeeper,
+	}
+	queries := map[string]precopmiles_common.QueryHandler{
+		StakingFunctionBondDenom:           s.BondDenom,
+		StakingFunctionDelegation:          s.Delegation,
+		StakingFunctionUnbondingDelegation: s.UnbondingDelegation,
+		StakingFunctionDelegationRewards:   s.DelegationRewards,
+	}
+	txs := map[string]precopmiles_common.TxHandler{
+		StakingFunctionDelegate:                 s.Delegate,
+		StakingFunctionUndelegate:               s.Undelegate,
+		StakingFunctionRedelegate:               s.Redelegate,
+		StakingFunctionWithdrawDelegatorRewards: s.WithdrawDelegatorRewards,
+	}
+	methods := make(map[string]precopmiles_common.Method)
+	for name, handler := range queries {
+		methods[name] = precopmiles_common.NewQueryMethod(RequiredGasBasic[name], handler)
+	}
+	for name, handler := range txs {
+		methods[name] = precopmiles_common.NewTxMethod(RequiredGasBasic[name], handler)
+	}
+	precompile, err := precopmiles_common.NewPrecompile(common.HexToAddress(PrecompileAddress), StakingABI, KVGasConfig, methods)
+	if err != nil {
+		return nil, err
+	}
+	s.Precompile = precompile
+	return s, nil
+}
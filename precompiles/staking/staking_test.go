@@ -0,0 +1,240 @@
+package staking_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	precompiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	stakingprecompile "github.com/0glabs/0g-chain/precompiles/staking"
+	"github.com/0glabs/0g-chain/precompiles/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/suite"
+)
+
+type StakingTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	abi        abi.ABI
+	staking    *stakingprecompile.StakingPrecompile
+	bondDenom  string
+	delegator  *testutil.TestSigner
+	validator1 sdk.ValAddress
+	validator2 sdk.ValAddress
+}
+
+var selfDelegation = sdkmath.NewInt(1e6)
+
+func (suite *StakingTestSuite) SetupTest() {
+	suite.PrecompileTestSuite.SetupTest()
+
+	precompile, ok := suite.EvmKeeper.GetPrecompiles()[common.HexToAddress(stakingprecompile.PrecompileAddress)]
+	suite.Require().True(ok)
+	suite.staking = precompile.(*stakingprecompile.StakingPrecompile)
+	abi, err := abi.JSON(strings.NewReader(stakingprecompile.StakingABI))
+	suite.Require().NoError(err)
+	suite.abi = abi
+
+	// stake the evm denom so that delegations change the balances cached by the StateDB
+	suite.bondDenom = suite.EvmKeeper.GetParams(suite.Ctx).EvmDenom
+	params := suite.StakingKeeper.GetParams(suite.Ctx)
+	params.BondDenom = suite.bondDenom
+	suite.Require().NoError(suite.StakingKeeper.SetParams(suite.Ctx, params))
+
+	suite.validator1 = suite.createValidator()
+	suite.validator2 = suite.createValidator()
+	suite.delegator = testutil.GenSigner()
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, suite.delegator.Addr.Bytes(), suite.coins(1e8)))
+}
+
+func (suite *StakingTestSuite) coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(suite.bondDenom, amount))
+}
+
+func (suite *StakingTestSuite) createValidator() sdk.ValAddress {
+	valAddr := sdk.ValAddress(testutil.GenSigner().Addr.Bytes())
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, valAddr.Bytes(), suite.coins(selfDelegation.Int64())))
+	suite.Require().NoError(suite.App.CreateNewUnbondedValidator(suite.Ctx, valAddr, selfDelegation))
+	return valAddr
+}
+
+func (suite *StakingTestSuite) runTx(input []byte, signer *testutil.TestSigner) ([]byte, error) {
+	return suite.RunPrecompile(suite.staking, input, signer, signer.Addr, 10000000, false)
+}
+
+func (suite *StakingTestSuite) call(signer *testutil.TestSigner, name string, args ...interface{}) []interface{} {
+	input, err := suite.abi.Pack(name, args...)
+	suite.Require().NoError(err)
+	bz, err := suite.runTx(input, signer)
+	suite.Require().NoError(err)
+	out, err := suite.abi.Methods[name].Outputs.Unpack(bz)
+	suite.Require().NoError(err)
+	return out
+}
+
+func (suite *StakingTestSuite) bankBalance(addr common.Address) int64 {
+	return suite.App.GetBankKeeper().GetBalance(suite.Ctx, addr.Bytes(), suite.bondDenom).Amount.Int64()
+}
+
+func (suite *StakingTestSuite) Test_Delegate() {
+	// the account is dirty in the StateDB, so its cached balance is written back on commit
+	suite.Statedb.SetNonce(suite.delegator.Addr, 1)
+
+	suite.call(suite.delegator, "delegate", suite.validator1.String(), big.NewInt(1e6))
+	logs := suite.Statedb.Logs()
+	suite.Require().Len(logs, 1)
+	suite.Assert().Equal(common.BytesToHash(suite.delegator.Addr.Bytes()), logs[0].Topics[1])
+	out, err := suite.abi.Unpack(stakingprecompile.DelegateEvent, logs[0].Data)
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]interface{}{suite.validator1.String(), big.NewInt(1e6)}, out)
+
+	out = suite.call(suite.delegator, "delegation", suite.delegator.Addr, suite.validator1.String())
+	suite.Assert().Equal(sdk.NewDec(1e6).BigInt(), out[0].(*big.Int))
+	suite.Assert().Equal(stakingprecompile.IStakingCoin{Denom: suite.bondDenom, Amount: big.NewInt(1e6)}, out[1])
+	out = suite.call(suite.delegator, "delegation", suite.delegator.Addr, suite.validator2.String())
+	suite.Assert().Zero(out[0].(*big.Int).Sign())
+
+	// the StateDB keeps the balance changed by the staking module
+	suite.Assert().Equal(big.NewInt(1e8-1e6), suite.Statedb.GetBalance(suite.delegator.Addr))
+	suite.Require().NoError(suite.Statedb.Commit())
+	suite.Assert().EqualValues(1e8-1e6, suite.bankBalance(suite.delegator.Addr))
+
+	// invalid validators and amounts are rejected
+	input, err := suite.abi.Pack("delegate", "invalid", big.NewInt(1e6))
+	suite.Require().NoError(err)
+	ret, err := suite.runTx(input, suite.delegator)
+	suite.AssertCosmosRevert(ret, err, sdkerrors.ErrInvalidAddress)
+	input, err = suite.abi.Pack("delegate", suite.validator1.String(), big.NewInt(0))
+	suite.Require().NoError(err)
+	ret, err = suite.runTx(input, suite.delegator)
+	suite.AssertCosmosRevert(ret, err, sdkerrors.ErrInvalidRequest)
+}
+
+func (suite *StakingTestSuite) Test_UndelegateAndRedelegate() {
+	suite.call(suite.delegator, "delegate", suite.validator1.String(), big.NewInt(1e6))
+
+	out := suite.call(suite.delegator, "undelegate", suite.validator1.String(), big.NewInt(4e5))
+	completionTime := out[0].(int64)
+	suite.Assert().Equal(suite.Ctx.BlockTime().Add(suite.StakingKeeper.UnbondingTime(suite.Ctx)).Unix(), completionTime)
+	logs := suite.Statedb.Logs()
+	out, err := suite.abi.Unpack(stakingprecompile.UndelegateEvent, logs[len(logs)-1].Data)
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]interface{}{suite.validator1.String(), big.NewInt(4e5), completionTime}, out)
+
+	out = suite.call(suite.delegator, "unbondingDelegation", suite.delegator.Addr, suite.validator1.String())
+	suite.Assert().Equal([]stakingprecompile.IStakingUnbondingEntry{{
+		CreationHeight: suite.Ctx.BlockHeight(),
+		CompletionTime: completionTime,
+		InitialBalance: big.NewInt(4e5),
+		Balance:        big.NewInt(4e5),
+	}}, out[0])
+
+	out = suite.call(suite.delegator, "redelegate", suite.validator1.String(), suite.validator2.String(), big.NewInt(3e5))
+	// redelegations from unbonded validators complete immediately
+	suite.Assert().Equal(suite.Ctx.BlockTime().Unix(), out[0].(int64))
+	logs = suite.Statedb.Logs()
+	out, err = suite.abi.Unpack(stakingprecompile.RedelegateEvent, logs[len(logs)-1].Data)
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]interface{}{suite.validator1.String(), suite.validator2.String(), big.NewInt(3e5), suite.Ctx.BlockTime().Unix()}, out)
+
+	out = suite.call(suite.delegator, "delegation", suite.delegator.Addr, suite.validator1.String())
+	suite.Assert().Equal(stakingprecompile.IStakingCoin{Denom: suite.bondDenom, Amount: big.NewInt(3e5)}, out[1])
+	out = suite.call(suite.delegator, "delegation", suite.delegator.Addr, suite.validator2.String())
+	suite.Assert().Equal(stakingprecompile.IStakingCoin{Denom: suite.bondDenom, Amount: big.NewInt(3e5)}, out[1])
+
+	// more than delegated
+	input, err := suite.abi.Pack("undelegate", suite.validator1.String(), big.NewInt(1e6))
+	suite.Require().NoError(err)
+	ret, err := suite.runTx(input, suite.delegator)
+	suite.AssertCosmosRevert(ret, err, sdkerrors.ErrInvalidRequest)
+}
+
+func (suite *StakingTestSuite) Test_WithdrawRewards() {
+	suite.call(suite.delegator, "delegate", suite.validator1.String(), big.NewInt(1e6))
+
+	// the delegation is half of the validator tokens
+	rewards := suite.coins(1000)
+	suite.Require().NoError(suite.App.FundModuleAccount(suite.Ctx, distrtypes.ModuleName, rewards))
+	validator := suite.StakingKeeper.Validator(suite.Ctx, suite.validator1)
+	suite.App.GetDistrKeeper().AllocateTokensToValidator(suite.Ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+	expected := []stakingprecompile.IStakingCoin{{Denom: suite.bondDenom, Amount: big.NewInt(500)}}
+
+	// no rewards are accounted in the height the delegation starts
+	out := suite.call(suite.delegator, "delegationRewards", suite.delegator.Addr, suite.validator1.String())
+	suite.Assert().Empty(out[0])
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+	suite.Statedb = statedb.New(suite.Ctx, suite.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(suite.Ctx.HeaderHash().Bytes())))
+
+	period := suite.App.GetDistrKeeper().GetValidatorCurrentRewards(suite.Ctx, suite.validator1).Period
+	out = suite.call(suite.delegator, "delegationRewards", suite.delegator.Addr, suite.validator1.String())
+	suite.Assert().Equal(expected, out[0])
+	suite.Assert().Equal(period, suite.App.GetDistrKeeper().GetValidatorCurrentRewards(suite.Ctx, suite.validator1).Period)
+
+	balance := suite.Statedb.GetBalance(suite.delegator.Addr)
+	out = suite.call(suite.delegator, "withdrawDelegatorRewards", suite.validator1.String())
+	suite.Assert().Equal(expected, out[0])
+	logs := suite.Statedb.Logs()
+	out, err := suite.abi.Unpack(stakingprecompile.WithdrawRewardsEvent, logs[len(logs)-1].Data)
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]interface{}{suite.validator1.String(), expected}, out)
+	suite.Assert().Equal(new(big.Int).Add(balance, big.NewInt(500)), suite.Statedb.GetBalance(suite.delegator.Addr))
+
+	out = suite.call(suite.delegator, "delegationRewards", suite.delegator.Addr, suite.validator1.String())
+	suite.Assert().Empty(out[0])
+}
+
+func (suite *StakingTestSuite) Test_GasAndStaticCall() {
+	out := suite.call(suite.delegator, "bondDenom")
+	suite.Assert().Equal(suite.bondDenom, out[0])
+
+	// the store accesses are charged on top of the basic gas
+	input, err := suite.abi.Pack("delegate", suite.validator1.String(), big.NewInt(1e6))
+	suite.Require().NoError(err)
+	_, err = suite.RunPrecompile(suite.staking, input, suite.delegator, suite.delegator.Addr, 1000, false)
+	suite.Require().ErrorIs(err, vm.ErrOutOfGas)
+
+	ret, err := suite.RunPrecompile(suite.staking, input, suite.delegator, suite.delegator.Addr, 10000000, true)
+	suite.AssertRevert(ret, err, precompiles_common.ErrWriteProtection("delegate"))
+	input, err = suite.abi.Pack("delegation", suite.delegator.Addr, suite.validator1.String())
+	suite.Require().NoError(err)
+	_, err = suite.RunPrecompile(suite.staking, input, suite.delegator, suite.delegator.Addr, 10000000, true)
+	suite.Require().NoError(err)
+}
+
+func (suite *StakingTestSuite) Test_ContractCaller() {
+	// the contract delegates its own funds, the account is dirty in the StateDB once deployed
+	caller := testutil.GenSigner().Addr
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, caller.Bytes(), suite.coins(1e8)))
+	suite.DeployCaller(suite.staking, caller)
+
+	input, err := suite.abi.Pack("delegate", suite.validator1.String(), big.NewInt(1e6))
+	suite.Require().NoError(err)
+	_, err = suite.RunContract(caller, input, suite.delegator, 10000000)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(big.NewInt(1e8-1e6), suite.Statedb.GetBalance(caller))
+	suite.Require().NoError(suite.Statedb.Commit())
+	out := suite.call(suite.delegator, "delegation", caller, suite.validator1.String())
+	suite.Assert().Equal(sdk.NewDec(1e6).BigInt(), out[0].(*big.Int))
+	suite.Assert().EqualValues(1e8-1e6, suite.bankBalance(caller))
+
+	// neither the delegation nor the balance is changed if the contract reverts
+	suite.DeployRevertingCaller(suite.staking, caller)
+	_, err = suite.RunContract(caller, input, suite.delegator, 10000000)
+	suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+	suite.Assert().Equal(big.NewInt(1e8-1e6), suite.Statedb.GetBalance(caller))
+	suite.Require().NoError(suite.Statedb.Commit())
+	out = suite.call(suite.delegator, "delegation", caller, suite.validator1.String())
+	suite.Assert().Equal(sdk.NewDec(1e6).BigInt(), out[0].(*big.Int))
+	suite.Assert().EqualValues(1e8-1e6, suite.bankBalance(caller))
+}
+
+func TestStakingSuite(t *testing.T) {
+	suite.Run(t, new(StakingTestSuite))
+}
@@ -0,0 +1,117 @@
+package staking
+
+import (
+	"math/big"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+func (s *StakingPrecompile) Delegate(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgDelegate(args, contract.Caller(), s.stakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	balances := s.trackBalances(ctx, stateDB, contract.Caller())
+	_, err = stakingkeeper.NewMsgServerImpl(s.stakingKeeper).Delegate(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	balances.Sync(ctx)
+	// emit events
+	err = s.EmitDelegateEvent(ctx, stateDB, contract.Caller(), msg.ValidatorAddress, args[1].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (s *StakingPrecompile) Undelegate(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgUndelegate(args, contract.Caller(), s.stakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	balances := s.trackBalances(ctx, stateDB, contract.Caller())
+	res, err := stakingkeeper.NewMsgServerImpl(s.stakingKeeper).Undelegate(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	balances.Sync(ctx)
+	// emit events
+	completionTime := res.CompletionTime.Unix()
+	err = s.EmitUndelegateEvent(ctx, stateDB, contract.Caller(), msg.ValidatorAddress, args[1].(*big.Int), completionTime)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(completionTime)
+}
+
+func (s *StakingPrecompile) Redelegate(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgBeginRedelegate(args, contract.Caller(), s.stakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	balances := s.trackBalances(ctx, stateDB, contract.Caller())
+	res, err := stakingkeeper.NewMsgServerImpl(s.stakingKeeper).BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	balances.Sync(ctx)
+	// emit events
+	completionTime := res.CompletionTime.Unix()
+	err = s.EmitRedelegateEvent(ctx, stateDB, contract.Caller(), msg.ValidatorSrcAddress, msg.ValidatorDstAddress, args[2].(*big.Int), completionTime)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(completionTime)
+}
+
+func (s *StakingPrecompile) WithdrawDelegatorRewards(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgWithdrawDelegatorReward(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	balances := s.trackBalances(ctx, stateDB, contract.Caller())
+	res, err := distrkeeper.NewMsgServerImpl(s.distrKeeper).WithdrawDelegatorReward(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	balances.Sync(ctx)
+	// emit events
+	// the distribution module reports a zero coin of the base denom when there is no reward
+	amount := NewIStakingCoins(sdk.NewCoins(res.Amount...))
+	err = s.EmitWithdrawRewardsEvent(ctx, stateDB, contract.Caller(), msg.ValidatorAddress, amount)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(amount)
+}
+
+// trackBalances tracks the delegator and its withdraw address, which receives the rewards withdrawn on any change of
+// the delegation.
+func (s *StakingPrecompile) trackBalances(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address) *precopmiles_common.BalanceTracker {
+	withdrawAddr := s.distrKeeper.GetDelegatorWithdrawAddr(ctx, ToAccAddress(delegator))
+	return precopmiles_common.NewBalanceTracker(ctx, stateDB, delegator, common.BytesToAddress(withdrawAddr))
+}
@@ -0,0 +1,117 @@
+package staking
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type IStakingCoin = struct {
+	Denom  string   "json:\"denom\""
+	Amount *big.Int "json:\"amount\""
+}
+
+type IStakingUnbondingEntry = struct {
+	CreationHeight int64    "json:\"creationHeight\""
+	CompletionTime int64    "json:\"completionTime\""
+	InitialBalance *big.Int "json:\"initialBalance\""
+	Balance        *big.Int "json:\"balance\""
+}
+
+func NewIStakingCoin(coin sdk.Coin) IStakingCoin {
+	return IStakingCoin{
+		Denom:  coin.Denom,
+		Amount: coin.Amount.BigInt(),
+	}
+}
+
+func NewIStakingCoins(coins sdk.Coins) []IStakingCoin {
+	result := make([]IStakingCoin, len(coins))
+	for i, coin := range coins {
+		result[i] = NewIStakingCoin(coin)
+	}
+	return result
+}
+
+func NewIStakingUnbondingEntries(entries []stakingtypes.UnbondingDelegationEntry) []IStakingUnbondingEntry {
+	result := make([]IStakingUnbondingEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = IStakingUnbondingEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: entry.CompletionTime.Unix(),
+			InitialBalance: entry.InitialBalance.BigInt(),
+			Balance:        entry.Balance.BigInt(),
+		}
+	}
+	return result
+}
+
+// ToAccAddress returns the cosmos account address of the EVM address.
+func ToAccAddress(addr common.Address) sdk.AccAddress {
+	return sdk.AccAddress(addr.Bytes())
+}
+
+func NewMsgDelegate(args []interface{}, delegator common.Address, bondDenom string) (*stakingtypes.MsgDelegate, error) {
+	if len(args) != 2 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(2, len(args))
+	}
+
+	return &stakingtypes.MsgDelegate{
+		DelegatorAddress: ToAccAddress(delegator).String(),
+		ValidatorAddress: args[0].(string),
+		Amount:           sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(args[1].(*big.Int))),
+	}, nil
+}
+
+func NewMsgUndelegate(args []interface{}, delegator common.Address, bondDenom string) (*stakingtypes.MsgUndelegate, error) {
+	if len(args) != 2 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(2, len(args))
+	}
+
+	return &stakingtypes.MsgUndelegate{
+		DelegatorAddress: ToAccAddress(delegator).String(),
+		ValidatorAddress: args[0].(string),
+		Amount:           sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(args[1].(*big.Int))),
+	}, nil
+}
+
+func NewMsgBeginRedelegate(args []interface{}, delegator common.Address, bondDenom string) (*stakingtypes.MsgBeginRedelegate, error) {
+	if len(args) != 3 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(3, len(args))
+	}
+
+	return &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    ToAccAddress(delegator).String(),
+		ValidatorSrcAddress: args[0].(string),
+		ValidatorDstAddress: args[1].(string),
+		Amount:              sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(args[2].(*big.Int))),
+	}, nil
+}
+
+func NewMsgWithdrawDelegatorReward(args []interface{}, delegator common.Address) (*distrtypes.MsgWithdrawDelegatorReward, error) {
+	if len(args) != 1 {
+		return nil, precopmiles_common.ErrInvalidNumberOfArgs(1, len(args))
+	}
+
+	return &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: ToAccAddress(delegator).String(),
+		ValidatorAddress: args[0].(string),
+	}, nil
+}
+
+// parseDelegation returns the delegator and validator addresses of the query arguments.
+func parseDelegation(args []interface{}) (sdk.AccAddress, sdk.ValAddress, error) {
+	if len(args) != 2 {
+		return nil, nil, precopmiles_common.ErrInvalidNumberOfArgs(2, len(args))
+	}
+	valAddr, err := sdk.ValAddressFromBech32(args[1].(string))
+	if err != nil {
+		return nil, nil, precopmiles_common.ErrInvalidArguments(err)
+	}
+	return ToAccAddress(args[0].(common.Address)), valAddr, nil
+}
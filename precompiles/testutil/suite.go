@@ -1,20 +1,26 @@
 package testutil
 
 import (
+	"math/big"
 	"strings"
 
 	"github.com/0glabs/0g-chain/app"
 	"github.com/0glabs/0g-chain/chaincfg"
+	precompiles_common "github.com/0glabs/0g-chain/precompiles/common"
 	dasignersprecompile "github.com/0glabs/0g-chain/precompiles/dasigners"
 	"github.com/0glabs/0g-chain/x/bep3/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	emtests "github.com/evmos/ethermint/tests"
 	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
 	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/exp/maps"
 
+	errorsmod "cosmossdk.io/errors"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -86,3 +92,93 @@ func (suite *PrecompileTestSuite) SetupTest() {
 
 	suite.Statedb = statedb.New(suite.Ctx, suite.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(suite.Ctx.HeaderHash().Bytes())))
 }
+
+// AssertRevert checks the precompile call reverted with the custom error packed in revertErr.
+func (suite *PrecompileTestSuite) AssertRevert(bz []byte, err error, revertErr *precompiles_common.RevertError) {
+	suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+	suite.Assert().Equal(revertErr.Data(), bz)
+}
+
+// AssertCosmosRevert checks the precompile call reverted with the CosmosError of the expected registered error.
+func (suite *PrecompileTestSuite) AssertCosmosRevert(bz []byte, err error, expected *errorsmod.Error) {
+	suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+	codespace, code, _, err := precompiles_common.UnpackCosmosError(bz)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(expected.Codespace(), codespace)
+	suite.Assert().Equal(expected.ABCICode(), code)
+}
+
+// RunPrecompile runs the precompile in a tx signed by signer with caller as the direct caller of the precompile.
+func (suite *PrecompileTestSuite) RunPrecompile(p vm.PrecompiledContract, input []byte, signer *TestSigner, caller common.Address, gas uint64, readonly bool) ([]byte, error) {
+	addr := p.Address()
+	contract := vm.NewPrecompile(vm.AccountRef(caller), vm.AccountRef(addr), big.NewInt(0), gas)
+	contract.Input = input
+
+	evm := suite.newEVM(addr, input, signer, gas)
+	return p.Run(evm, contract, readonly)
+}
+
+// DeployCaller deploys at addr a contract calling the precompile with its input, which returns the result of the
+// call or reverts with it.
+func (suite *PrecompileTestSuite) DeployCaller(p vm.PrecompiledContract, addr common.Address) {
+	code := callPrecompile(p)
+	code = append(code,
+		// copy the result to memory
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.RETURNDATACOPY),
+		// jump to the return if the call succeeds
+		byte(vm.PUSH1), byte(len(code)+13), byte(vm.JUMPI),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.REVERT),
+		byte(vm.JUMPDEST), byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.RETURN),
+	)
+	suite.Statedb.SetCode(addr, code)
+}
+
+// DeployRevertingCaller deploys at addr a contract calling the precompile with its input, which reverts once the
+// call returns.
+func (suite *PrecompileTestSuite) DeployRevertingCaller(p vm.PrecompiledContract, addr common.Address) {
+	code := callPrecompile(p)
+	code = append(code,
+		// discard the result and revert
+		byte(vm.POP), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.REVERT),
+	)
+	suite.Statedb.SetCode(addr, code)
+}
+
+// callPrecompile returns the code calling the precompile with the input, which leaves the success flag on the stack.
+func callPrecompile(p vm.PrecompiledContract) []byte {
+	code := []byte{
+		// copy the input to memory
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		// call the precompile with the input
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+		byte(vm.PUSH20),
+	}
+	code = append(code, p.Address().Bytes()...)
+	return append(code, byte(vm.GAS), byte(vm.CALL))
+}
+
+// RunContract calls the contract at addr in a tx signed by signer.
+func (suite *PrecompileTestSuite) RunContract(addr common.Address, input []byte, signer *TestSigner, gas uint64) ([]byte, error) {
+	evm := suite.newEVM(addr, input, signer, gas)
+	ret, _, err := evm.Call(vm.AccountRef(signer.Addr), addr, input, gas, big.NewInt(0))
+	return ret, err
+}
+
+// newEVM returns the EVM executing a tx to addr signed by signer.
+func (suite *PrecompileTestSuite) newEVM(addr common.Address, input []byte, signer *TestSigner, gas uint64) *vm.EVM {
+	msgEthereumTx := evmtypes.NewTx(suite.EvmKeeper.ChainID(), 0, &addr, big.NewInt(0), gas, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil)
+	msgEthereumTx.From = signer.HexAddr
+	err := msgEthereumTx.Sign(suite.EthSigner, signer.Signer)
+	suite.Require().NoError(err, "failed to sign Ethereum message")
+
+	proposerAddress := suite.Ctx.BlockHeader().ProposerAddress
+	cfg, err := suite.EvmKeeper.EVMConfig(suite.Ctx, proposerAddress, suite.EvmKeeper.ChainID())
+	suite.Require().NoError(err, "failed to instantiate EVM config")
+
+	msg, err := msgEthereumTx.AsMessage(suite.EthSigner, big.NewInt(0))
+	suite.Require().NoError(err, "failed to instantiate Ethereum message")
+
+	evm := suite.EvmKeeper.NewEVM(suite.Ctx, msg, cfg, nil, suite.Statedb)
+	evm.WithPrecompiles(suite.EvmKeeper.GetPrecompiles(), maps.Keys(suite.EvmKeeper.GetPrecompiles()))
+	return evm
+}
@@ -32,12 +32,15 @@ func GetQueryCmd() *cobra.Command {
 		getCmdQueryNextProposalID(),
 		getCmdQueryProposal(),
 		getCmdQueryProposals(),
+		getCmdQueryClosedProposal(),
+		getCmdQueryClosedProposals(),
 		// votes
 		getCmdQueryVotes(),
 		// other
 		getCmdQueryProposer(),
 		getCmdQueryTally(),
 		getCmdQueryRawParams(),
+		getCmdQueryParams(),
 	}
 
 	for _, cmd := range cmds {
@@ -193,6 +196,80 @@ func getCmdQueryProposals() *cobra.Command {
 	}
 }
 
+// getCmdQueryClosedProposal implements the query closed proposal command.
+func getCmdQueryClosedProposal() *cobra.Command {
+	return &cobra.Command{
+		Use:     "closed-proposal [proposal-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the outcome, final tally and votes of a closed proposal",
+		Example: fmt.Sprintf("%s query %s closed-proposal 2", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Prepare params for querier
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ClosedProposal(context.Background(), &types.QueryClosedProposalRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// getCmdQueryClosedProposals implements a query closed proposals command.
+func getCmdQueryClosedProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "closed-proposals [committee-id]",
+		Short: "Query the closed proposals, optionally of a single committee",
+		Args:  cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s query %[2]s closed-proposals
+%[1]s query %[2]s closed-proposals 1 --limit 10`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Prepare params for querier
+			var committeeID uint64
+			if len(args) > 0 {
+				committeeID, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("committee-id %s not a valid uint", args[0])
+				}
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ClosedProposals(context.Background(), &types.QueryClosedProposalsRequest{
+				CommitteeId: committeeID,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "closed-proposals")
+	return cmd
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
@@ -316,3 +393,26 @@ func getCmdQueryRawParams() *cobra.Command {
 		},
 	}
 }
+
+// getCmdQueryParams implements the query params command.
+func getCmdQueryParams() *cobra.Command {
+	return &cobra.Command{
+		Use:     "params",
+		Args:    cobra.NoArgs,
+		Short:   "Query the module params",
+		Example: fmt.Sprintf("%s query %s params", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
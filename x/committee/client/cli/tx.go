@@ -24,6 +24,11 @@ import (
 	"github.com/0glabs/0g-chain/x/committee/types"
 )
 
+const (
+	flagDeposit   = "deposit"
+	flagExpedited = "expedited"
+)
+
 const PARAMS_CHANGE_PROPOSAL_EXAMPLE = `
 {
 	"@type": "/cosmos.params.v1beta1.ParameterChangeProposal",
@@ -74,6 +79,7 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdVote(),
 		getCmdSubmitProposal(),
+		getCmdCancelProposal(),
 	}
 
 	for _, cmd := range cmds {
@@ -97,7 +103,7 @@ For example:
 %s
 `, PARAMS_CHANGE_PROPOSAL_EXAMPLE),
 		Args:    cobra.ExactArgs(2),
-		Example: fmt.Sprintf("%s tx %s submit-proposal 1 your-proposal.json", version.AppName, types.ModuleName),
+		Example: fmt.Sprintf("%s tx %s submit-proposal 1 your-proposal.json --deposit 1000srg --expedited", version.AppName, types.ModuleName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -126,11 +132,27 @@ For example:
 				return err
 			}
 
+			// Get deposit
+			depositStr, err := cmd.Flags().GetString(flagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			expedited, err := cmd.Flags().GetBool(flagExpedited)
+			if err != nil {
+				return err
+			}
+
 			// Build message and run basic validation
 			msg, err := types.NewMsgSubmitProposal(pubProposal, proposer, committeeID)
 			if err != nil {
 				return err
 			}
+			msg.Deposit = deposit
+			msg.Expedited = expedited
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -140,18 +162,50 @@ For example:
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(flagDeposit, "", "deposit of the proposal, refunded unless the proposal fails or is cancelled")
+	cmd.Flags().Bool(flagExpedited, false, "submit an expedited proposal with the shorter duration and higher threshold of the committee")
 
 	return cmd
 }
 
+// getCmdCancelProposal returns the command to cancel a proposal.
+func getCmdCancelProposal() *cobra.Command {
+	return &cobra.Command{
+		Use:     "cancel-proposal [proposal-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Cancel an active proposal",
+		Long:    "Cancel the active proposal with id [proposal-id] submitted by the sender, burning its deposit.",
+		Example: fmt.Sprintf("%s tx %s cancel-proposal 2", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgCancelProposal(clientCtx.GetFromAddress(), proposalID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 // getCmdVote returns the command to vote on a proposal.
 func getCmdVote() *cobra.Command {
 	return &cobra.Command{
 		Use:     "vote [proposal-id] [vote]",
 		Args:    cobra.ExactArgs(2),
 		Short:   "Vote for an active proposal",
-		Long:    "Submit a [yes/no/abstain] vote for the proposal with id [proposal-id].",
-		Example: fmt.Sprintf("%s tx %s vote 2 yes", version.AppName, types.ModuleName),
+		Long:    "Submit a [yes/no/abstain] vote for the proposal with id [proposal-id], or split the vote across weighted options summing to 1.",
+		Example: fmt.Sprintf("%[1]s tx %[2]s vote 2 yes\n%[1]s tx %[2]s vote 2 yes=0.6,no=0.3,abstain=0.1", version.AppName, types.ModuleName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -172,20 +226,21 @@ func getCmdVote() *cobra.Command {
 				return fmt.Errorf("must specify a vote")
 			}
 
-			var vote types.VoteType
-			switch rawVote {
-			case "yes", "y":
-				vote = types.VOTE_TYPE_YES
-			case "no", "n":
-				vote = types.VOTE_TYPE_NO
-			case "abstain", "a":
-				vote = types.VOTE_TYPE_ABSTAIN
-			default:
-				return fmt.Errorf("must specify a valid vote type: (yes/y, no/n, abstain/a)")
-			}
-
 			// Build vote message and run basic validation
-			msg := types.NewMsgVote(from, proposalID, vote)
+			var msg *types.MsgVote
+			if strings.Contains(rawVote, "=") {
+				options, err := types.WeightedVoteOptionsFromString(rawVote)
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgWeightedVote(from, proposalID, options)
+			} else {
+				vote, err := types.VoteTypeFromString(rawVote)
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgVote(from, proposalID, vote)
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -26,6 +26,10 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, gs *types.GenesisState)
 	for _, v := range gs.Votes {
 		keeper.SetVote(ctx, v)
 	}
+	keeper.SetParams(ctx, gs.Params)
+	for _, cp := range gs.ClosedProposals {
+		keeper.SetClosedProposal(ctx, cp)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -38,10 +42,13 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	proposals := keeper.GetProposals(ctx)
 	votes := keeper.GetVotes(ctx)
 
-	return types.NewGenesisState(
+	gs := types.NewGenesisState(
 		nextID,
 		committees,
 		proposals,
 		votes,
 	)
+	gs.Params = keeper.GetParams(ctx)
+	gs.ClosedProposals = keeper.GetClosedProposals(ctx)
+	return gs
 }
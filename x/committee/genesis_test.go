@@ -8,6 +8,7 @@ import (
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/0glabs/0g-chain/app"
 	"github.com/0glabs/0g-chain/x/committee"
@@ -55,6 +56,28 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 		"hard",
 	)
 
+	closedProposal := types.NewClosedProposal(
+		types.MustNewProposal(govv1beta1.NewTextProposal("A Title", "A description of this proposal."), 1, memberCom.ID, time.Date(1998, time.January, 8, 0, 0, 0, 0, time.UTC)),
+		types.Passed,
+		types.ProposalTally{
+			YesVotes:      sdk.NewDec(1),
+			NoVotes:       sdk.ZeroDec(),
+			AbstainVotes:  sdk.ZeroDec(),
+			CurrentVotes:  sdk.NewDec(1),
+			PossibleVotes: sdk.NewDec(2),
+			VoteThreshold: testutil.D("0.667"),
+			Quorum:        sdk.ZeroDec(),
+		},
+		[]types.Vote{types.NewVote(1, suite.addresses[0], types.VOTE_TYPE_YES)},
+		10,
+		time.Date(1998, time.January, 2, 0, 0, 0, 0, time.UTC),
+	)
+	withArchive := func(gs *types.GenesisState) *types.GenesisState {
+		gs.Params = types.Params{ClosedProposalRetention: time.Hour * 24 * 365}
+		gs.ClosedProposals = []types.ClosedProposal{closedProposal}
+		return gs
+	}
+
 	// Most genesis validation tests are located in the types directory. The 'invalid' test cases are
 	// randomly selected subset of those tests.
 	testCases := []struct {
@@ -87,6 +110,26 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 			),
 			expectPass: true,
 		},
+		{
+			name: "params and closed proposals",
+			genState: withArchive(types.NewGenesisState(
+				2,
+				[]types.Committee{memberCom},
+				[]types.Proposal{},
+				[]types.Vote{},
+			)),
+			expectPass: true,
+		},
+		{
+			name: "invalid: closed proposal ID is an active proposal",
+			genState: withArchive(types.NewGenesisState(
+				2,
+				[]types.Committee{memberCom},
+				[]types.Proposal{types.MustNewProposal(govv1beta1.NewTextProposal("A Title", "A description of this proposal."), 1, memberCom.ID, time.Date(1998, time.January, 8, 0, 0, 0, 0, time.UTC))},
+				[]types.Vote{},
+			)),
+			expectPass: false,
+		},
 		{
 			name: "invalid: duplicate committee ID",
 			genState: types.NewGenesisState(
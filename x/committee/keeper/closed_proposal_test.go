@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/0glabs/0g-chain/x/committee/keeper"
+	"github.com/0glabs/0g-chain/x/committee/testutil"
+	"github.com/0glabs/0g-chain/x/committee/types"
+)
+
+func (suite *keeperTestSuite) TestClosedProposals() {
+	suite.App.InitializeFromGenesisStates()
+	startTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	ctx := suite.App.NewContext(false, tmproto.Header{Height: 1, Time: startTime})
+
+	com := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:3],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	suite.Keeper.SetCommittee(ctx, com)
+	pubProposal := govv1beta1.NewTextProposal("A Title", "A description of this proposal.")
+	passedID, err := suite.Keeper.SubmitProposal(ctx, suite.Addresses[0], com.ID, pubProposal)
+	suite.Require().NoError(err)
+	failedID, err := suite.Keeper.SubmitProposal(ctx, suite.Addresses[0], com.ID, pubProposal)
+	suite.Require().NoError(err)
+
+	// the first proposal passes as soon as it has enough votes
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, passedID, suite.Addresses[0], types.VOTE_TYPE_YES))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, passedID, suite.Addresses[1], types.VOTE_TYPE_YES))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, failedID, suite.Addresses[2], types.VOTE_TYPE_YES))
+	suite.Keeper.ProcessProposals(ctx)
+
+	_, found := suite.Keeper.GetProposal(ctx, passedID)
+	suite.Require().False(found)
+	closed, found := suite.Keeper.GetClosedProposal(ctx, passedID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Passed, closed.Outcome)
+	suite.Require().Equal(int64(1), closed.CloseHeight)
+	suite.Require().Equal(startTime, closed.CloseTime)
+	suite.Require().Len(closed.Votes, 2)
+	suite.Require().Equal(sdk.NewDec(2), closed.Tally.YesVotes)
+	suite.Require().Equal(sdk.NewDec(3), closed.Tally.PossibleVotes)
+	_, found = suite.Keeper.GetClosedProposal(ctx, failedID)
+	suite.Require().False(found)
+
+	// the second proposal fails once it expires
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(time.Hour * 24 * 8))
+	suite.Keeper.ProcessProposals(ctx)
+	closed, found = suite.Keeper.GetClosedProposal(ctx, failedID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Failed, closed.Outcome)
+	suite.Require().Equal(sdk.NewDec(1), closed.Tally.YesVotes)
+	suite.Require().Len(suite.Keeper.GetVotesByProposal(ctx, failedID), 0)
+
+	// the archive is paginated and filtered by committee
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	res, err := queryServer.ClosedProposals(sdk.WrapSDKContext(ctx), &types.QueryClosedProposalsRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ClosedProposals, 1)
+	suite.Require().Equal(passedID, res.ClosedProposals[0].Proposal.ID)
+	suite.Require().NotNil(res.Pagination.NextKey)
+	res, err = queryServer.ClosedProposals(sdk.WrapSDKContext(ctx), &types.QueryClosedProposalsRequest{CommitteeId: com.ID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ClosedProposals, 2)
+	res, err = queryServer.ClosedProposals(sdk.WrapSDKContext(ctx), &types.QueryClosedProposalsRequest{CommitteeId: com.ID + 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ClosedProposals, 0)
+	_, err = queryServer.ClosedProposal(sdk.WrapSDKContext(ctx), &types.QueryClosedProposalRequest{ProposalId: 100})
+	suite.Require().Error(err)
+
+	// closed proposals are kept forever by default
+	suite.Keeper.PruneClosedProposals(ctx.WithBlockTime(startTime.Add(time.Hour * 24 * 365 * 10)))
+	suite.Require().Len(suite.Keeper.GetClosedProposals(ctx), 2)
+
+	// only the authority changes the retention
+	msgServer := keeper.NewMsgServerImpl(suite.Keeper)
+	params := types.Params{ClosedProposalRetention: time.Hour * 24}
+	_, err = msgServer.ChangeParams(sdk.WrapSDKContext(ctx), &types.MsgChangeParams{Authority: suite.Addresses[0].String(), Params: &params})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.ChangeParams(sdk.WrapSDKContext(ctx), &types.MsgChangeParams{Authority: suite.Keeper.GetAuthority(), Params: &params})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.Keeper.GetParams(ctx))
+
+	// closed proposals older than the retention are pruned
+	suite.Keeper.PruneClosedProposals(ctx.WithBlockTime(startTime.Add(time.Hour * 24 * 8).Add(time.Hour)))
+	_, found = suite.Keeper.GetClosedProposal(ctx, passedID)
+	suite.Require().False(found)
+	_, found = suite.Keeper.GetClosedProposal(ctx, failedID)
+	suite.Require().True(found)
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/0glabs/0g-chain/x/committee"
+	"github.com/0glabs/0g-chain/x/committee/keeper"
+	"github.com/0glabs/0g-chain/x/committee/testutil"
+	"github.com/0glabs/0g-chain/x/committee/types"
+)
+
+func (suite *keeperTestSuite) TestCouncilCommittee() {
+	suite.App.InitializeFromGenesisStates()
+	ctx := suite.App.NewContext(false, tmproto.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+	councilKeeper := suite.App.GetCouncilKeeper()
+	setCouncil := func(id uint64, members []sdk.AccAddress) {
+		council, found := councilKeeper.GetCouncil(ctx, id)
+		suite.Require().True(found || id > 1)
+		council.ID = id
+		council.Members = make([]sdk.ValAddress, len(members))
+		for i, member := range members {
+			council.Members[i] = sdk.ValAddress(member)
+		}
+		councilKeeper.SetCouncil(ctx, council)
+		councilKeeper.SetCurrentCouncilID(ctx, id)
+	}
+	setCouncil(1, suite.Addresses[:3])
+
+	com := types.MustNewCouncilCommittee(
+		1,
+		"This council committee is for testing.",
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	// the members are resolved from the current council, not stored
+	com.Members = suite.Addresses[5:]
+	suite.Keeper.SetCommittee(ctx, com)
+	stored := suite.Keeper.GetCommittees(ctx)
+	suite.Require().Len(stored, 1)
+	suite.Require().Empty(stored[0].GetMembers())
+	suite.Require().NoError(stored[0].Validate())
+	loaded, found := suite.Keeper.GetCommittee(ctx, com.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.CouncilCommitteeType, loaded.GetType())
+	suite.Require().Equal(suite.Addresses[:3], loaded.GetMembers())
+
+	// the committees query resolves the members as well, while the genesis export keeps them unset
+	res, err := keeper.NewQueryServerImpl(suite.Keeper).Committees(sdk.WrapSDKContext(ctx), &types.QueryCommitteesRequest{})
+	suite.Require().NoError(err)
+	queried, err := types.UnpackCommittees(res.Committees)
+	suite.Require().NoError(err)
+	suite.Require().Len(queried, 1)
+	suite.Require().Equal(suite.Addresses[:3], queried[0].GetMembers())
+	exported := committee.ExportGenesis(ctx, suite.Keeper).GetCommittees()
+	suite.Require().Len(exported, 1)
+	suite.Require().Empty(exported[0].GetMembers())
+	suite.Require().NoError(exported[0].Validate())
+
+	// only the council members can submit proposals and vote
+	pubProposal := govv1beta1.NewTextProposal("A Title", "A description of this proposal.")
+	_, err = suite.Keeper.SubmitProposal(ctx, suite.Addresses[3], com.ID, pubProposal)
+	suite.Require().Error(err)
+	proposalID, err := suite.Keeper.SubmitProposal(ctx, suite.Addresses[0], com.ID, pubProposal)
+	suite.Require().NoError(err)
+	suite.Require().Error(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[3], types.VOTE_TYPE_YES))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_NO))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[1], types.VOTE_TYPE_YES))
+
+	loaded, _ = suite.Keeper.GetCommittee(ctx, com.ID)
+	suite.Require().True(suite.Keeper.GetProposalResult(ctx, proposalID, loaded))
+
+	// the membership rotates with the council, the votes of the former members are ignored
+	setCouncil(2, suite.Addresses[1:4])
+	loaded, _ = suite.Keeper.GetCommittee(ctx, com.ID)
+	suite.Require().Equal(suite.Addresses[1:4], loaded.GetMembers())
+	yesVotes, _, _ := suite.Keeper.TallyCouncilCommitteeVotes(ctx, proposalID, loaded)
+	suite.Require().Equal(sdk.NewDec(1), yesVotes)
+	suite.Require().False(suite.Keeper.GetProposalResult(ctx, proposalID, loaded))
+	tally, found := suite.Keeper.GetProposalTallyResponse(ctx, proposalID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(1), tally.YesVotes)
+	suite.Require().Equal(sdk.NewDec(3), tally.PossibleVotes)
+
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[3], types.VOTE_TYPE_YES))
+	suite.Require().True(suite.Keeper.GetProposalResult(ctx, proposalID, loaded))
+
+	// an empty council passes nothing
+	setCouncil(3, nil)
+	loaded, _ = suite.Keeper.GetCommittee(ctx, com.ID)
+	suite.Require().False(suite.Keeper.GetProposalResult(ctx, proposalID, loaded))
+}
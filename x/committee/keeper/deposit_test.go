@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/0glabs/0g-chain/x/committee/keeper"
+	"github.com/0glabs/0g-chain/x/committee/testutil"
+	"github.com/0glabs/0g-chain/x/committee/types"
+)
+
+func (suite *keeperTestSuite) TestProposalDeposits() {
+	suite.App.InitializeFromGenesisStates()
+	startTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	ctx := suite.App.NewContext(false, tmproto.Header{Height: 1, Time: startTime})
+	funds := sdk.NewCoins(sdk.NewInt64Coin("deposit", 100))
+	suite.Require().NoError(suite.App.FundAccount(ctx, suite.Addresses[0], funds))
+
+	com := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:3],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	com.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin("deposit", 10))
+	suite.Keeper.SetCommittee(ctx, com)
+	pubProposal := govv1beta1.NewTextProposal("A Title", "A description of this proposal.")
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("deposit", 10))
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	// proposals must pay at least the min deposit
+	_, err := suite.Keeper.SubmitProposal(ctx, suite.Addresses[0], com.ID, pubProposal)
+	suite.Require().ErrorIs(err, types.ErrInvalidDeposit)
+
+	// the deposit is escrowed and refunded once the proposal passes
+	passedID, err := suite.Keeper.SubmitProposalWithDeposit(ctx, suite.Addresses[0], com.ID, pubProposal, deposit, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(deposit, suite.BankKeeper.GetAllBalances(ctx, moduleAddr))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, passedID, suite.Addresses[0], types.VOTE_TYPE_YES))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, passedID, suite.Addresses[1], types.VOTE_TYPE_YES))
+	suite.Keeper.ProcessProposals(ctx)
+	suite.Require().Equal(funds, suite.BankKeeper.GetAllBalances(ctx, suite.Addresses[0]))
+
+	// the deposit is burned once the proposal fails
+	failedID, err := suite.Keeper.SubmitProposalWithDeposit(ctx, suite.Addresses[0], com.ID, pubProposal, deposit, false)
+	suite.Require().NoError(err)
+	suite.Keeper.ProcessProposals(ctx.WithBlockTime(startTime.Add(time.Hour * 24 * 8)))
+	closed, found := suite.Keeper.GetClosedProposal(ctx, failedID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Failed, closed.Outcome)
+	suite.Require().Equal(funds.Sub(deposit...), suite.BankKeeper.GetAllBalances(ctx, suite.Addresses[0]))
+	suite.Require().True(suite.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+	suite.Require().Equal(sdk.NewInt(90), suite.BankKeeper.GetSupply(ctx, "deposit").Amount)
+}
+
+func (suite *keeperTestSuite) TestCancelProposal() {
+	suite.App.InitializeFromGenesisStates()
+	ctx := suite.App.NewContext(false, tmproto.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+	funds := sdk.NewCoins(sdk.NewInt64Coin("deposit", 100))
+	suite.Require().NoError(suite.App.FundAccount(ctx, suite.Addresses[0], funds))
+
+	com := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:3],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	suite.Keeper.SetCommittee(ctx, com)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("deposit", 10))
+	proposalID, err := suite.Keeper.SubmitProposalWithDeposit(ctx, suite.Addresses[0], com.ID,
+		govv1beta1.NewTextProposal("A Title", "A description of this proposal."), deposit, false)
+	suite.Require().NoError(err)
+
+	// only the proposer cancels a proposal
+	msgServer := keeper.NewMsgServerImpl(suite.Keeper)
+	_, err = msgServer.CancelProposal(sdk.WrapSDKContext(ctx), types.NewMsgCancelProposal(suite.Addresses[1], proposalID))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.CancelProposal(sdk.WrapSDKContext(ctx), types.NewMsgCancelProposal(suite.Addresses[0], proposalID+1))
+	suite.Require().ErrorIs(err, types.ErrUnknownProposal)
+
+	// the proposal is archived as cancelled and its deposit burned
+	_, err = msgServer.CancelProposal(sdk.WrapSDKContext(ctx), types.NewMsgCancelProposal(suite.Addresses[0], proposalID))
+	suite.Require().NoError(err)
+	_, found := suite.Keeper.GetProposal(ctx, proposalID)
+	suite.Require().False(found)
+	closed, found := suite.Keeper.GetClosedProposal(ctx, proposalID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Cancelled, closed.Outcome)
+	suite.Require().Equal(funds.Sub(deposit...), suite.BankKeeper.GetAllBalances(ctx, suite.Addresses[0]))
+	suite.Require().True(suite.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+	suite.Require().Equal(sdk.NewInt(90), suite.BankKeeper.GetSupply(ctx, "deposit").Amount)
+}
+
+func (suite *keeperTestSuite) TestExpeditedProposals() {
+	suite.App.InitializeFromGenesisStates()
+	startTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	ctx := suite.App.NewContext(false, tmproto.Header{Height: 1, Time: startTime})
+
+	com := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:4],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	suite.Keeper.SetCommittee(ctx, com)
+	pubProposal := govv1beta1.NewTextProposal("A Title", "A description of this proposal.")
+
+	// committees without an expedited duration reject expedited proposals
+	_, err := suite.Keeper.SubmitProposalWithDeposit(ctx, suite.Addresses[0], com.ID, pubProposal, nil, true)
+	suite.Require().ErrorIs(err, types.ErrExpeditedNotAllowed)
+
+	com.ExpeditedProposalDuration = time.Hour * 24
+	com.ExpeditedVoteThreshold = testutil.D("0.8")
+	suite.Require().NoError(com.Validate())
+	suite.Keeper.SetCommittee(ctx, com)
+	expeditedID, err := suite.Keeper.SubmitProposalWithDeposit(ctx, suite.Addresses[0], com.ID, pubProposal, nil, true)
+	suite.Require().NoError(err)
+	proposal, found := suite.Keeper.GetProposal(ctx, expeditedID)
+	suite.Require().True(found)
+	suite.Require().True(proposal.Expedited)
+	suite.Require().Equal(startTime.Add(time.Hour*24), proposal.Deadline)
+
+	// expedited proposals need the higher threshold to pass
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, expeditedID, suite.Addresses[0], types.VOTE_TYPE_YES))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, expeditedID, suite.Addresses[1], types.VOTE_TYPE_YES))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, expeditedID, suite.Addresses[2], types.VOTE_TYPE_YES))
+	suite.Require().False(suite.Keeper.GetProposalResult(ctx, expeditedID, com))
+	tally, _ := suite.Keeper.GetProposalTallyResponse(ctx, expeditedID)
+	suite.Require().Equal(testutil.D("0.8"), tally.VoteThreshold)
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, expeditedID, suite.Addresses[3], types.VOTE_TYPE_YES))
+	suite.Require().True(suite.Keeper.GetProposalResult(ctx, expeditedID, com))
+}
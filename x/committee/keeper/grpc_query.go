@@ -32,6 +32,9 @@ func (s queryServer) Committees(ctx context.Context, req *types.QueryCommitteesR
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	committees := s.keeper.GetCommittees(sdkCtx)
+	for _, committee := range committees {
+		s.keeper.loadCouncilMembers(sdkCtx, committee)
+	}
 	committeesAny, err := types.PackCommittees(committees)
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, "could not pack committees: %v", err)
@@ -186,6 +189,64 @@ func (s queryServer) RawParams(c context.Context, req *types.QueryRawParamsReque
 	return &types.QueryRawParamsResponse{RawData: string(rawParams)}, nil
 }
 
+// ClosedProposals implements the Query/ClosedProposals gRPC method
+func (s queryServer) ClosedProposals(c context.Context, req *types.QueryClosedProposalsRequest) (*types.QueryClosedProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var closedProposals []types.ClosedProposal
+	store := prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.ClosedProposalKeyPrefix)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var closedProposal types.ClosedProposal
+		if err := s.keeper.cdc.Unmarshal(value, &closedProposal); err != nil {
+			return false, err
+		}
+		if req.CommitteeId != 0 && closedProposal.Proposal.CommitteeID != req.CommitteeId {
+			return false, nil
+		}
+
+		if accumulate {
+			closedProposals = append(closedProposals, closedProposal)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClosedProposalsResponse{
+		ClosedProposals: closedProposals,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// ClosedProposal implements the Query/ClosedProposal gRPC method
+func (s queryServer) ClosedProposal(c context.Context, req *types.QueryClosedProposalRequest) (*types.QueryClosedProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	closedProposal, found := s.keeper.GetClosedProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "cannot find closed proposal: %v", req.ProposalId)
+	}
+	return &types.QueryClosedProposalResponse{ClosedProposal: closedProposal}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (s queryServer) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: s.keeper.GetParams(ctx)}, nil
+}
+
 func (s queryServer) proposalResponseFromProposal(proposal types.Proposal) types.QueryProposalResponse {
 	return types.QueryProposalResponse{
 		PubProposal: proposal.Content,
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -20,13 +21,21 @@ type Keeper struct {
 	paramKeeper   types.ParamKeeper
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	councilKeeper types.CouncilKeeper
+	stakingKeeper types.StakingKeeper
 
 	// Proposal router
 	router govv1beta1.Router
+	// Msg router executing the messages of ExecuteMsgsProposals
+	msgRouter *baseapp.MsgServiceRouter
+	// the address signing the messages of ExecuteMsgsProposals. Should be the gov module account,
+	// as committees act on behalf of governance within their permissions
+	authority string
 }
 
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.Router,
-	paramKeeper types.ParamKeeper, ak types.AccountKeeper, sk types.BankKeeper,
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.Router, msgRouter *baseapp.MsgServiceRouter,
+	paramKeeper types.ParamKeeper, ak types.AccountKeeper, sk types.BankKeeper, ck types.CouncilKeeper, stk types.StakingKeeper,
+	authority string,
 ) Keeper {
 	// Logic in the keeper methods assume the set of gov handlers is fixed.
 	// So the gov router must be sealed so no handlers can be added or removed after the keeper is created.
@@ -38,10 +47,19 @@ func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.
 		paramKeeper:   paramKeeper,
 		accountKeeper: ak,
 		bankKeeper:    sk,
+		councilKeeper: ck,
+		stakingKeeper: stk,
 		router:        router,
+		msgRouter:     msgRouter,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address signing the messages of ExecuteMsgsProposals.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // ------------------------------------------
 //				Committees
 // ------------------------------------------
@@ -58,11 +76,18 @@ func (k Keeper) GetCommittee(ctx sdk.Context, committeeID uint64) (types.Committ
 	if err != nil {
 		panic(err)
 	}
+	k.loadCouncilMembers(ctx, committee)
 	return committee, true
 }
 
 // SetCommittee puts a committee into the store.
 func (k Keeper) SetCommittee(ctx sdk.Context, committee types.Committee) {
+	// the members of a council committee are resolved when loaded
+	if com, ok := committee.(*types.CouncilCommittee); ok && len(com.Members) > 0 {
+		base := *com.BaseCommittee
+		base.Members = nil
+		committee = &types.CouncilCommittee{BaseCommittee: &base}
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CommitteeKeyPrefix)
 	bz, err := k.cdc.MarshalInterface(committee)
 	if err != nil {
@@ -94,7 +119,22 @@ func (k Keeper) IterateCommittees(ctx sdk.Context, cb func(committee types.Commi
 	}
 }
 
-// GetCommittees returns all stored committees.
+// loadCouncilMembers sets the members of a council committee to the members of the current council.
+func (k Keeper) loadCouncilMembers(ctx sdk.Context, committee types.Committee) {
+	com, ok := committee.(*types.CouncilCommittee)
+	if !ok {
+		return
+	}
+	councilMembers := k.councilKeeper.GetCurrentCouncilMembers(ctx)
+	members := make([]sdk.AccAddress, len(councilMembers))
+	for i, member := range councilMembers {
+		members[i] = sdk.AccAddress(member)
+	}
+	com.SetMembers(members)
+}
+
+// GetCommittees returns all stored committees. The members of council committees are left unset as stored, the
+// queries resolve them with loadCouncilMembers.
 func (k Keeper) GetCommittees(ctx sdk.Context) types.Committees {
 	results := types.Committees{}
 	k.IterateCommittees(ctx, func(com types.Committee) bool {
@@ -135,7 +175,9 @@ func (k Keeper) IncrementNextProposalID(ctx sdk.Context) error {
 }
 
 // StoreNewProposal stores a proposal, adding a new ID
-func (k Keeper) StoreNewProposal(ctx sdk.Context, pubProposal types.PubProposal, committeeID uint64, deadline time.Time) (uint64, error) {
+func (k Keeper) StoreNewProposal(ctx sdk.Context, pubProposal types.PubProposal, committeeID uint64, deadline time.Time,
+	proposer sdk.AccAddress, deposit sdk.Coins, expedited bool,
+) (uint64, error) {
 	newProposalID, err := k.GetNextProposalID(ctx)
 	if err != nil {
 		return 0, err
@@ -149,6 +191,9 @@ func (k Keeper) StoreNewProposal(ctx sdk.Context, pubProposal types.PubProposal,
 	if err != nil {
 		return 0, err
 	}
+	proposal.Proposer = proposer
+	proposal.Deposit = deposit
+	proposal.Expedited = expedited
 
 	k.SetProposal(ctx, proposal)
 
@@ -299,3 +344,110 @@ func (k Keeper) GetVotesByProposal(ctx sdk.Context, proposalID uint64) []types.V
 
 	return results
 }
+
+// ------------------------------------------
+//				Closed Proposals
+// ------------------------------------------
+
+// GetClosedProposal gets a closed proposal from the archive.
+func (k Keeper) GetClosedProposal(ctx sdk.Context, proposalID uint64) (types.ClosedProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedProposalKeyPrefix)
+	bz := store.Get(types.GetKeyFromID(proposalID))
+	if bz == nil {
+		return types.ClosedProposal{}, false
+	}
+	var closedProposal types.ClosedProposal
+	k.cdc.MustUnmarshal(bz, &closedProposal)
+	return closedProposal, true
+}
+
+// SetClosedProposal puts a closed proposal into the archive, indexed by its close time.
+func (k Keeper) SetClosedProposal(ctx sdk.Context, closedProposal types.ClosedProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedProposalKeyPrefix)
+	bz := k.cdc.MustMarshal(&closedProposal)
+	store.Set(types.GetKeyFromID(closedProposal.Proposal.ID), bz)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedProposalByTimeKeyPrefix)
+	indexStore.Set(types.GetClosedProposalByTimeKey(closedProposal.CloseTime, closedProposal.Proposal.ID), []byte{})
+}
+
+// DeleteClosedProposal removes a closed proposal from the archive.
+func (k Keeper) DeleteClosedProposal(ctx sdk.Context, proposalID uint64) {
+	closedProposal, found := k.GetClosedProposal(ctx, proposalID)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedProposalKeyPrefix)
+	store.Delete(types.GetKeyFromID(proposalID))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedProposalByTimeKeyPrefix)
+	indexStore.Delete(types.GetClosedProposalByTimeKey(closedProposal.CloseTime, proposalID))
+}
+
+// IterateClosedProposals provides an iterator over all archived closed proposals.
+// For each closed proposal, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateClosedProposals(ctx sdk.Context, cb func(closedProposal types.ClosedProposal) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ClosedProposalKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var closedProposal types.ClosedProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &closedProposal)
+		if cb(closedProposal) {
+			break
+		}
+	}
+}
+
+// GetClosedProposals returns all archived closed proposals.
+func (k Keeper) GetClosedProposals(ctx sdk.Context) []types.ClosedProposal {
+	results := []types.ClosedProposal{}
+	k.IterateClosedProposals(ctx, func(closedProposal types.ClosedProposal) bool {
+		results = append(results, closedProposal)
+		return false
+	})
+	return results
+}
+
+// PruneClosedProposals removes the closed proposals archived longer than the retention.
+func (k Keeper) PruneClosedProposals(ctx sdk.Context) {
+	retention := k.GetParams(ctx).ClosedProposalRetention
+	if retention == 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedProposalByTimeKeyPrefix)
+	// closed proposals are kept for the retention, those closed at the cutoff are pruned
+	end := sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime().Add(-retention)))
+	iterator := store.Iterator(nil, end)
+
+	var proposalIDs []uint64
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		proposalIDs = append(proposalIDs, types.Uint64FromBytes(key[len(key)-8:]))
+	}
+	for _, proposalID := range proposalIDs {
+		k.DeleteClosedProposal(ctx, proposalID)
+	}
+}
+
+// ------------------------------------------
+//				Params
+// ------------------------------------------
+
+// GetParams returns the module params, the defaults if they are not set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams puts the module params into the store.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	bz := k.cdc.MustMarshal(&params)
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, bz)
+}
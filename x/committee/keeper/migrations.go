@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/committee/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// V2 captures the voting power of the existing token committee votes.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var votes []types.Vote
+	m.keeper.IterateVotes(ctx, func(vote types.Vote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+	for _, vote := range votes {
+		proposal, found := m.keeper.GetProposal(ctx, vote.ProposalID)
+		if !found {
+			continue
+		}
+		committee, found := m.keeper.GetCommittee(ctx, proposal.CommitteeID)
+		if !found {
+			continue
+		}
+		if com, ok := committee.(*types.TokenCommittee); ok && vote.Weight == nil {
+			weight := m.keeper.GetVotingPower(ctx, vote.Voter, com.TallyDenom)
+			vote.Weight = &weight
+			m.keeper.SetVote(ctx, vote)
+		}
+	}
+	return nil
+}
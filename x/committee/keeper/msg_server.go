@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/0glabs/0g-chain/x/committee/types"
 )
@@ -29,7 +31,7 @@ func (m msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitPro
 		return nil, err
 	}
 
-	proposalID, err := m.keeper.SubmitProposal(ctx, proposer, msg.CommitteeID, msg.GetPubProposal())
+	proposalID, err := m.keeper.SubmitProposalWithDeposit(ctx, proposer, msg.CommitteeID, msg.GetPubProposal(), msg.Deposit, msg.Expedited)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +56,12 @@ func (m msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVo
 		return nil, err
 	}
 
-	if err := m.keeper.AddVote(ctx, msg.ProposalID, voter, msg.VoteType); err != nil {
+	if len(msg.Options) > 0 {
+		err = m.keeper.AddWeightedVote(ctx, msg.ProposalID, voter, msg.Options)
+	} else {
+		err = m.keeper.AddVote(ctx, msg.ProposalID, voter, msg.VoteType)
+	}
+	if err != nil {
 		return nil, err
 	}
 
@@ -68,3 +75,39 @@ func (m msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVo
 
 	return &types.MsgVoteResponse{}, nil
 }
+
+// CancelProposal handles MsgCancelProposal messages
+func (m msgServer) CancelProposal(goCtx context.Context, msg *types.MsgCancelProposal) (*types.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.CancelProposal(ctx, msg.ProposalID, proposer); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer),
+		),
+	)
+
+	return &types.MsgCancelProposalResponse{}, nil
+}
+
+// ChangeParams handles MsgChangeParams messages
+func (m msgServer) ChangeParams(goCtx context.Context, msg *types.MsgChangeParams) (*types.MsgChangeParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.keeper.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.keeper.authority, msg.Authority)
+	}
+	m.keeper.SetParams(ctx, *msg.Params)
+
+	return &types.MsgChangeParamsResponse{}, nil
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/0glabs/0g-chain/x/committee/testutil"
+	"github.com/0glabs/0g-chain/x/committee/types"
+	dasignerstypes "github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (suite *keeperTestSuite) TestExecuteMsgsProposal() {
+	suite.App.InitializeFromGenesisStates()
+	ctx := suite.App.NewContext(false, tmproto.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+	dasignersKeeper := suite.App.GetDASignersKeeper()
+	authority := suite.Keeper.GetAuthority()
+	params := dasignersKeeper.GetParams(ctx)
+
+	// the committee may change the dasigners params except the epoch blocks
+	com := types.MustNewMemberCommittee(
+		1,
+		"This committee is for testing.",
+		suite.Addresses[:3],
+		[]types.Permission{&types.MsgPermission{
+			AllowedMsgs: types.AllowedMsgs{{
+				TypeUrl: sdk.MsgTypeURL(&dasignerstypes.MsgChangeParams{}),
+				FieldRequirements: []types.MsgFieldRequirement{
+					{Path: "params.epoch_blocks", Value: fmt.Sprintf(`"%d"`, params.EpochBlocks)},
+				},
+			}},
+		}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	suite.Keeper.SetCommittee(ctx, com)
+
+	newParams := params
+	newParams.TokensPerVote++
+	pubProposal := types.MustNewExecuteMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		&dasignerstypes.MsgChangeParams{Authority: authority, Params: &newParams},
+	})
+	proposalID, err := suite.Keeper.SubmitProposal(ctx, suite.Addresses[0], com.ID, &pubProposal)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[1], types.VOTE_TYPE_YES))
+	suite.Keeper.ProcessProposals(ctx)
+	_, found := suite.Keeper.GetProposal(ctx, proposalID)
+	suite.Require().False(found)
+	suite.Require().Equal(newParams, dasignersKeeper.GetParams(ctx))
+
+	testCases := []struct {
+		name string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			name: "constrained field",
+			msgs: []sdk.Msg{&dasignerstypes.MsgChangeParams{Authority: authority, Params: func() *dasignerstypes.Params {
+				p := newParams
+				p.EpochBlocks++
+				return &p
+			}()}},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "not allowed type",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(authority), suite.Addresses[0], sdk.NewCoins(sdk.NewInt64Coin("ua0gi", 1)))},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "allowed and not allowed messages",
+			msgs: []sdk.Msg{
+				&dasignerstypes.MsgChangeParams{Authority: authority, Params: &newParams},
+				banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(authority), suite.Addresses[0], sdk.NewCoins(sdk.NewInt64Coin("ua0gi", 1))),
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "not signed by the authority",
+			msgs: []sdk.Msg{&dasignerstypes.MsgChangeParams{Authority: suite.Addresses[0].String(), Params: &newParams}},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "no messages",
+			msgs: []sdk.Msg{},
+			err:  types.ErrInvalidPubProposal,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			pubProposal := types.MustNewExecuteMsgsProposal("A Title", "A description of this proposal.", tc.msgs)
+			_, err := suite.Keeper.SubmitProposal(ctx, suite.Addresses[0], com.ID, &pubProposal)
+			suite.Require().ErrorIs(err, tc.err)
+		})
+	}
+
+	// god committees are not allowed to sign messages as the gov module account
+	godCom := types.MustNewMemberCommittee(
+		2,
+		"This committee is for testing.",
+		suite.Addresses[:3],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	suite.Keeper.SetCommittee(ctx, godCom)
+	_, err = suite.Keeper.SubmitProposal(ctx, suite.Addresses[0], godCom.ID, &pubProposal)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+}
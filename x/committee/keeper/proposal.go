@@ -4,14 +4,25 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/0glabs/0g-chain/x/committee/types"
 )
 
 // SubmitProposal adds a proposal to a committee so that it can be voted on.
 func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committeeID uint64, pubProposal types.PubProposal) (uint64, error) {
+	return k.SubmitProposalWithDeposit(ctx, proposer, committeeID, pubProposal, nil, false)
+}
+
+// SubmitProposalWithDeposit adds a proposal to a committee, escrowing the deposit of the proposer until the proposal
+// closes. Expedited proposals use the expedited proposal duration and vote threshold of the committee.
+func (k Keeper) SubmitProposalWithDeposit(ctx sdk.Context, proposer sdk.AccAddress, committeeID uint64, pubProposal types.PubProposal,
+	deposit sdk.Coins, expedited bool,
+) (uint64, error) {
 	// Limit proposals to only be submitted by committee members
 	com, found := k.GetCommittee(ctx, committeeID)
 	if !found {
@@ -31,9 +42,27 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 		return 0, err
 	}
 
+	duration := com.GetProposalDuration()
+	if expedited {
+		if !com.AllowsExpedited() {
+			return 0, errorsmod.Wrapf(types.ErrExpeditedNotAllowed, "%d", committeeID)
+		}
+		duration = com.GetExpeditedProposalDuration()
+	}
+
+	// Escrow the deposit
+	if !deposit.IsAllGTE(com.GetMinDeposit()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidDeposit, "%s is less than the min deposit %s", deposit, com.GetMinDeposit())
+	}
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, proposer, types.ModuleName, deposit); err != nil {
+			return 0, err
+		}
+	}
+
 	// Get a new ID and store the proposal
-	deadline := ctx.BlockTime().Add(com.GetProposalDuration())
-	proposalID, err := k.StoreNewProposal(ctx, pubProposal, committeeID, deadline)
+	deadline := ctx.BlockTime().Add(duration)
+	proposalID, err := k.StoreNewProposal(ctx, pubProposal, committeeID, deadline, proposer, deposit, expedited)
 	if err != nil {
 		return 0, err
 	}
@@ -44,14 +73,48 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.GetID())),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 			sdk.NewAttribute(types.AttributeKeyDeadline, deadline.String()),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, deposit.String()),
+			sdk.NewAttribute(types.AttributeKeyExpedited, fmt.Sprintf("%t", expedited)),
 		),
 	)
 	return proposalID, nil
 }
 
+// CancelProposal closes an active proposal on behalf of its proposer, burning the deposit so that proposals are not
+// submitted and withdrawn for free.
+func (k Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) error {
+	pr, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if pr.HasExpiredBy(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrProposalExpired, "%s ≥ %s", ctx.BlockTime(), pr.Deadline)
+	}
+	if pr.Proposer.Empty() || !pr.Proposer.Equals(proposer) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the proposer can cancel the proposal")
+	}
+
+	k.CloseProposal(ctx, pr, types.Cancelled)
+	return nil
+}
+
 // AddVote submits a vote on a proposal.
 func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, voteType types.VoteType) error {
+	return k.addVote(ctx, types.NewVote(proposalID, voter, voteType))
+}
+
+// AddWeightedVote submits a vote on a proposal split across the vote types of the options.
+func (k Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, options types.WeightedVoteOptions) error {
+	return k.addVote(ctx, types.NewWeightedVote(proposalID, voter, options))
+}
+
+func (k Keeper) addVote(ctx sdk.Context, vote types.Vote) error {
 	// Validate
+	if err := vote.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidVoteType, err.Error())
+	}
+	proposalID, voter := vote.ProposalID, vote.Voter
 	pr, found := k.GetProposal(ctx, proposalID)
 	if !found {
 		return errorsmod.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -64,17 +127,24 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 		return errorsmod.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
 	}
 
-	if _, ok := com.(*types.MemberCommittee); ok {
+	switch c := com.(type) {
+	case *types.MemberCommittee, *types.CouncilCommittee:
 		if !com.HasMember(voter) {
 			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "voter must be a member of committee")
 		}
-		if voteType != types.VOTE_TYPE_YES {
-			return errorsmod.Wrap(types.ErrInvalidVoteType, "member committees only accept yes votes")
-		}
+	case *types.TokenCommittee:
+		// capture the voting power, tokens received after the vote are not counted
+		weight := k.GetVotingPower(ctx, voter, c.TallyDenom)
+		vote.Weight = &weight
 	}
 
 	// Store vote, overwriting any prior vote
-	k.SetVote(ctx, types.NewVote(proposalID, voter, voteType))
+	k.SetVote(ctx, vote)
+
+	voteAttr := fmt.Sprintf("%d", vote.VoteType)
+	if len(vote.Options) > 0 {
+		voteAttr = vote.Options.String()
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -82,7 +152,7 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.GetID())),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", pr.ID)),
 			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
-			sdk.NewAttribute(types.AttributeKeyVote, fmt.Sprintf("%d", voteType)),
+			sdk.NewAttribute(types.AttributeKeyVote, voteAttr),
 		),
 	)
 	return nil
@@ -97,13 +167,13 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 		return err
 	}
 
-	if !k.router.HasRoute(pubProposal.ProposalRoute()) {
+	handler, found := k.getProposalHandler(pubProposal)
+	if !found {
 		return errorsmod.Wrapf(types.ErrNoProposalHandlerExists, "%T", pubProposal)
 	}
 
 	// Run the proposal's changes through the associated handler using a cached version of state to ensure changes are not permanent.
 	cacheCtx, _ := ctx.CacheContext()
-	handler := k.router.GetRoute(pubProposal.ProposalRoute())
 
 	// Handle an edge case where a param change proposal causes the proposal handler to panic.
 	// A param change proposal with a registered subspace value but unregistered key value will cause a panic in the param change proposal handler.
@@ -121,6 +191,46 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 	return nil
 }
 
+// getProposalHandler returns the handler enacting a pub proposal. ExecuteMsgsProposals are executed through the msg
+// router, the other proposals are routed to the gov handlers.
+func (k Keeper) getProposalHandler(pubProposal types.PubProposal) (govv1beta1.Handler, bool) {
+	if _, ok := pubProposal.(*types.ExecuteMsgsProposal); ok {
+		return k.executeMsgs, true
+	}
+	if !k.router.HasRoute(pubProposal.ProposalRoute()) {
+		return nil, false
+	}
+	return k.router.GetRoute(pubProposal.ProposalRoute()), true
+}
+
+// executeMsgs executes the messages of an ExecuteMsgsProposal, each message must be signed by the committee authority only.
+func (k Keeper) executeMsgs(ctx sdk.Context, content govv1beta1.Content) error {
+	proposal, ok := content.(*types.ExecuteMsgsProposal)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidPubProposal, "unexpected proposal %T", content)
+	}
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPubProposal, err.Error())
+	}
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || signers[0].String() != k.authority {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "msg %d must be signed by the committee authority %s only", i, k.authority)
+		}
+		handler := k.msgRouter.Handler(msg)
+		if handler == nil {
+			return errorsmod.Wrapf(types.ErrNoProposalHandlerExists, "msg %d: %s", i, sdk.MsgTypeURL(msg))
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return errorsmod.Wrapf(err, "msg %d", i)
+		}
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+	return nil
+}
+
 func (k Keeper) ProcessProposals(ctx sdk.Context) {
 	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
 		committee, found := k.GetCommittee(ctx, proposal.CommitteeID)
@@ -155,6 +265,8 @@ func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee
 		return k.GetMemberCommitteeProposalResult(ctx, proposalID, com)
 	case *types.TokenCommittee:
 		return k.GetTokenCommitteeProposalResult(ctx, proposalID, com)
+	case *types.CouncilCommittee:
+		return k.GetCouncilCommitteeProposalResult(ctx, proposalID, com)
 	default: // Should never hit default case
 		return false
 	}
@@ -162,56 +274,120 @@ func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee
 
 // GetMemberCommitteeProposalResult gets the result of a member committee proposal
 func (k Keeper) GetMemberCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
-	currVotes := k.TallyMemberCommitteeVotes(ctx, proposalID)
-	possibleVotes := sdk.NewDec(int64(len(committee.GetMembers())))
-	return currVotes.GTE(committee.GetVoteThreshold().Mul(possibleVotes)) // vote threshold requirements
+	yesVotes, _, _ := k.TallyMemberCommitteeVotes(ctx, proposalID, committee)
+	possibleVotes := committee.GetTotalWeight()
+	return yesVotes.GTE(k.getVoteThreshold(ctx, proposalID, committee).Mul(possibleVotes)) // vote threshold requirements
 }
 
-// TallyMemberCommitteeVotes returns the polling status of a member committee vote
-func (k Keeper) TallyMemberCommitteeVotes(ctx sdk.Context, proposalID uint64) (totalVotes sdk.Dec) {
-	votes := k.GetVotesByProposal(ctx, proposalID)
-	return sdk.NewDec(int64(len(votes)))
+// TallyMemberCommitteeVotes returns the polling status of a member committee vote. Each vote counts the weight of
+// the member split across its options, the votes of addresses that are no longer members are ignored.
+func (k Keeper) TallyMemberCommitteeVotes(ctx sdk.Context, proposalID uint64, committee types.Committee) (yesVotes, noVotes, abstainVotes sdk.Dec) {
+	return k.tallyVotes(ctx, proposalID, func(vote types.Vote) sdk.Dec {
+		return committee.GetMemberWeight(vote.Voter)
+	})
+}
+
+// getVoteThreshold returns the vote threshold of a proposal, expedited proposals use the expedited vote threshold of
+// the committee.
+func (k Keeper) getVoteThreshold(ctx sdk.Context, proposalID uint64, committee types.Committee) sdk.Dec {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if found && proposal.Expedited && committee.AllowsExpedited() {
+		return committee.GetExpeditedVoteThreshold()
+	}
+	return committee.GetVoteThreshold()
+}
+
+// tallyVotes sums the voting power of each vote of a proposal split across the options of the vote.
+func (k Keeper) tallyVotes(ctx sdk.Context, proposalID uint64, votingPower func(vote types.Vote) sdk.Dec) (yesVotes, noVotes, abstainVotes sdk.Dec) {
+	yesVotes = sdk.ZeroDec()
+	noVotes = sdk.ZeroDec()
+	abstainVotes = sdk.ZeroDec()
+	for _, vote := range k.GetVotesByProposal(ctx, proposalID) {
+		power := votingPower(vote)
+		if power.IsZero() {
+			continue
+		}
+		for _, option := range vote.GetOptions() {
+			switch option.Option {
+			case types.VOTE_TYPE_YES:
+				yesVotes = yesVotes.Add(power.Mul(option.Weight))
+			case types.VOTE_TYPE_NO:
+				noVotes = noVotes.Add(power.Mul(option.Weight))
+			case types.VOTE_TYPE_ABSTAIN:
+				abstainVotes = abstainVotes.Add(power.Mul(option.Weight))
+			}
+		}
+	}
+	return yesVotes, noVotes, abstainVotes
+}
+
+// GetCouncilCommitteeProposalResult gets the result of a council committee proposal,
+// only the votes of the members of the current council are counted
+func (k Keeper) GetCouncilCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
+	if len(committee.GetMembers()) == 0 {
+		return false
+	}
+	yesVotes, _, _ := k.TallyCouncilCommitteeVotes(ctx, proposalID, committee)
+	possibleVotes := committee.GetTotalWeight()
+	return yesVotes.GTE(k.getVoteThreshold(ctx, proposalID, committee).Mul(possibleVotes)) // vote threshold requirements
+}
+
+// TallyCouncilCommitteeVotes returns the polling status of a council committee vote,
+// the votes of the members rotated out of the council are ignored
+func (k Keeper) TallyCouncilCommitteeVotes(ctx sdk.Context, proposalID uint64, committee types.Committee) (yesVotes, noVotes, abstainVotes sdk.Dec) {
+	return k.TallyMemberCommitteeVotes(ctx, proposalID, committee)
 }
 
 // GetTokenCommitteeProposalResult gets the result of a token committee proposal
 func (k Keeper) GetTokenCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee *types.TokenCommittee) bool {
-	yesVotes, noVotes, totalVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposalID, committee.TallyDenom)
+	yesVotes, noVotes, _, totalVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposalID, committee.TallyDenom)
 	if totalVotes.GTE(committee.Quorum.Mul(possibleVotes)) { // quorum requirement
 		nonAbstainVotes := yesVotes.Add(noVotes)
-		if yesVotes.GTE(nonAbstainVotes.Mul(committee.VoteThreshold)) { // vote threshold requirements
+		if yesVotes.GTE(nonAbstainVotes.Mul(k.getVoteThreshold(ctx, proposalID, committee))) { // vote threshold requirements
 			return true
 		}
 	}
 	return false
 }
 
-// TallyTokenCommitteeVotes returns the polling status of a token committee vote. Returns yes votes,
+// TallyTokenCommitteeVotes returns the polling status of a token committee vote. Returns yes, no and abstain votes,
 // total current votes, total possible votes (equal to token supply), vote threshold (yes vote ratio
 // required for proposal to pass), and quorum (votes tallied at this percentage).
+// Each vote counts the lesser of the voting power captured at vote time and the current voting power, so a token
+// moved to another voter after voting is counted once.
 func (k Keeper) TallyTokenCommitteeVotes(ctx sdk.Context, proposalID uint64,
 	tallyDenom string,
-) (yesVotes, noVotes, totalVotes, possibleVotes sdk.Dec) {
-	votes := k.GetVotesByProposal(ctx, proposalID)
-
-	yesVotes = sdk.ZeroDec()
-	noVotes = sdk.ZeroDec()
-	totalVotes = sdk.ZeroDec()
-	for _, vote := range votes {
+) (yesVotes, noVotes, abstainVotes, totalVotes, possibleVotes sdk.Dec) {
+	yesVotes, noVotes, abstainVotes = k.tallyVotes(ctx, proposalID, func(vote types.Vote) sdk.Dec {
 		// 1 token = 1 vote
-		acc := k.accountKeeper.GetAccount(ctx, vote.Voter)
-		accNumCoins := k.bankKeeper.GetBalance(ctx, acc.GetAddress(), tallyDenom).Amount
-
-		// Add votes to counters
-		totalVotes = totalVotes.Add(sdk.NewDecFromInt(accNumCoins))
-		if vote.VoteType == types.VOTE_TYPE_YES {
-			yesVotes = yesVotes.Add(sdk.NewDecFromInt(accNumCoins))
-		} else if vote.VoteType == types.VOTE_TYPE_NO {
-			noVotes = noVotes.Add(sdk.NewDecFromInt(accNumCoins))
+		power := k.GetVotingPower(ctx, vote.Voter, tallyDenom)
+		// votes without a captured weight count the current voting power
+		if vote.Weight != nil {
+			power = sdkmath.MinInt(power, *vote.Weight)
 		}
-	}
+		return sdk.NewDecFromInt(power)
+	})
+	totalVotes = yesVotes.Add(noVotes).Add(abstainVotes)
 
 	possibleVotesInt := k.bankKeeper.GetSupply(ctx, tallyDenom).Amount
-	return yesVotes, noVotes, totalVotes, sdk.NewDecFromInt(possibleVotesInt)
+	return yesVotes, noVotes, abstainVotes, totalVotes, sdk.NewDecFromInt(possibleVotesInt)
+}
+
+// GetVotingPower returns the token committee voting power of an address, its balance of the tally denom plus the
+// tokens it has staked when the tally denom is the bond denom.
+func (k Keeper) GetVotingPower(ctx sdk.Context, voter sdk.AccAddress, tallyDenom string) sdkmath.Int {
+	power := k.bankKeeper.GetBalance(ctx, voter, tallyDenom).Amount
+	if tallyDenom != k.stakingKeeper.BondDenom(ctx) {
+		return power
+	}
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, voter, func(delegation stakingtypes.Delegation) bool {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if found {
+			power = power.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+		}
+		return false
+	})
+	return power
 }
 
 func (k Keeper) attemptEnactProposal(ctx sdk.Context, proposal types.Proposal) types.ProposalOutcome {
@@ -239,7 +415,7 @@ func (k Keeper) enactProposal(ctx sdk.Context, proposal types.Proposal) error {
 	}
 
 	// enact the proposal
-	handler := k.router.GetRoute(proposal.GetContent().ProposalRoute())
+	handler, _ := k.getProposalHandler(proposal.GetContent())
 	if err := handler(ctx, proposal.GetContent()); err != nil {
 		// the handler should not error as it was checked in ValidatePubProposal
 		panic(fmt.Sprintf("unexpected handler error: %s", err))
@@ -260,35 +436,82 @@ func (k Keeper) GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*t
 	var proposalTally types.QueryTallyResponse
 	switch com := committee.(type) {
 	case *types.MemberCommittee:
-		currVotes := k.TallyMemberCommitteeVotes(ctx, proposal.ID)
-		possibleVotes := sdk.NewDec(int64(len(com.Members)))
+		yesVotes, noVotes, abstainVotes := k.TallyMemberCommitteeVotes(ctx, proposal.ID, com)
 		proposalTally = types.QueryTallyResponse{
 			ProposalID:    proposal.ID,
-			YesVotes:      currVotes,
-			NoVotes:       sdk.ZeroDec(),
-			CurrentVotes:  currVotes,
-			PossibleVotes: possibleVotes,
-			VoteThreshold: com.VoteThreshold,
+			YesVotes:      yesVotes,
+			NoVotes:       noVotes,
+			AbstainVotes:  abstainVotes,
+			CurrentVotes:  yesVotes.Add(noVotes).Add(abstainVotes),
+			PossibleVotes: com.GetTotalWeight(),
+			VoteThreshold: k.getVoteThreshold(ctx, proposal.ID, com),
+			Quorum:        sdk.ZeroDec(),
+		}
+	case *types.CouncilCommittee:
+		yesVotes, noVotes, abstainVotes := k.TallyCouncilCommitteeVotes(ctx, proposal.ID, com)
+		proposalTally = types.QueryTallyResponse{
+			ProposalID:    proposal.ID,
+			YesVotes:      yesVotes,
+			NoVotes:       noVotes,
+			AbstainVotes:  abstainVotes,
+			CurrentVotes:  yesVotes.Add(noVotes).Add(abstainVotes),
+			PossibleVotes: com.GetTotalWeight(),
+			VoteThreshold: k.getVoteThreshold(ctx, proposal.ID, com),
 			Quorum:        sdk.ZeroDec(),
 		}
 	case *types.TokenCommittee:
-		yesVotes, noVotes, currVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposal.ID, com.TallyDenom)
+		yesVotes, noVotes, abstainVotes, currVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposal.ID, com.TallyDenom)
 		proposalTally = types.QueryTallyResponse{
 			ProposalID:    proposal.ID,
 			YesVotes:      yesVotes,
 			NoVotes:       noVotes,
+			AbstainVotes:  abstainVotes,
 			CurrentVotes:  currVotes,
 			PossibleVotes: possibleVotes,
-			VoteThreshold: com.VoteThreshold,
+			VoteThreshold: k.getVoteThreshold(ctx, proposal.ID, com),
 			Quorum:        com.Quorum,
 		}
 	}
 	return &proposalTally, true
 }
 
-// CloseProposal deletes proposals and their votes, emitting an event denoting the final status of the proposal
+// settleDeposit burns the deposit of a failed or cancelled proposal and refunds the deposit of the other proposals.
+func (k Keeper) settleDeposit(ctx sdk.Context, proposal types.Proposal, outcome types.ProposalOutcome) {
+	if proposal.Deposit.IsZero() {
+		return
+	}
+	var err error
+	if outcome == types.Failed || outcome == types.Cancelled {
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, proposal.Deposit)
+	} else {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposal.Proposer, proposal.Deposit)
+	}
+	if err != nil {
+		// the deposit is left in the module account rather than failing to close the proposal
+		ctx.Logger().Error("failed to settle proposal deposit", "proposal", proposal.ID, "err", err)
+	}
+}
+
+// CloseProposal deletes proposals and their votes, archiving them with the outcome and final tally and emitting an
+// event denoting the final status of the proposal
 func (k Keeper) CloseProposal(ctx sdk.Context, proposal types.Proposal, outcome types.ProposalOutcome) {
-	tally, _ := k.GetProposalTallyResponse(ctx, proposal.ID)
+	tally, found := k.GetProposalTallyResponse(ctx, proposal.ID)
+	// the tally of a proposal whose committee was deleted is empty
+	proposalTally := types.ProposalTally{
+		YesVotes:      sdk.ZeroDec(),
+		NoVotes:       sdk.ZeroDec(),
+		AbstainVotes:  sdk.ZeroDec(),
+		CurrentVotes:  sdk.ZeroDec(),
+		PossibleVotes: sdk.ZeroDec(),
+		VoteThreshold: sdk.ZeroDec(),
+		Quorum:        sdk.ZeroDec(),
+	}
+	if found {
+		proposalTally = types.NewProposalTally(*tally)
+	}
+	k.settleDeposit(ctx, proposal, outcome)
+	votes := k.GetVotesByProposal(ctx, proposal.ID)
+	k.SetClosedProposal(ctx, types.NewClosedProposal(proposal, outcome, proposalTally, votes, ctx.BlockHeight(), ctx.BlockTime()))
 	k.DeleteProposalAndVotes(ctx, proposal.ID)
 
 	bz, err := k.cdc.MarshalJSON(tally)
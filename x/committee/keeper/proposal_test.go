@@ -331,6 +331,14 @@ func (suite *keeperTestSuite) TestAddVote() {
 			proposalID: types.DefaultNextProposalID,
 			voter:      memberCom.Members[0],
 			voteType:   types.VOTE_TYPE_NO,
+			expectErr:  false,
+		},
+		{
+			name:       "MemberCommittee: voter votes unspecified",
+			committee:  memberCom,
+			proposalID: types.DefaultNextProposalID,
+			voter:      memberCom.Members[0],
+			voteType:   types.VOTE_TYPE_UNSPECIFIED,
 			expectErr:  true,
 		},
 	}
@@ -426,8 +434,8 @@ func (suite *keeperTestSuite) TestTallyMemberCommitteeVotes() {
 		)
 
 		// Check that all votes are counted
-		currentVotes := keeper.TallyMemberCommitteeVotes(ctx, defaultProposalID)
-		suite.Equal(tc.expectedVoteCount, currentVotes)
+		yesVotes, _, _ := keeper.TallyMemberCommitteeVotes(ctx, defaultProposalID, memberCom)
+		suite.Equal(tc.expectedVoteCount, yesVotes)
 	}
 }
 
@@ -565,7 +573,7 @@ func (suite *keeperTestSuite) TestTallyTokenCommitteeVotes() {
 			app.NewFundedGenStateWithCoins(tApp.AppCodec(), genCoins, genAddrs),
 		)
 
-		yesVotes, noVotes, currVotes, possibleVotes := keeper.TallyTokenCommitteeVotes(ctx, defaultProposalID, tokenCom.TallyDenom)
+		yesVotes, noVotes, _, currVotes, possibleVotes := keeper.TallyTokenCommitteeVotes(ctx, defaultProposalID, tokenCom.TallyDenom)
 
 		// Check that all Yes votes are counted according to their weight
 		suite.Equal(tc.expectedYesVoteCount, yesVotes)
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/0glabs/0g-chain/x/committee/keeper"
+	"github.com/0glabs/0g-chain/x/committee/testutil"
+	"github.com/0glabs/0g-chain/x/committee/types"
+)
+
+func (suite *keeperTestSuite) TestTokenCommitteeVoteWeight() {
+	suite.App.InitializeFromGenesisStates()
+	ctx := suite.App.NewContext(false, tmproto.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+	denom := suite.App.GetStakingKeeper().BondDenom(ctx)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+	for i, amount := range []int64{100, 0, 150, 10, 100} {
+		suite.Require().NoError(suite.App.FundAccount(ctx, suite.Addresses[i], coins(amount)))
+	}
+
+	com := types.MustNewTokenCommittee(
+		1,
+		"This token committee is for testing.",
+		suite.Addresses[:1],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+		sdk.ZeroDec(),
+		denom,
+	)
+	suite.Keeper.SetCommittee(ctx, com)
+	proposalID, err := suite.Keeper.SubmitProposal(ctx, suite.Addresses[0], com.ID, govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+	tally := func() (sdk.Dec, sdk.Dec) {
+		yesVotes, noVotes, _, _, _ := suite.Keeper.TallyTokenCommitteeVotes(ctx, proposalID, denom)
+		return yesVotes, noVotes
+	}
+
+	// tokens moved to another voter after voting are counted once
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+	suite.Require().NoError(suite.BankKeeper.SendCoins(ctx, suite.Addresses[0], suite.Addresses[1], coins(100)))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[1], types.VOTE_TYPE_YES))
+	yesVotes, _ := tally()
+	suite.Require().Equal(sdk.NewDec(100), yesVotes)
+
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[2], types.VOTE_TYPE_NO))
+	suite.Require().False(suite.Keeper.GetProposalResult(ctx, proposalID, com))
+
+	// moving the tokens back and voting again does not swing the proposal either
+	suite.Require().NoError(suite.BankKeeper.SendCoins(ctx, suite.Addresses[1], suite.Addresses[0], coins(100)))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+	yesVotes, noVotes := tally()
+	suite.Require().Equal(sdk.NewDec(100), yesVotes)
+	suite.Require().Equal(sdk.NewDec(150), noVotes)
+	suite.Require().False(suite.Keeper.GetProposalResult(ctx, proposalID, com))
+
+	// tokens received after voting are not counted
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[3], types.VOTE_TYPE_YES))
+	suite.Require().NoError(suite.App.FundAccount(ctx, suite.Addresses[3], coins(1000)))
+	yesVotes, _ = tally()
+	suite.Require().Equal(sdk.NewDec(110), yesVotes)
+
+	// staked tokens are counted
+	suite.Require().NoError(suite.App.CreateNewUnbondedValidator(ctx, sdk.ValAddress(suite.Addresses[4]), sdkmath.NewInt(60)))
+	suite.Require().Equal(sdkmath.NewInt(100), suite.Keeper.GetVotingPower(ctx, suite.Addresses[4], denom))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[4], types.VOTE_TYPE_YES))
+	vote, found := suite.Keeper.GetVote(ctx, proposalID, suite.Addresses[4])
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(100), *vote.Weight)
+	yesVotes, _ = tally()
+	suite.Require().Equal(sdk.NewDec(210), yesVotes)
+	suite.Require().True(suite.Keeper.GetProposalResult(ctx, proposalID, com))
+
+	// votes without a captured weight count the current voting power until migrated
+	suite.Keeper.SetVote(ctx, types.NewVote(proposalID, suite.Addresses[3], types.VOTE_TYPE_YES))
+	yesVotes, _ = tally()
+	suite.Require().Equal(sdk.NewDec(1210), yesVotes)
+	suite.Require().NoError(keeper.NewMigrator(suite.Keeper).Migrate1to2(ctx))
+	vote, found = suite.Keeper.GetVote(ctx, proposalID, suite.Addresses[3])
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(1010), *vote.Weight)
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/0glabs/0g-chain/x/committee/keeper"
+	"github.com/0glabs/0g-chain/x/committee/testutil"
+	"github.com/0glabs/0g-chain/x/committee/types"
+)
+
+func (suite *keeperTestSuite) TestWeightedVotes() {
+	suite.App.InitializeFromGenesisStates()
+	ctx := suite.App.NewContext(false, tmproto.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+
+	com := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:3],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	com.MemberWeights = []sdk.Dec{testutil.D("3"), testutil.D("1"), testutil.D("1")}
+	suite.Keeper.SetCommittee(ctx, com)
+	proposalID, err := suite.Keeper.SubmitProposal(ctx, suite.Addresses[0], com.ID, govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+
+	// the weight of a member is split across the options of its vote
+	suite.Require().NoError(suite.Keeper.AddWeightedVote(ctx, proposalID, suite.Addresses[0], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.VOTE_TYPE_YES, testutil.D("0.5")),
+		types.NewWeightedVoteOption(types.VOTE_TYPE_NO, testutil.D("0.25")),
+		types.NewWeightedVoteOption(types.VOTE_TYPE_ABSTAIN, testutil.D("0.25")),
+	}))
+	suite.Require().False(suite.Keeper.GetProposalResult(ctx, proposalID, com))
+
+	// invalid splits are rejected
+	msgServer := keeper.NewMsgServerImpl(suite.Keeper)
+	_, err = msgServer.Vote(sdk.WrapSDKContext(ctx), types.NewMsgWeightedVote(suite.Addresses[1], proposalID, types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.VOTE_TYPE_YES, testutil.D("0.5")),
+	}))
+	suite.Require().ErrorIs(err, types.ErrInvalidVoteType)
+	_, err = msgServer.Vote(sdk.WrapSDKContext(ctx), types.NewMsgWeightedVote(suite.Addresses[1], proposalID, types.NewNonSplitVoteOption(types.VOTE_TYPE_YES)))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.Keeper.GetProposalResult(ctx, proposalID, com))
+
+	tally, found := suite.Keeper.GetProposalTallyResponse(ctx, proposalID)
+	suite.Require().True(found)
+	suite.Require().Equal(testutil.D("2.5"), tally.YesVotes)
+	suite.Require().Equal(testutil.D("0.75"), tally.NoVotes)
+	suite.Require().Equal(testutil.D("0.75"), tally.AbstainVotes)
+	suite.Require().Equal(testutil.D("4"), tally.CurrentVotes)
+	suite.Require().Equal(testutil.D("5"), tally.PossibleVotes)
+
+	// the votes of removed members are ignored
+	com.Members = suite.Addresses[1:3]
+	com.MemberWeights = []sdk.Dec{testutil.D("1"), testutil.D("1")}
+	suite.Keeper.SetCommittee(ctx, com)
+	tally, _ = suite.Keeper.GetProposalTallyResponse(ctx, proposalID)
+	suite.Require().Equal(testutil.D("1"), tally.YesVotes)
+	suite.Require().Equal(testutil.D("0"), tally.AbstainVotes)
+	suite.Require().Equal(testutil.D("2"), tally.PossibleVotes)
+}
+
+func (suite *keeperTestSuite) TestTokenCommitteeWeightedVotes() {
+	suite.App.InitializeFromGenesisStates()
+	ctx := suite.App.NewContext(false, tmproto.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+	suite.Require().NoError(suite.App.FundAccount(ctx, suite.Addresses[0], sdk.NewCoins(sdk.NewInt64Coin("votes", 100))))
+	suite.Require().NoError(suite.App.FundAccount(ctx, suite.Addresses[1], sdk.NewCoins(sdk.NewInt64Coin("votes", 100))))
+
+	com := types.MustNewTokenCommittee(
+		1,
+		"This token committee is for testing.",
+		suite.Addresses[:1],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+		testutil.D("0.5"),
+		"votes",
+	)
+	suite.Keeper.SetCommittee(ctx, com)
+	proposalID, err := suite.Keeper.SubmitProposal(ctx, suite.Addresses[0], com.ID, govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+
+	// abstain votes count towards the quorum only
+	suite.Require().NoError(suite.Keeper.AddWeightedVote(ctx, proposalID, suite.Addresses[0], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.VOTE_TYPE_YES, testutil.D("0.3")),
+		types.NewWeightedVoteOption(types.VOTE_TYPE_ABSTAIN, testutil.D("0.7")),
+	}))
+	suite.Require().NoError(suite.Keeper.AddVote(ctx, proposalID, suite.Addresses[1], types.VOTE_TYPE_NO))
+	yesVotes, noVotes, abstainVotes, totalVotes, possibleVotes := suite.Keeper.TallyTokenCommitteeVotes(ctx, proposalID, com.TallyDenom)
+	suite.Require().Equal(testutil.D("30"), yesVotes)
+	suite.Require().Equal(testutil.D("100"), noVotes)
+	suite.Require().Equal(testutil.D("70"), abstainVotes)
+	suite.Require().Equal(testutil.D("200"), totalVotes)
+	suite.Require().Equal(testutil.D("200"), possibleVotes)
+	suite.Require().False(suite.Keeper.GetProposalResult(ctx, proposalID, com))
+
+	suite.Require().NoError(suite.Keeper.AddWeightedVote(ctx, proposalID, suite.Addresses[1], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.VOTE_TYPE_NO, testutil.D("0.2")),
+		types.NewWeightedVoteOption(types.VOTE_TYPE_ABSTAIN, testutil.D("0.8")),
+	}))
+	suite.Require().True(suite.Keeper.GetProposalResult(ctx, proposalID, com))
+}
@@ -55,12 +55,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*PubProposal)(nil), nil)
 	cdc.RegisterConcrete(CommitteeChangeProposal{}, "0g/CommitteeChangeProposal", nil)
 	cdc.RegisterConcrete(CommitteeDeleteProposal{}, "0g/CommitteeDeleteProposal", nil)
+	cdc.RegisterConcrete(ExecuteMsgsProposal{}, "0g/ExecuteMsgsProposal", nil)
 
 	// Committees
 	cdc.RegisterInterface((*Committee)(nil), nil)
 	cdc.RegisterConcrete(BaseCommittee{}, "0g/BaseCommittee", nil)
 	cdc.RegisterConcrete(MemberCommittee{}, "0g/MemberCommittee", nil)
 	cdc.RegisterConcrete(TokenCommittee{}, "0g/TokenCommittee", nil)
+	cdc.RegisterConcrete(CouncilCommittee{}, "0g/CouncilCommittee", nil)
 
 	// Permissions
 	cdc.RegisterInterface((*Permission)(nil), nil)
@@ -68,10 +70,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(TextPermission{}, "0g/TextPermission", nil)
 	cdc.RegisterConcrete(SoftwareUpgradePermission{}, "0g/SoftwareUpgradePermission", nil)
 	cdc.RegisterConcrete(ParamsChangePermission{}, "0g/ParamsChangePermission", nil)
+	cdc.RegisterConcrete(MsgPermission{}, "0g/MsgPermission", nil)
 
 	// Msgs
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "0g/MsgSubmitProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "0g/MsgVote")
+	legacy.RegisterAminoMsg(cdc, &MsgChangeParams{}, "0g/committee/MsgChangeParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelProposal{}, "0g/committee/MsgCancelProposal")
 }
 
 // RegisterProposalTypeCodec allows external modules to register their own pubproposal types on the
@@ -84,6 +89,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitProposal{},
 		&MsgVote{},
+		&MsgChangeParams{},
+		&MsgCancelProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -94,6 +101,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BaseCommittee{},
 		&TokenCommittee{},
 		&MemberCommittee{},
+		&CouncilCommittee{},
 	)
 
 	registry.RegisterInterface(
@@ -103,6 +111,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&TextPermission{},
 		&SoftwareUpgradePermission{},
 		&ParamsChangePermission{},
+		&MsgPermission{},
 	)
 
 	// Need to register PubProposal here since we use this as alias for the x/gov Content interface for all the proposal implementations used in this module.
@@ -116,6 +125,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&proposaltypes.ParameterChangeProposal{},
 		&upgradetypes.SoftwareUpgradeProposal{},
 		&upgradetypes.CancelSoftwareUpgradeProposal{},
+		&ExecuteMsgsProposal{},
 	)
 
 	registry.RegisterImplementations(
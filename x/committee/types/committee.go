@@ -2,8 +2,10 @@ package types
 
 import (
 	fmt "fmt"
+	"strings"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/0glabs/0g-chain/chaincfg"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -16,10 +18,11 @@ import (
 const MaxCommitteeDescriptionLength int = 512
 
 const (
-	BaseCommitteeType   = "0g/BaseCommittee"
-	MemberCommitteeType = "0g/MemberCommittee" // Committee is composed of member addresses that vote to enact proposals within their permissions
-	TokenCommitteeType  = "0g/TokenCommittee"  // Committee is composed of token holders with voting power determined by total token balance
-	BondDenom           = chaincfg.BondDenom
+	BaseCommitteeType    = "0g/BaseCommittee"
+	MemberCommitteeType  = "0g/MemberCommittee"  // Committee is composed of member addresses that vote to enact proposals within their permissions
+	TokenCommitteeType   = "0g/TokenCommittee"   // Committee is composed of token holders with voting power determined by total token balance
+	CouncilCommitteeType = "0g/CouncilCommittee" // Committee is composed of the members of the current council elected in x/council
+	BondDenom            = chaincfg.BondDenom
 )
 
 // Marshal needed for protobuf compatibility.
@@ -45,6 +48,8 @@ type Committee interface {
 	GetMembers() []sdk.AccAddress
 	SetMembers([]sdk.AccAddress)
 	HasMember(addr sdk.AccAddress) bool
+	GetMemberWeight(addr sdk.AccAddress) sdk.Dec
+	GetTotalWeight() sdk.Dec
 
 	GetPermissions() []Permission
 	SetPermissions([]Permission)
@@ -56,6 +61,11 @@ type Committee interface {
 	GetVoteThreshold() sdk.Dec
 	SetVoteThreshold(sdk.Dec)
 
+	GetMinDeposit() sdk.Coins
+	GetExpeditedProposalDuration() time.Duration
+	GetExpeditedVoteThreshold() sdk.Dec
+	AllowsExpedited() bool
+
 	GetTallyOption() TallyOption
 	Validate() error
 
@@ -104,6 +114,32 @@ func (c *BaseCommittee) HasMember(addr sdk.AccAddress) bool {
 	return false
 }
 
+// GetMemberWeight returns the voting weight of a member, zero for an address that is not a member
+func (c BaseCommittee) GetMemberWeight(addr sdk.AccAddress) sdk.Dec {
+	for i, m := range c.GetMembers() {
+		if !m.Equals(addr) {
+			continue
+		}
+		if len(c.MemberWeights) == 0 {
+			return sdk.OneDec()
+		}
+		return c.MemberWeights[i]
+	}
+	return sdk.ZeroDec()
+}
+
+// GetTotalWeight returns the sum of the voting weights of the members
+func (c BaseCommittee) GetTotalWeight() sdk.Dec {
+	if len(c.MemberWeights) == 0 {
+		return sdk.NewDec(int64(len(c.GetMembers())))
+	}
+	total := sdk.ZeroDec()
+	for _, w := range c.MemberWeights {
+		total = total.Add(w)
+	}
+	return total
+}
+
 // GetPermissions is a getter for committee permissions
 func (c *BaseCommittee) GetPermissions() []Permission {
 	permissions, err := UnpackPermissions(c.Permissions)
@@ -141,13 +177,16 @@ func (c BaseCommittee) String() string {
 	return fmt.Sprintf(`Committee %d:
 	Description:              %s
 	Members:               %s
+	MemberWeights:               %s
   	Permissions:               			%s
   	VoteThreshold:            		  %s
 	ProposalDuration:        						%s
-	TallyOption:   						%s`,
-		c.ID, c.Description, c.GetMembers(), c.Permissions,
+	TallyOption:   						%s
+	MinDeposit:   						%s
+	ExpeditedProposalDuration:   						%s`,
+		c.ID, c.Description, c.GetMembers(), c.MemberWeights, c.Permissions,
 		c.VoteThreshold.String(), c.ProposalDuration.String(),
-		c.TallyOption.String(),
+		c.TallyOption.String(), c.MinDeposit, c.ExpeditedProposalDuration.String(),
 	)
 }
 
@@ -167,6 +206,20 @@ func (c *BaseCommittee) SetProposalDuration(proposalDuration time.Duration) {
 	c.ProposalDuration = proposalDuration
 }
 
+// GetMinDeposit is a getter for committee MinDeposit
+func (c BaseCommittee) GetMinDeposit() sdk.Coins { return c.MinDeposit }
+
+// GetExpeditedProposalDuration is a getter for committee ExpeditedProposalDuration
+func (c BaseCommittee) GetExpeditedProposalDuration() time.Duration {
+	return c.ExpeditedProposalDuration
+}
+
+// GetExpeditedVoteThreshold is a getter for committee ExpeditedVoteThreshold
+func (c BaseCommittee) GetExpeditedVoteThreshold() sdk.Dec { return c.ExpeditedVoteThreshold }
+
+// AllowsExpedited returns if the committee accepts expedited proposals
+func (c BaseCommittee) AllowsExpedited() bool { return c.ExpeditedProposalDuration > 0 }
+
 // GetTallyOption is a getter for committee TallyOption
 func (c BaseCommittee) GetTallyOption() TallyOption { return c.TallyOption }
 
@@ -184,10 +237,6 @@ func (c BaseCommittee) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 
 // Validate validates BaseCommittee fields
 func (c BaseCommittee) Validate() error {
-	if len(c.Description) > MaxCommitteeDescriptionLength {
-		return fmt.Errorf("description length %d longer than max allowed %d", len(c.Description), MaxCommitteeDescriptionLength)
-	}
-
 	if len(c.Members) <= 0 {
 		return fmt.Errorf("committee must have members")
 	}
@@ -205,6 +254,25 @@ func (c BaseCommittee) Validate() error {
 		addressMap[m.String()] = true
 	}
 
+	// the weights are optional, every member has a weight of one without them
+	if len(c.MemberWeights) > 0 && len(c.MemberWeights) != len(c.Members) {
+		return fmt.Errorf("committee has %d member weights for %d members", len(c.MemberWeights), len(c.Members))
+	}
+	for _, w := range c.MemberWeights {
+		if w.IsNil() || !w.IsPositive() {
+			return fmt.Errorf("invalid member weight: %s", w)
+		}
+	}
+
+	return c.validateRules()
+}
+
+// validateRules validates BaseCommittee fields except the members
+func (c BaseCommittee) validateRules() error {
+	if len(c.Description) > MaxCommitteeDescriptionLength {
+		return fmt.Errorf("description length %d longer than max allowed %d", len(c.Description), MaxCommitteeDescriptionLength)
+	}
+
 	// validate permissions
 	permissions, err := UnpackPermissions(c.Permissions)
 	if err != nil {
@@ -229,6 +297,24 @@ func (c BaseCommittee) Validate() error {
 		return fmt.Errorf("invalid tally option: %d", c.TallyOption)
 	}
 
+	if err := c.MinDeposit.Validate(); err != nil {
+		return fmt.Errorf("invalid min deposit: %w", err)
+	}
+
+	// expedited proposals are disabled without a duration
+	if c.ExpeditedProposalDuration < 0 {
+		return fmt.Errorf("invalid expedited proposal duration: %s", c.ExpeditedProposalDuration)
+	}
+	if c.AllowsExpedited() {
+		if c.ExpeditedProposalDuration >= c.ProposalDuration {
+			return fmt.Errorf("expedited proposal duration %s must be shorter than the proposal duration %s", c.ExpeditedProposalDuration, c.ProposalDuration)
+		}
+		// expedited threshold must be in the range (threshold, 1]
+		if c.ExpeditedVoteThreshold.IsNil() || c.ExpeditedVoteThreshold.LTE(c.VoteThreshold) || c.ExpeditedVoteThreshold.GT(sdk.NewDec(1)) {
+			return fmt.Errorf("invalid expedited threshold: %s", c.ExpeditedVoteThreshold)
+		}
+	}
+
 	return nil
 }
 
@@ -242,13 +328,14 @@ func NewMemberCommittee(id uint64, description string, members []sdk.AccAddress,
 	}
 	return &MemberCommittee{
 		BaseCommittee: &BaseCommittee{
-			ID:               id,
-			Description:      description,
-			Members:          members,
-			Permissions:      permissionsAny,
-			VoteThreshold:    threshold,
-			ProposalDuration: duration,
-			TallyOption:      tallyOption,
+			ID:                     id,
+			Description:            description,
+			Members:                members,
+			Permissions:            permissionsAny,
+			VoteThreshold:          threshold,
+			ProposalDuration:       duration,
+			TallyOption:            tallyOption,
+			ExpeditedVoteThreshold: sdk.ZeroDec(),
 		},
 	}, nil
 }
@@ -277,13 +364,14 @@ func NewTokenCommittee(id uint64, description string, members []sdk.AccAddress,
 	}
 	return &TokenCommittee{
 		BaseCommittee: &BaseCommittee{
-			ID:               id,
-			Description:      description,
-			Members:          members,
-			Permissions:      permissionsAny,
-			VoteThreshold:    threshold,
-			ProposalDuration: duration,
-			TallyOption:      tallyOption,
+			ID:                     id,
+			Description:            description,
+			Members:                members,
+			Permissions:            permissionsAny,
+			VoteThreshold:          threshold,
+			ProposalDuration:       duration,
+			TallyOption:            tallyOption,
+			ExpeditedVoteThreshold: sdk.ZeroDec(),
 		},
 		Quorum:     quorum,
 		TallyDenom: tallyDenom,
@@ -325,9 +413,63 @@ func (c TokenCommittee) Validate() error {
 		return fmt.Errorf("invalid quorum: %s", c.Quorum)
 	}
 
+	// votes are weighted by the tokens of the voters
+	if len(c.MemberWeights) > 0 {
+		return fmt.Errorf("token committee cannot have member weights")
+	}
+
 	return c.BaseCommittee.Validate()
 }
 
+// NewCouncilCommittee instantiates a new instance of CouncilCommittee
+func NewCouncilCommittee(id uint64, description string, permissions []Permission,
+	threshold sdk.Dec, duration time.Duration, tallyOption TallyOption,
+) (*CouncilCommittee, error) {
+	permissionsAny, err := PackPermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+	return &CouncilCommittee{
+		BaseCommittee: &BaseCommittee{
+			ID:                     id,
+			Description:            description,
+			Permissions:            permissionsAny,
+			VoteThreshold:          threshold,
+			ProposalDuration:       duration,
+			TallyOption:            tallyOption,
+			ExpeditedVoteThreshold: sdk.ZeroDec(),
+		},
+	}, nil
+}
+
+// MustNewCouncilCommittee instantiates a new instance of CouncilCommittee and panics on error
+func MustNewCouncilCommittee(id uint64, description string, permissions []Permission,
+	threshold sdk.Dec, duration time.Duration, tallyOption TallyOption,
+) *CouncilCommittee {
+	committee, err := NewCouncilCommittee(id, description, permissions, threshold, duration, tallyOption)
+	if err != nil {
+		panic(err)
+	}
+	return committee
+}
+
+// GetType is a getter for committee type
+func (c CouncilCommittee) GetType() string { return CouncilCommitteeType }
+
+// Validate validates the committee's fields, the members are resolved from the current council and must not be stored
+func (c CouncilCommittee) Validate() error {
+	if c.BaseCommittee == nil {
+		return fmt.Errorf("council committee must have a base committee")
+	}
+	if len(c.Members) > 0 {
+		return fmt.Errorf("council committee cannot have stored members")
+	}
+	if len(c.MemberWeights) > 0 {
+		return fmt.Errorf("council committee cannot have member weights")
+	}
+	return c.BaseCommittee.validateRules()
+}
+
 // ------------------------------------------
 //				Proposals
 // ------------------------------------------
@@ -429,6 +571,9 @@ func (p Proposal) ProposalType() string {
 }
 
 func (p Proposal) ValidateBasic() error {
+	if err := p.Deposit.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidDeposit, err.Error())
+	}
 	content := p.GetContent()
 	if content == nil {
 		return nil
@@ -457,11 +602,142 @@ func NewVote(proposalID uint64, voter sdk.AccAddress, voteType VoteType) Vote {
 	}
 }
 
+// NewWeightedVote instantiates a new instance of Vote split across the vote types of the options
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Options:    options,
+	}
+}
+
+// GetOptions returns the options of the vote, a vote without options is cast entirely for its vote type
+func (v Vote) GetOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 {
+		return NewNonSplitVoteOption(v.VoteType)
+	}
+	return v.Options
+}
+
 // Validates Vote fields
 func (v Vote) Validate() error {
 	if v.Voter.Empty() {
 		return fmt.Errorf("voter address cannot be empty")
 	}
+	if v.Weight != nil && v.Weight.IsNegative() {
+		return fmt.Errorf("vote weight cannot be negative")
+	}
+
+	return validateVote(v.VoteType, v.Options)
+}
+
+// validateVote validates a vote cast either for a single vote type or split across weighted options
+func validateVote(voteType VoteType, options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return voteType.Validate()
+	}
+	if voteType != VOTE_TYPE_UNSPECIFIED {
+		return fmt.Errorf("weighted vote cannot have a vote type: %d", voteType)
+	}
+	return options.Validate()
+}
+
+// NewWeightedVoteOption instantiates a new instance of WeightedVoteOption
+func NewWeightedVoteOption(option VoteType, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{
+		Option: option,
+		Weight: weight,
+	}
+}
+
+// NewNonSplitVoteOption returns the options of a vote cast entirely for a vote type
+func NewNonSplitVoteOption(option VoteType) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// WeightedVoteOptions is the split of a vote across vote types
+type WeightedVoteOptions []WeightedVoteOption
+
+// Validate checks the options have distinct valid vote types and positive weights summing to one
+func (opts WeightedVoteOptions) Validate() error {
+	if len(opts) == 0 {
+		return fmt.Errorf("weighted vote must have options")
+	}
+	seen := make(map[VoteType]bool, len(opts))
+	total := sdk.ZeroDec()
+	for _, o := range opts {
+		if err := o.Option.Validate(); err != nil {
+			return err
+		}
+		if seen[o.Option] {
+			return fmt.Errorf("duplicate vote option: %s", o.Option)
+		}
+		seen[o.Option] = true
+		if o.Weight.IsNil() || !o.Weight.IsPositive() || o.Weight.GT(sdk.OneDec()) {
+			return fmt.Errorf("invalid vote option weight: %s", o.Weight)
+		}
+		total = total.Add(o.Weight)
+	}
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("vote option weights must sum to 1: %s", total)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (opts WeightedVoteOptions) String() string {
+	strs := make([]string, len(opts))
+	for i, o := range opts {
+		strs[i] = fmt.Sprintf("%s=%s", o.Option, o.Weight)
+	}
+	return strings.Join(strs, ",")
+}
+
+// NewProposalTally returns the tally of a tally query response
+func NewProposalTally(tally QueryTallyResponse) ProposalTally {
+	return ProposalTally{
+		YesVotes:      tally.YesVotes,
+		NoVotes:       tally.NoVotes,
+		AbstainVotes:  tally.AbstainVotes,
+		CurrentVotes:  tally.CurrentVotes,
+		PossibleVotes: tally.PossibleVotes,
+		VoteThreshold: tally.VoteThreshold,
+		Quorum:        tally.Quorum,
+	}
+}
 
-	return v.VoteType.Validate()
+// NewClosedProposal instantiates a new instance of ClosedProposal
+func NewClosedProposal(proposal Proposal, outcome ProposalOutcome, tally ProposalTally, votes []Vote, closeHeight int64, closeTime time.Time) ClosedProposal {
+	return ClosedProposal{
+		Proposal:    proposal,
+		Outcome:     outcome,
+		Tally:       tally,
+		Votes:       votes,
+		CloseHeight: closeHeight,
+		CloseTime:   closeTime,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (cp ClosedProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return cp.Proposal.UnpackInterfaces(unpacker)
+}
+
+// Validate validates ClosedProposal fields
+func (cp ClosedProposal) Validate() error {
+	if err := cp.Proposal.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, ok := toString[cp.Outcome]; !ok {
+		return fmt.Errorf("invalid proposal outcome: %d", cp.Outcome)
+	}
+	for _, v := range cp.Votes {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+		if v.ProposalID != cp.Proposal.ID {
+			return fmt.Errorf("vote refers to another proposal; vote: %+v", v)
+		}
+	}
+	return nil
 }
@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -73,6 +74,17 @@ type BaseCommittee struct {
 	// The length of time a proposal remains active for. Proposals will close earlier if they get enough votes.
 	ProposalDuration time.Duration `protobuf:"bytes,6,opt,name=proposal_duration,json=proposalDuration,proto3,stdduration" json:"proposal_duration"`
 	TallyOption      TallyOption   `protobuf:"varint,7,opt,name=tally_option,json=tallyOption,proto3,enum=zgc.committee.v1beta1.TallyOption" json:"tally_option,omitempty"`
+	// member_weights are the voting weights of the members, in the order of the members. Every member has a weight of
+	// one when empty.
+	MemberWeights []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,rep,name=member_weights,json=memberWeights,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"member_weights"`
+	// min_deposit is the deposit required to submit a proposal, proposals are free when empty. The deposit is burned
+	// when the proposal fails or is cancelled and refunded otherwise.
+	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit"`
+	// expedited_proposal_duration is the length of time an expedited proposal remains active for, shorter than the
+	// proposal duration. Expedited proposals are disabled when zero.
+	ExpeditedProposalDuration time.Duration `protobuf:"bytes,10,opt,name=expedited_proposal_duration,json=expeditedProposalDuration,proto3,stdduration" json:"expedited_proposal_duration"`
+	// expedited_vote_threshold is the vote threshold of expedited proposals, higher than the vote threshold.
+	ExpeditedVoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=expedited_vote_threshold,json=expeditedVoteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expedited_vote_threshold"`
 }
 
 func (m *BaseCommittee) Reset()      { *m = BaseCommittee{} }
@@ -183,11 +195,50 @@ func (m *TokenCommittee) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TokenCommittee proto.InternalMessageInfo
 
+// CouncilCommittee is a committee whose members are the members of the current council in x/council,
+// the members of the base committee are left empty and resolved when the committee is loaded.
+type CouncilCommittee struct {
+	*BaseCommittee `protobuf:"bytes,1,opt,name=base_committee,json=baseCommittee,proto3,embedded=base_committee" json:"base_committee,omitempty"`
+}
+
+func (m *CouncilCommittee) Reset()      { *m = CouncilCommittee{} }
+func (*CouncilCommittee) ProtoMessage() {}
+func (*CouncilCommittee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e3f5a94075c4544, []int{3}
+}
+func (m *CouncilCommittee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CouncilCommittee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CouncilCommittee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CouncilCommittee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CouncilCommittee.Merge(m, src)
+}
+func (m *CouncilCommittee) XXX_Size() int {
+	return m.Size()
+}
+func (m *CouncilCommittee) XXX_DiscardUnknown() {
+	xxx_messageInfo_CouncilCommittee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CouncilCommittee proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("zgc.committee.v1beta1.TallyOption", TallyOption_name, TallyOption_value)
 	proto.RegisterType((*BaseCommittee)(nil), "zgc.committee.v1beta1.BaseCommittee")
 	proto.RegisterType((*MemberCommittee)(nil), "zgc.committee.v1beta1.MemberCommittee")
 	proto.RegisterType((*TokenCommittee)(nil), "zgc.committee.v1beta1.TokenCommittee")
+	proto.RegisterType((*CouncilCommittee)(nil), "zgc.committee.v1beta1.CouncilCommittee")
 }
 
 func init() {
@@ -195,49 +246,57 @@ func init() {
 }
 
 var fileDescriptor_8e3f5a94075c4544 = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcb, 0x6e, 0xd3, 0x5a,
-	0x14, 0xb5, 0x93, 0xdc, 0xf4, 0xf6, 0xb8, 0xcd, 0x4d, 0xcf, 0x6d, 0xaf, 0x9c, 0xea, 0xca, 0xb6,
-	0xaa, 0x82, 0x22, 0x44, 0xec, 0x36, 0xcc, 0x98, 0xc5, 0x75, 0xa2, 0x06, 0x95, 0x26, 0x38, 0xee,
-	0x00, 0x26, 0x96, 0x1f, 0x07, 0xc7, 0x6a, 0xec, 0x13, 0x7c, 0x9c, 0x42, 0xfa, 0x05, 0x0c, 0x19,
-	0x76, 0x88, 0xc4, 0x2f, 0xf4, 0x23, 0xaa, 0x8e, 0x2a, 0x46, 0x88, 0x41, 0x0a, 0xe9, 0x4f, 0x20,
-	0x46, 0xc8, 0xaf, 0x26, 0x85, 0x22, 0xc1, 0x80, 0x51, 0x72, 0xd6, 0x5e, 0xfb, 0xb1, 0xf6, 0x5e,
-	0x32, 0xb8, 0x73, 0xec, 0x58, 0x92, 0x85, 0x3d, 0xcf, 0x0d, 0x43, 0x84, 0xa4, 0xa3, 0x6d, 0x13,
-	0x85, 0xc6, 0xf6, 0x0c, 0x11, 0x87, 0x01, 0x0e, 0x31, 0x5c, 0x3b, 0x76, 0x2c, 0x71, 0x06, 0xa6,
-	0xb4, 0xf5, 0x8a, 0x85, 0x89, 0x87, 0x89, 0x1e, 0x93, 0xa4, 0xe4, 0x91, 0x64, 0xac, 0xaf, 0x3a,
-	0xd8, 0xc1, 0x09, 0x1e, 0xfd, 0x4b, 0xd1, 0x8a, 0x83, 0xb1, 0x33, 0x40, 0x52, 0xfc, 0x32, 0x47,
-	0xcf, 0x25, 0xc3, 0x1f, 0xa7, 0x21, 0xee, 0xfb, 0x90, 0x3d, 0x0a, 0x8c, 0xd0, 0xc5, 0x7e, 0x12,
-	0xdf, 0xf8, 0x92, 0x07, 0xcb, 0xb2, 0x41, 0xd0, 0x4e, 0x36, 0x05, 0xfc, 0x0f, 0xe4, 0x5c, 0x9b,
-	0xa5, 0x05, 0xba, 0x5a, 0x90, 0x8b, 0xd3, 0x09, 0x9f, 0x6b, 0x2b, 0x6a, 0xce, 0xb5, 0xa1, 0x00,
-	0x18, 0x1b, 0x11, 0x2b, 0x70, 0x87, 0x51, 0x3a, 0x9b, 0x13, 0xe8, 0xea, 0xa2, 0x3a, 0x0f, 0x41,
-	0x13, 0x2c, 0x78, 0xc8, 0x33, 0x51, 0x40, 0xd8, 0xbc, 0x90, 0xaf, 0x2e, 0xc9, 0xbb, 0x5f, 0x27,
-	0x7c, 0xcd, 0x71, 0xc3, 0xfe, 0xc8, 0x8c, 0x64, 0xa6, 0x52, 0xd2, 0x9f, 0x1a, 0xb1, 0x0f, 0xa5,
-	0x70, 0x3c, 0x44, 0x44, 0x6c, 0x58, 0x56, 0xc3, 0xb6, 0x03, 0x44, 0xc8, 0xfb, 0xd3, 0xda, 0xbf,
-	0xa9, 0xe0, 0x14, 0x91, 0xc7, 0x21, 0x22, 0x6a, 0x56, 0x18, 0xb6, 0x00, 0x33, 0x44, 0x81, 0xe7,
-	0x12, 0xe2, 0x62, 0x9f, 0xb0, 0x05, 0x21, 0x5f, 0x65, 0xea, 0xab, 0x62, 0xa2, 0x52, 0xcc, 0x54,
-	0x8a, 0x0d, 0x7f, 0x2c, 0x97, 0xce, 0x4f, 0x6b, 0xa0, 0x7b, 0x4d, 0x56, 0xe7, 0x13, 0xe1, 0x01,
-	0x28, 0x1d, 0xe1, 0x10, 0xe9, 0x61, 0x3f, 0x40, 0xa4, 0x8f, 0x07, 0x36, 0xfb, 0x57, 0x24, 0x48,
-	0x16, 0xcf, 0x26, 0x3c, 0xf5, 0x71, 0xc2, 0xdf, 0xfd, 0x85, 0xb1, 0x15, 0x64, 0xa9, 0xcb, 0x51,
-	0x15, 0x2d, 0x2b, 0x02, 0xbb, 0x60, 0x65, 0x18, 0xe0, 0x21, 0x26, 0xc6, 0x40, 0xcf, 0x36, 0xcd,
-	0x16, 0x05, 0xba, 0xca, 0xd4, 0x2b, 0x3f, 0x0c, 0xa9, 0xa4, 0x04, 0xf9, 0xef, 0xa8, 0xe9, 0xc9,
-	0x25, 0x4f, 0xab, 0xe5, 0x2c, 0x3b, 0x8b, 0xc1, 0x26, 0x58, 0x0a, 0x8d, 0xc1, 0x60, 0xac, 0xe3,
-	0x64, 0xef, 0x0b, 0x02, 0x5d, 0x2d, 0xd5, 0x37, 0xc4, 0x5b, 0xad, 0x23, 0x6a, 0x11, 0xb5, 0x13,
-	0x33, 0x55, 0x26, 0x9c, 0x3d, 0x1e, 0xae, 0x9c, 0xbc, 0xe5, 0xa9, 0xf3, 0xd3, 0xda, 0xe2, 0xf5,
-	0xa1, 0x37, 0x5e, 0x82, 0x7f, 0x1e, 0xc7, 0x5b, 0x9d, 0xdd, 0xfe, 0x09, 0x28, 0x99, 0x06, 0x41,
-	0xfa, 0x75, 0xe1, 0xd8, 0x07, 0x4c, 0x7d, 0xf3, 0x27, 0xed, 0x6e, 0x38, 0x47, 0x2e, 0x5c, 0x4c,
-	0x78, 0x5a, 0x5d, 0x36, 0xe7, 0xc1, 0xdb, 0x1a, 0x5f, 0xd2, 0xa0, 0xa4, 0xe1, 0x43, 0xe4, 0xff,
-	0xc9, 0xc6, 0xb0, 0x05, 0x8a, 0x2f, 0x46, 0x38, 0x18, 0x79, 0x89, 0x55, 0x7f, 0xfb, 0xb2, 0x69,
-	0x36, 0xe4, 0x41, 0xb2, 0x48, 0xdd, 0x46, 0x3e, 0xf6, 0xd8, 0x7c, 0xec, 0x7b, 0x10, 0x43, 0x4a,
-	0x84, 0xdc, 0xa2, 0xf0, 0x5e, 0x00, 0x98, 0xb9, 0x4b, 0xc0, 0xff, 0x01, 0xab, 0x35, 0xf6, 0xf6,
-	0x9e, 0xea, 0x9d, 0xae, 0xd6, 0xee, 0xec, 0xeb, 0x07, 0xfb, 0xbd, 0x6e, 0x73, 0xa7, 0xdd, 0x6a,
-	0x37, 0x95, 0x32, 0x05, 0x37, 0x81, 0x70, 0x23, 0xda, 0x6a, 0xab, 0x3d, 0x4d, 0xef, 0x36, 0x7a,
-	0x9a, 0xae, 0xed, 0x36, 0xf5, 0x6e, 0xa7, 0xa7, 0x95, 0x69, 0x58, 0x01, 0x6b, 0x37, 0x58, 0x4a,
-	0xb3, 0xa1, 0xec, 0xb5, 0xf7, 0x9b, 0xe5, 0xdc, 0x7a, 0xe1, 0xf5, 0x3b, 0x8e, 0x92, 0x1f, 0x9d,
-	0x7d, 0xe6, 0xa8, 0xb3, 0x29, 0x47, 0x5f, 0x4c, 0x39, 0xfa, 0xd3, 0x94, 0xa3, 0xdf, 0x5c, 0x71,
-	0xd4, 0xc5, 0x15, 0x47, 0x7d, 0xb8, 0xe2, 0xa8, 0x67, 0xf7, 0xe7, 0x54, 0x6f, 0x39, 0x03, 0xc3,
-	0x24, 0xd2, 0x96, 0x53, 0xb3, 0xfa, 0x86, 0xeb, 0x4b, 0xaf, 0xe6, 0x3e, 0x55, 0xb1, 0x7e, 0xb3,
-	0x18, 0x7b, 0xf4, 0xc1, 0xb7, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xa2, 0xc0, 0xfd, 0xc8, 0x04,
-	0x00, 0x00,
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4d, 0x6f, 0xe3, 0x54,
+	0x14, 0xb5, 0x93, 0x90, 0x4e, 0x9f, 0xa7, 0x21, 0x63, 0x66, 0x46, 0x4e, 0x41, 0xb6, 0x55, 0x0d,
+	0x28, 0x42, 0xc4, 0xee, 0x84, 0x1d, 0xbb, 0x38, 0x4e, 0x34, 0x41, 0xa5, 0x09, 0x8e, 0x07, 0x04,
+	0x1b, 0xcb, 0x1f, 0x0f, 0xe7, 0x69, 0x6c, 0x3f, 0xe3, 0xe7, 0xcc, 0x34, 0xf3, 0x0b, 0x58, 0xb0,
+	0x60, 0xd9, 0x25, 0x12, 0x3b, 0xd6, 0xfd, 0x11, 0x55, 0x57, 0x15, 0x2b, 0xc4, 0x22, 0x85, 0xf4,
+	0x5f, 0xb0, 0x42, 0xfe, 0x4a, 0xdc, 0x0f, 0xa4, 0xaa, 0x12, 0xac, 0x92, 0x77, 0xef, 0x3d, 0xe7,
+	0xbe, 0x73, 0xde, 0xbd, 0x09, 0xf8, 0xf0, 0xad, 0x6b, 0xcb, 0x36, 0xf6, 0x7d, 0x14, 0xc7, 0x10,
+	0xca, 0xaf, 0x9f, 0x5b, 0x30, 0x36, 0x9f, 0x6f, 0x22, 0x52, 0x18, 0xe1, 0x18, 0xb3, 0x4f, 0xde,
+	0xba, 0xb6, 0xb4, 0x09, 0xe6, 0x65, 0xbb, 0xbc, 0x8d, 0x89, 0x8f, 0x89, 0x6c, 0x99, 0xa4, 0x8c,
+	0x45, 0x41, 0x06, 0xdb, 0x6d, 0x65, 0x79, 0x23, 0x3d, 0xc9, 0xd9, 0x21, 0x4f, 0x3d, 0x76, 0xb1,
+	0x8b, 0xb3, 0x78, 0xf2, 0xad, 0x00, 0xb8, 0x18, 0xbb, 0x1e, 0x94, 0xd3, 0x93, 0x35, 0xff, 0x4e,
+	0x36, 0x83, 0x45, 0x9e, 0xe2, 0xaf, 0xa7, 0x9c, 0x79, 0x64, 0xc6, 0x08, 0xe7, 0xbd, 0xf6, 0x7e,
+	0xdc, 0x02, 0x3b, 0x8a, 0x49, 0x60, 0xbf, 0xb8, 0x25, 0xfb, 0x14, 0x54, 0x90, 0xc3, 0xd1, 0x22,
+	0xdd, 0xae, 0x29, 0xf5, 0xd5, 0x52, 0xa8, 0x8c, 0x54, 0xad, 0x82, 0x1c, 0x56, 0x04, 0x8c, 0x03,
+	0x89, 0x1d, 0xa1, 0x30, 0x81, 0x73, 0x15, 0x91, 0x6e, 0x6f, 0x6b, 0xe5, 0x10, 0x6b, 0x81, 0x2d,
+	0x1f, 0xfa, 0x16, 0x8c, 0x08, 0x57, 0x15, 0xab, 0xed, 0x87, 0xca, 0x8b, 0xbf, 0x97, 0x42, 0xc7,
+	0x45, 0xf1, 0x6c, 0x6e, 0x25, 0x36, 0xe4, 0x52, 0xf2, 0x8f, 0x0e, 0x71, 0x5e, 0xc9, 0xf1, 0x22,
+	0x84, 0x44, 0xea, 0xd9, 0x76, 0xcf, 0x71, 0x22, 0x48, 0xc8, 0x6f, 0x27, 0x9d, 0xf7, 0x72, 0xc1,
+	0x79, 0x44, 0x59, 0xc4, 0x90, 0x68, 0x05, 0x31, 0x3b, 0x04, 0x4c, 0x08, 0x23, 0x1f, 0x11, 0x82,
+	0x70, 0x40, 0xb8, 0x9a, 0x58, 0x6d, 0x33, 0xdd, 0xc7, 0x52, 0xa6, 0x52, 0x2a, 0x54, 0x4a, 0xbd,
+	0x60, 0xa1, 0x34, 0xce, 0x4e, 0x3a, 0x60, 0xb2, 0x2e, 0xd6, 0xca, 0x40, 0xf6, 0x25, 0x68, 0xbc,
+	0xc6, 0x31, 0x34, 0xe2, 0x59, 0x04, 0xc9, 0x0c, 0x7b, 0x0e, 0xf7, 0x4e, 0x22, 0x48, 0x91, 0x4e,
+	0x97, 0x02, 0xf5, 0xc7, 0x52, 0xf8, 0xe8, 0x0e, 0xd7, 0x56, 0xa1, 0xad, 0xed, 0x24, 0x2c, 0x7a,
+	0x41, 0xc2, 0x4e, 0xc0, 0xa3, 0x30, 0xc2, 0x21, 0x26, 0xa6, 0x67, 0x14, 0x4e, 0x73, 0x75, 0x91,
+	0x6e, 0x33, 0xdd, 0xd6, 0x8d, 0x4b, 0xaa, 0x79, 0x81, 0xf2, 0x20, 0x69, 0x7a, 0x7c, 0x21, 0xd0,
+	0x5a, 0xb3, 0x40, 0x17, 0x39, 0x76, 0x00, 0x1e, 0xc6, 0xa6, 0xe7, 0x2d, 0x0c, 0x9c, 0xf9, 0xbe,
+	0x25, 0xd2, 0xed, 0x46, 0x77, 0x4f, 0xba, 0x75, 0xb4, 0x24, 0x3d, 0x29, 0x1d, 0xa7, 0x95, 0x1a,
+	0x13, 0x6f, 0x0e, 0x89, 0xde, 0xcc, 0x42, 0xe3, 0x0d, 0x44, 0xee, 0x2c, 0x26, 0xdc, 0x03, 0xb1,
+	0x7a, 0x1f, 0xbd, 0x19, 0xcb, 0xd7, 0x19, 0x09, 0xeb, 0x01, 0xc6, 0x47, 0x81, 0xe1, 0xc0, 0x10,
+	0x13, 0x14, 0x73, 0xdb, 0xe9, 0x73, 0xb4, 0xa4, 0xfc, 0x09, 0x93, 0x01, 0x5f, 0x5f, 0xad, 0x8f,
+	0x51, 0xa0, 0xec, 0x27, 0xed, 0x7e, 0xbd, 0x10, 0xda, 0x77, 0x68, 0x97, 0x00, 0x88, 0x06, 0x7c,
+	0x14, 0xa8, 0x19, 0x3d, 0x6b, 0x83, 0xf7, 0xe1, 0x51, 0x08, 0x1d, 0x14, 0x43, 0xc7, 0xb8, 0xe9,
+	0x33, 0xb8, 0xbb, 0xcf, 0xad, 0x35, 0xcf, 0xe4, 0xba, 0xe1, 0x33, 0xc0, 0x6d, 0x9a, 0x5c, 0x9b,
+	0x11, 0xe6, 0x5e, 0x33, 0xf2, 0x74, 0xcd, 0xf7, 0x55, 0x79, 0x58, 0x3e, 0x7b, 0x74, 0xfc, 0xb3,
+	0x40, 0x9d, 0x9d, 0x74, 0xb6, 0xd7, 0xcb, 0xb7, 0xf7, 0x06, 0xbc, 0xfb, 0x45, 0x6a, 0xf0, 0x66,
+	0x1f, 0xbf, 0x04, 0x8d, 0xc4, 0x47, 0x63, 0xfd, 0xd8, 0xe9, 0x6e, 0x32, 0xdd, 0x67, 0xff, 0x32,
+	0x02, 0x57, 0xb6, 0x59, 0xa9, 0x9d, 0x2f, 0x05, 0x5a, 0xdb, 0xb1, 0xca, 0xc1, 0xdb, 0x1a, 0x5f,
+	0xd0, 0xa0, 0xa1, 0xe3, 0x57, 0x30, 0xf8, 0x2f, 0x1b, 0xb3, 0x43, 0x50, 0xff, 0x7e, 0x8e, 0xa3,
+	0xb9, 0xcf, 0x55, 0xee, 0xe5, 0x64, 0x8e, 0x66, 0x05, 0x90, 0x0d, 0xb7, 0xe1, 0xc0, 0x00, 0xfb,
+	0x5c, 0x35, 0xfd, 0x2d, 0x02, 0x69, 0x48, 0x4d, 0x22, 0xb7, 0x29, 0x3c, 0x02, 0xcd, 0x3e, 0x9e,
+	0x07, 0x36, 0xf2, 0xfe, 0x67, 0x6f, 0x3f, 0x8e, 0x00, 0x53, 0xda, 0x4b, 0xf6, 0x03, 0xc0, 0xe9,
+	0xbd, 0x83, 0x83, 0x6f, 0x8c, 0xf1, 0x44, 0x1f, 0x8d, 0x0f, 0x8d, 0x97, 0x87, 0xd3, 0xc9, 0xa0,
+	0x3f, 0x1a, 0x8e, 0x06, 0x6a, 0x93, 0x62, 0x9f, 0x01, 0xf1, 0x4a, 0x76, 0x38, 0xd2, 0xa6, 0xba,
+	0x31, 0xe9, 0x4d, 0x75, 0x43, 0x7f, 0x31, 0x30, 0x26, 0xe3, 0xa9, 0xde, 0xa4, 0xd9, 0x16, 0x78,
+	0x72, 0xa5, 0x4a, 0x1d, 0xf4, 0xd4, 0x83, 0xd1, 0xe1, 0xa0, 0x59, 0xd9, 0xad, 0xfd, 0xf0, 0x0b,
+	0x4f, 0x29, 0x9f, 0x9f, 0xfe, 0xc5, 0x53, 0xa7, 0x2b, 0x9e, 0x3e, 0x5f, 0xf1, 0xf4, 0x9f, 0x2b,
+	0x9e, 0xfe, 0xe9, 0x92, 0xa7, 0xce, 0x2f, 0x79, 0xea, 0xf7, 0x4b, 0x9e, 0xfa, 0xf6, 0x93, 0x92,
+	0xdf, 0xfb, 0xae, 0x67, 0x5a, 0x44, 0xde, 0x77, 0x3b, 0xf6, 0xcc, 0x44, 0x81, 0x7c, 0x54, 0xfa,
+	0x63, 0x4b, 0x9d, 0xb7, 0xea, 0xe9, 0x26, 0x7d, 0xfa, 0xcf, 0x00, 0x10, 0x30, 0x68, 0x11, 0xf6,
+	0x06, 0x00, 0x00,
 }
 
 func (m *BaseCommittee) Marshal() (dAtA []byte, err error) {
@@ -260,18 +319,64 @@ func (m *BaseCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.TallyOption != 0 {
-		i = encodeVarintCommittee(dAtA, i, uint64(m.TallyOption))
-		i--
-		dAtA[i] = 0x38
+	{
+		size := m.ExpeditedVoteThreshold.Size()
+		i -= size
+		if _, err := m.ExpeditedVoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCommittee(dAtA, i, uint64(size))
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ProposalDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposalDuration):])
+	i--
+	dAtA[i] = 0x5a
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpeditedProposalDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpeditedProposalDuration):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintCommittee(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x52
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommittee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MemberWeights) > 0 {
+		for iNdEx := len(m.MemberWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MemberWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.MemberWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintCommittee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.TallyOption != 0 {
+		i = encodeVarintCommittee(dAtA, i, uint64(m.TallyOption))
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ProposalDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposalDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCommittee(dAtA, i, uint64(n2))
+	i--
 	dAtA[i] = 0x32
 	{
 		size := m.VoteThreshold.Size()
@@ -408,6 +513,41 @@ func (m *TokenCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CouncilCommittee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CouncilCommittee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CouncilCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseCommittee != nil {
+		{
+			size, err := m.BaseCommittee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommittee(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCommittee(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommittee(v)
 	base := offset
@@ -451,6 +591,22 @@ func (m *BaseCommittee) Size() (n int) {
 	if m.TallyOption != 0 {
 		n += 1 + sovCommittee(uint64(m.TallyOption))
 	}
+	if len(m.MemberWeights) > 0 {
+		for _, e := range m.MemberWeights {
+			l = e.Size()
+			n += 1 + l + sovCommittee(uint64(l))
+		}
+	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovCommittee(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpeditedProposalDuration)
+	n += 1 + l + sovCommittee(uint64(l))
+	l = m.ExpeditedVoteThreshold.Size()
+	n += 1 + l + sovCommittee(uint64(l))
 	return n
 }
 
@@ -486,6 +642,19 @@ func (m *TokenCommittee) Size() (n int) {
 	return n
 }
 
+func (m *CouncilCommittee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseCommittee != nil {
+		l = m.BaseCommittee.Size()
+		n += 1 + l + sovCommittee(uint64(l))
+	}
+	return n
+}
+
 func sovCommittee(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -724,6 +893,143 @@ func (m *BaseCommittee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberWeights", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MemberWeights = append(m.MemberWeights, v)
+			if err := m.MemberWeights[len(m.MemberWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedProposalDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpeditedProposalDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpeditedVoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommittee(dAtA[iNdEx:])
@@ -983,6 +1289,92 @@ func (m *TokenCommittee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CouncilCommittee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommittee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CouncilCommittee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CouncilCommittee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseCommittee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseCommittee == nil {
+				m.BaseCommittee = &BaseCommittee{}
+			}
+			if err := m.BaseCommittee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommittee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCommittee(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -230,6 +230,57 @@ func TestMemberCommittee(t *testing.T) {
 			},
 			expectPass: true,
 		},
+		{
+			name: "weighted members",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This member committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				committee.MemberWeights = []sdk.Dec{testutil.D("1"), testutil.D("2.5"), testutil.D("0.5")}
+				return committee, err
+			},
+			expectPass: true,
+		},
+		{
+			name: "missing member weight",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This member committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				committee.MemberWeights = []sdk.Dec{testutil.D("1"), testutil.D("2.5")}
+				return committee, err
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero member weight",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This member committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				committee.MemberWeights = []sdk.Dec{testutil.D("1"), testutil.D("0"), testutil.D("0.5")}
+				return committee, err
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -248,6 +299,180 @@ func TestMemberCommittee(t *testing.T) {
 	}
 }
 
+func TestBaseCommittee_MemberWeights(t *testing.T) {
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("0gChainTest1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("0gChainTest2"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("0gChainTest3"))),
+	}
+	committee := types.MustNewMemberCommittee(
+		1,
+		"This member committee is for testing.",
+		addresses[:2],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.667"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+
+	// every member has a weight of one without weights
+	require.Equal(t, testutil.D("1"), committee.GetMemberWeight(addresses[1]))
+	require.Equal(t, testutil.D("0"), committee.GetMemberWeight(addresses[2]))
+	require.Equal(t, testutil.D("2"), committee.GetTotalWeight())
+
+	committee.MemberWeights = []sdk.Dec{testutil.D("3"), testutil.D("0.5")}
+	require.Equal(t, testutil.D("3"), committee.GetMemberWeight(addresses[0]))
+	require.Equal(t, testutil.D("0.5"), committee.GetMemberWeight(addresses[1]))
+	require.Equal(t, testutil.D("0"), committee.GetMemberWeight(addresses[2]))
+	require.Equal(t, testutil.D("3.5"), committee.GetTotalWeight())
+}
+
+func TestBaseCommittee_DepositAndExpedited(t *testing.T) {
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("0gChainTest1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("0gChainTest2"))),
+	}
+
+	testCases := []struct {
+		name       string
+		update     func(*types.MemberCommittee)
+		expectPass bool
+	}{
+		{
+			name:       "expedited disabled",
+			update:     func(c *types.MemberCommittee) {},
+			expectPass: true,
+		},
+		{
+			name: "min deposit and expedited",
+			update: func(c *types.MemberCommittee) {
+				c.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin("a0gi", 10))
+				c.ExpeditedProposalDuration = time.Hour * 24
+				c.ExpeditedVoteThreshold = testutil.D("0.8")
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid min deposit",
+			update: func(c *types.MemberCommittee) {
+				c.MinDeposit = sdk.Coins{sdk.Coin{Denom: "a0gi", Amount: sdk.NewInt(-1)}}
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative expedited duration",
+			update: func(c *types.MemberCommittee) {
+				c.ExpeditedProposalDuration = -time.Hour
+			},
+			expectPass: false,
+		},
+		{
+			name: "expedited duration not shorter",
+			update: func(c *types.MemberCommittee) {
+				c.ExpeditedProposalDuration = time.Hour * 24 * 7
+				c.ExpeditedVoteThreshold = testutil.D("0.8")
+			},
+			expectPass: false,
+		},
+		{
+			name: "expedited threshold not higher",
+			update: func(c *types.MemberCommittee) {
+				c.ExpeditedProposalDuration = time.Hour * 24
+				c.ExpeditedVoteThreshold = testutil.D("0.667")
+			},
+			expectPass: false,
+		},
+		{
+			name: "expedited threshold above one",
+			update: func(c *types.MemberCommittee) {
+				c.ExpeditedProposalDuration = time.Hour * 24
+				c.ExpeditedVoteThreshold = testutil.D("1.1")
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			committee := types.MustNewMemberCommittee(
+				1,
+				"This member committee is for testing.",
+				addresses,
+				[]types.Permission{&types.GodPermission{}},
+				testutil.D("0.667"),
+				time.Hour*24*7,
+				types.TALLY_OPTION_FIRST_PAST_THE_POST,
+			)
+			tc.update(committee)
+			err := committee.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestCouncilCommittee tests unique CouncilCommittee functionality
+func TestCouncilCommittee(t *testing.T) {
+	testCases := []struct {
+		name       string
+		modify     func(committee *types.CouncilCommittee)
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			modify:     func(committee *types.CouncilCommittee) {},
+			expectPass: true,
+		},
+		{
+			name: "stored members",
+			modify: func(committee *types.CouncilCommittee) {
+				committee.Members = []sdk.AccAddress{sdk.AccAddress(crypto.AddressHash([]byte("0gChainTest1")))}
+			},
+			expectPass: false,
+		},
+		{
+			name: "member weights",
+			modify: func(committee *types.CouncilCommittee) {
+				committee.MemberWeights = []sdk.Dec{testutil.D("1")}
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid threshold",
+			modify: func(committee *types.CouncilCommittee) {
+				committee.VoteThreshold = testutil.D("1.5")
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			committee, err := types.NewCouncilCommittee(
+				1,
+				"This council committee is for testing.",
+				[]types.Permission{&types.GodPermission{}},
+				testutil.D("0.667"),
+				time.Hour*24*7,
+				types.TALLY_OPTION_FIRST_PAST_THE_POST,
+			)
+			require.NoError(t, err)
+			require.Equal(t, types.CouncilCommitteeType, committee.GetType())
+
+			tc.modify(committee)
+			err = committee.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 // TestTokenCommittee tests unique TokenCommittee functionality
 func TestTokenCommittee(t *testing.T) {
 	addresses := []sdk.AccAddress{
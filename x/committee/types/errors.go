@@ -14,4 +14,6 @@ var (
 	ErrUnknownSubspace         = errorsmod.Register(ModuleName, 10, "subspace not found")
 	ErrInvalidVoteType         = errorsmod.Register(ModuleName, 11, "invalid vote type")
 	ErrNotFoundProposalTally   = errorsmod.Register(ModuleName, 12, "proposal tally not found")
+	ErrInvalidDeposit          = errorsmod.Register(ModuleName, 13, "invalid proposal deposit")
+	ErrExpeditedNotAllowed     = errorsmod.Register(ModuleName, 14, "committee does not allow expedited proposals")
 )
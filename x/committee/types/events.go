@@ -15,4 +15,7 @@ const (
 	AttributeKeyVote                = "vote"
 	AttributeKeyProposalOutcome     = "proposal_outcome"
 	AttributeKeyProposalTally       = "proposal_tally"
+	AttributeKeyProposer            = "proposer"
+	AttributeKeyDeposit             = "deposit"
+	AttributeKeyExpedited           = "expedited"
 )
@@ -21,6 +21,7 @@ func NewGenesisState(nextProposalID uint64, committees []Committee, proposals Pr
 		Committees:     packedCommittees,
 		Proposals:      proposals,
 		Votes:          votes,
+		Params:         DefaultParams(),
 	}
 }
 
@@ -56,6 +57,11 @@ func (data GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, cp := range data.ClosedProposals {
+		if err := cp.Proposal.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -117,6 +123,26 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("vote refers to non existent proposal; vote: %+v", v)
 		}
 	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// validate closed proposals
+	for _, cp := range gs.ClosedProposals {
+		// check the ID is unique across the active and closed proposals
+		if _, ok := proposalMap[cp.Proposal.ID]; ok {
+			return fmt.Errorf("duplicate proposal ID found in genesis state; id: %d", cp.Proposal.ID)
+		}
+		proposalMap[cp.Proposal.ID] = true
+
+		if err := cp.Validate(); err != nil {
+			return fmt.Errorf("closed proposal %d invalid: %w", cp.Proposal.ID, err)
+		}
+		if cp.Proposal.ID >= gs.NextProposalID {
+			return fmt.Errorf("NextProposalID is not greater than all proposal IDs; id: %d", cp.Proposal.ID)
+		}
+	}
 	return nil
 }
 
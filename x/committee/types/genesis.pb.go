@@ -4,13 +4,16 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -68,10 +71,12 @@ func (VoteType) EnumDescriptor() ([]byte, []int) {
 
 // GenesisState defines the committee module's genesis state.
 type GenesisState struct {
-	NextProposalID uint64       `protobuf:"varint,1,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
-	Committees     []*types.Any `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
-	Proposals      Proposals    `protobuf:"bytes,3,rep,name=proposals,proto3,castrepeated=Proposals" json:"proposals"`
-	Votes          []Vote       `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes"`
+	NextProposalID  uint64           `protobuf:"varint,1,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
+	Committees      []*types.Any     `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
+	Proposals       Proposals        `protobuf:"bytes,3,rep,name=proposals,proto3,castrepeated=Proposals" json:"proposals"`
+	Votes           []Vote           `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes"`
+	Params          Params           `protobuf:"bytes,5,opt,name=params,proto3" json:"params"`
+	ClosedProposals []ClosedProposal `protobuf:"bytes,6,rep,name=closed_proposals,json=closedProposals,proto3" json:"closed_proposals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -107,18 +112,69 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+// Params defines the parameters of the committee module.
+type Params struct {
+	// closed_proposal_retention is how long a closed proposal is kept in the archive, zero keeps closed proposals forever.
+	ClosedProposalRetention time.Duration `protobuf:"bytes,1,opt,name=closed_proposal_retention,json=closedProposalRetention,proto3,stdduration" json:"closed_proposal_retention"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc916f377aadb716, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetClosedProposalRetention() time.Duration {
+	if m != nil {
+		return m.ClosedProposalRetention
+	}
+	return 0
+}
+
 // Proposal is an internal record of a governance proposal submitted to a committee.
 type Proposal struct {
-	Content     *types.Any `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	ID          uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CommitteeID uint64     `protobuf:"varint,3,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
-	Deadline    time.Time  `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	Content     *types.Any                                    `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ID          uint64                                        `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CommitteeID uint64                                        `protobuf:"varint,3,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+	Deadline    time.Time                                     `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	Proposer    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	// deposit is held by the module account until the proposal closes.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// expedited proposals use the expedited proposal duration and vote threshold of the committee.
+	Expedited bool `protobuf:"varint,7,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc916f377aadb716, []int{1}
+	return fileDescriptor_dc916f377aadb716, []int{2}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,13 +208,18 @@ type Vote struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
 	VoteType   VoteType                                      `protobuf:"varint,3,opt,name=vote_type,json=voteType,proto3,enum=zgc.committee.v1beta1.VoteType" json:"vote_type,omitempty"`
+	// weight is the voting power of a token committee voter captured when the vote is cast. The tally counts the
+	// lesser of the weight and the current voting power, so tokens moved after voting are not counted twice.
+	Weight *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=weight,proto3,customtype=cosmossdk.io/math.Int" json:"weight,omitempty"`
+	// options split the vote across vote types, the vote_type is unspecified when set.
+	Options WeightedVoteOptions `protobuf:"bytes,5,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc916f377aadb716, []int{2}
+	return fileDescriptor_dc916f377aadb716, []int{3}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,11 +248,149 @@ func (m *Vote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Vote proto.InternalMessageInfo
 
+// WeightedVoteOption is the fraction of a vote cast for a vote type.
+type WeightedVoteOption struct {
+	Option VoteType                               `protobuf:"varint,1,opt,name=option,proto3,enum=zgc.committee.v1beta1.VoteType" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()         { *m = WeightedVoteOption{} }
+func (m *WeightedVoteOption) String() string { return proto.CompactTextString(m) }
+func (*WeightedVoteOption) ProtoMessage()    {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc916f377aadb716, []int{4}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
+func (m *WeightedVoteOption) GetOption() VoteType {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_TYPE_UNSPECIFIED
+}
+
+// ClosedProposal is an archived record of a closed proposal with its outcome, final tally and votes.
+type ClosedProposal struct {
+	Proposal Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+	// outcome is the ProposalOutcome of the proposal: 0 passed, 1 failed, 2 invalid, 3 cancelled.
+	Outcome     ProposalOutcome `protobuf:"varint,2,opt,name=outcome,proto3,casttype=ProposalOutcome" json:"outcome,omitempty"`
+	Tally       ProposalTally   `protobuf:"bytes,3,opt,name=tally,proto3" json:"tally"`
+	Votes       []Vote          `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes"`
+	CloseHeight int64           `protobuf:"varint,5,opt,name=close_height,json=closeHeight,proto3" json:"close_height,omitempty"`
+	CloseTime   time.Time       `protobuf:"bytes,6,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time"`
+}
+
+func (m *ClosedProposal) Reset()         { *m = ClosedProposal{} }
+func (m *ClosedProposal) String() string { return proto.CompactTextString(m) }
+func (*ClosedProposal) ProtoMessage()    {}
+func (*ClosedProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc916f377aadb716, []int{5}
+}
+func (m *ClosedProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClosedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClosedProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClosedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClosedProposal.Merge(m, src)
+}
+func (m *ClosedProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClosedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClosedProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClosedProposal proto.InternalMessageInfo
+
+// ProposalTally is the tally of the votes on a proposal.
+type ProposalTally struct {
+	YesVotes      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=yes_votes,json=yesVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"yes_votes"`
+	NoVotes       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=no_votes,json=noVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"no_votes"`
+	CurrentVotes  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=current_votes,json=currentVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_votes"`
+	PossibleVotes github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=possible_votes,json=possibleVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"possible_votes"`
+	VoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold"`
+	Quorum        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum"`
+	AbstainVotes  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=abstain_votes,json=abstainVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"abstain_votes"`
+}
+
+func (m *ProposalTally) Reset()         { *m = ProposalTally{} }
+func (m *ProposalTally) String() string { return proto.CompactTextString(m) }
+func (*ProposalTally) ProtoMessage()    {}
+func (*ProposalTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc916f377aadb716, []int{6}
+}
+func (m *ProposalTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTally.Merge(m, src)
+}
+func (m *ProposalTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalTally proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("zgc.committee.v1beta1.VoteType", VoteType_name, VoteType_value)
 	proto.RegisterType((*GenesisState)(nil), "zgc.committee.v1beta1.GenesisState")
+	proto.RegisterType((*Params)(nil), "zgc.committee.v1beta1.Params")
 	proto.RegisterType((*Proposal)(nil), "zgc.committee.v1beta1.Proposal")
 	proto.RegisterType((*Vote)(nil), "zgc.committee.v1beta1.Vote")
+	proto.RegisterType((*WeightedVoteOption)(nil), "zgc.committee.v1beta1.WeightedVoteOption")
+	proto.RegisterType((*ClosedProposal)(nil), "zgc.committee.v1beta1.ClosedProposal")
+	proto.RegisterType((*ProposalTally)(nil), "zgc.committee.v1beta1.ProposalTally")
 }
 
 func init() {
@@ -199,48 +398,81 @@ func init() {
 }
 
 var fileDescriptor_dc916f377aadb716 = []byte{
-	// 655 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
-	0x10, 0xb5, 0x1d, 0xff, 0xf8, 0x25, 0x9b, 0x90, 0x86, 0x2d, 0x54, 0x21, 0x95, 0x6c, 0x44, 0x2f,
-	0xa8, 0x6a, 0x6c, 0xa0, 0x87, 0x4a, 0x88, 0x43, 0xe3, 0x24, 0xb4, 0xbe, 0x84, 0xc8, 0x49, 0x91,
-	0xe8, 0xa1, 0x91, 0x63, 0x6f, 0x8d, 0xd5, 0xc4, 0x1b, 0x65, 0x97, 0x88, 0xf0, 0x09, 0x38, 0x72,
-	0xec, 0xb1, 0x6a, 0x6f, 0x3d, 0xf3, 0x21, 0x10, 0x27, 0xd4, 0x53, 0xa5, 0x4a, 0xa6, 0x32, 0xdf,
-	0xa0, 0xc7, 0x9e, 0x2a, 0xaf, 0xff, 0x24, 0x2a, 0xe5, 0xe4, 0xdd, 0x99, 0x37, 0x6f, 0xe7, 0xbd,
-	0x19, 0x19, 0x3c, 0x39, 0x75, 0x2c, 0xd5, 0xc2, 0xc3, 0xa1, 0x4b, 0x29, 0x42, 0xea, 0x64, 0xab,
-	0x8f, 0xa8, 0xb9, 0xa5, 0x3a, 0xc8, 0x43, 0xc4, 0x25, 0xca, 0x68, 0x8c, 0x29, 0x86, 0x2b, 0xa7,
-	0x8e, 0xa5, 0xa4, 0x20, 0x25, 0x06, 0x55, 0x56, 0x2d, 0x4c, 0x86, 0x98, 0xf4, 0x18, 0x48, 0x8d,
-	0x2e, 0x51, 0x45, 0x65, 0xd9, 0xc1, 0x0e, 0x8e, 0xe2, 0xe1, 0x29, 0x8e, 0xae, 0x3a, 0x18, 0x3b,
-	0x03, 0xa4, 0xb2, 0x5b, 0xff, 0xf8, 0xbd, 0x6a, 0x7a, 0xd3, 0x38, 0x25, 0xff, 0x9d, 0xa2, 0xee,
-	0x10, 0x11, 0x6a, 0x0e, 0x47, 0x11, 0x60, 0xfd, 0xb3, 0x00, 0x0a, 0xaf, 0xa2, 0xae, 0x3a, 0xd4,
-	0xa4, 0x08, 0xee, 0x82, 0x92, 0x87, 0x4e, 0x68, 0xf8, 0xfa, 0x08, 0x13, 0x73, 0xd0, 0x73, 0xed,
-	0x32, 0xbf, 0xc6, 0x6f, 0x88, 0x1a, 0x0c, 0x7c, 0xb9, 0xd8, 0x42, 0x27, 0xb4, 0x1d, 0xa7, 0xf4,
-	0x86, 0x51, 0xf4, 0xe6, 0xef, 0x36, 0xac, 0x03, 0x90, 0x0a, 0x22, 0x65, 0x61, 0x2d, 0xb3, 0x91,
-	0xdf, 0x5e, 0x56, 0xa2, 0x26, 0x94, 0xa4, 0x09, 0xa5, 0xe6, 0x4d, 0xb5, 0xc5, 0xab, 0x8b, 0x6a,
-	0xae, 0x9e, 0x60, 0x8d, 0xb9, 0x32, 0xd8, 0x06, 0xb9, 0xe4, 0x75, 0x52, 0xce, 0x30, 0x0e, 0x59,
-	0xf9, 0xa7, 0x57, 0x4a, 0xf2, 0xb4, 0xb6, 0x74, 0xe9, 0xcb, 0xdc, 0xd7, 0x1b, 0x39, 0x97, 0x44,
-	0x88, 0x31, 0x23, 0x81, 0x2f, 0xc0, 0x7f, 0x13, 0x4c, 0x11, 0x29, 0x8b, 0x8c, 0xed, 0xf1, 0x3d,
-	0x6c, 0x07, 0x98, 0x22, 0x4d, 0x0c, 0x99, 0x8c, 0x08, 0xbf, 0x23, 0x9e, 0x7d, 0x92, 0xb9, 0xf5,
-	0x5f, 0x3c, 0xc8, 0x26, 0xbc, 0xb0, 0x05, 0xfe, 0xb7, 0xb0, 0x47, 0x91, 0x47, 0x99, 0x2f, 0xf7,
-	0xe9, 0x93, 0xae, 0x2e, 0xaa, 0x95, 0x78, 0x78, 0x0e, 0x9e, 0xa4, 0x6f, 0xd4, 0xa3, 0x5a, 0x23,
-	0x21, 0x81, 0x8f, 0x80, 0xe0, 0xda, 0x65, 0x81, 0x59, 0xbc, 0x10, 0xf8, 0xb2, 0xa0, 0x37, 0x0c,
-	0xc1, 0xb5, 0xe1, 0x36, 0x28, 0xa4, 0x1d, 0x86, 0x43, 0xc8, 0x30, 0xc4, 0x83, 0xc0, 0x97, 0xf3,
-	0xa9, 0x6d, 0x7a, 0xc3, 0xc8, 0xa7, 0x20, 0xdd, 0x86, 0x2f, 0x41, 0xd6, 0x46, 0xa6, 0x3d, 0x70,
-	0x3d, 0x54, 0x16, 0x59, 0x73, 0x95, 0x3b, 0xcd, 0x75, 0x93, 0x0d, 0xd0, 0xb2, 0xa1, 0xd2, 0xf3,
-	0x1b, 0x99, 0x37, 0xd2, 0xaa, 0x9d, 0x6c, 0x28, 0xf8, 0x63, 0x28, 0xfa, 0x07, 0x0f, 0xc4, 0xd0,
-	0x10, 0xa8, 0x82, 0xfc, 0xdd, 0x65, 0x28, 0x06, 0xbe, 0x0c, 0xe6, 0x16, 0x01, 0x8c, 0x66, 0x4b,
-	0xf0, 0x2e, 0x72, 0x7b, 0xcc, 0x44, 0x15, 0xb4, 0xd7, 0xbf, 0x7d, 0xb9, 0xea, 0xb8, 0xf4, 0xe8,
-	0xb8, 0x1f, 0x7a, 0x1e, 0x6f, 0x74, 0xfc, 0xa9, 0x12, 0xfb, 0x83, 0x4a, 0xa7, 0x23, 0x44, 0x94,
-	0x9a, 0x65, 0xd5, 0x6c, 0x7b, 0x8c, 0x08, 0xf9, 0x76, 0x51, 0x7d, 0x18, 0x5b, 0x17, 0x47, 0xb4,
-	0x29, 0x45, 0x24, 0x1a, 0xca, 0x18, 0xee, 0x82, 0x5c, 0x78, 0xe8, 0x85, 0x65, 0xcc, 0x96, 0xe2,
-	0xbd, 0xfb, 0x11, 0x0a, 0xe8, 0x4e, 0x47, 0xc8, 0xc8, 0x4e, 0xe2, 0x53, 0x34, 0xd2, 0xa7, 0x0e,
-	0xc8, 0x26, 0x39, 0xb8, 0x0a, 0x56, 0x0e, 0xf6, 0xbb, 0xcd, 0x5e, 0xf7, 0xb0, 0xdd, 0xec, 0xbd,
-	0x69, 0x75, 0xda, 0xcd, 0xba, 0xbe, 0xa7, 0x37, 0x1b, 0x25, 0x0e, 0x2e, 0x81, 0xc5, 0x59, 0xea,
-	0xb0, 0xd9, 0x29, 0xf1, 0xb0, 0x04, 0x0a, 0xb3, 0x50, 0x6b, 0xbf, 0x24, 0xc0, 0x15, 0xb0, 0x34,
-	0x8b, 0xd4, 0xb4, 0x4e, 0xb7, 0xa6, 0xb7, 0x4a, 0x99, 0x8a, 0x78, 0xf6, 0x45, 0xe2, 0xb4, 0xbd,
-	0xcb, 0x40, 0xe2, 0xaf, 0x03, 0x89, 0xff, 0x19, 0x48, 0xfc, 0xf9, 0xad, 0xc4, 0x5d, 0xdf, 0x4a,
-	0xdc, 0xf7, 0x5b, 0x89, 0x7b, 0xfb, 0x6c, 0xce, 0x93, 0x4d, 0x67, 0x60, 0xf6, 0x89, 0xba, 0xe9,
-	0x54, 0xad, 0x23, 0xd3, 0xf5, 0xd4, 0x93, 0xb9, 0x9f, 0x07, 0x73, 0xa7, 0xbf, 0xc0, 0x06, 0xf8,
-	0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xa9, 0xa7, 0x8e, 0x5a, 0x04, 0x00, 0x00,
+	// 1171 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x6f, 0x1b, 0x55,
+	0x14, 0xf6, 0xd8, 0x8e, 0x1f, 0xd7, 0x8e, 0xe3, 0xdc, 0x34, 0x30, 0x49, 0xc1, 0x13, 0xc2, 0x2b,
+	0x20, 0x3c, 0x93, 0x86, 0x45, 0xa5, 0x52, 0x89, 0x7a, 0xe2, 0x84, 0x5a, 0x48, 0x49, 0x34, 0x71,
+	0x83, 0xca, 0x02, 0x33, 0x9e, 0xb9, 0x8c, 0x47, 0xb5, 0xe7, 0x1a, 0xdf, 0xeb, 0x10, 0xf7, 0x17,
+	0x74, 0xd9, 0x0d, 0x52, 0x97, 0x48, 0xec, 0x58, 0x47, 0xe2, 0x27, 0x50, 0x75, 0x55, 0x75, 0x85,
+	0x2a, 0xe1, 0x22, 0x67, 0xc9, 0x3f, 0xe8, 0x02, 0xa1, 0xfb, 0x98, 0xb1, 0x93, 0x34, 0x6a, 0xb0,
+	0xba, 0xca, 0xdc, 0xf3, 0xf8, 0xce, 0xf9, 0xce, 0xcb, 0x01, 0xef, 0xdf, 0xf7, 0x1c, 0xc3, 0xc1,
+	0x9d, 0x8e, 0x4f, 0x29, 0x42, 0xc6, 0xe1, 0xb5, 0x26, 0xa2, 0xf6, 0x35, 0xc3, 0x43, 0x01, 0x22,
+	0x3e, 0xd1, 0xbb, 0x3d, 0x4c, 0x31, 0x5c, 0xbc, 0xef, 0x39, 0x7a, 0x64, 0xa4, 0x4b, 0xa3, 0xe5,
+	0x92, 0x83, 0x49, 0x07, 0x13, 0xa3, 0x69, 0x93, 0xb1, 0xa7, 0x83, 0xfd, 0x40, 0xb8, 0x2d, 0x2f,
+	0x09, 0x7d, 0x83, 0xbf, 0x0c, 0xf1, 0x90, 0xaa, 0x2b, 0x1e, 0xf6, 0xb0, 0x90, 0xb3, 0xaf, 0xd0,
+	0xc1, 0xc3, 0xd8, 0x6b, 0x23, 0x83, 0xbf, 0x9a, 0xfd, 0x1f, 0x0c, 0x3b, 0x18, 0x48, 0x55, 0xe9,
+	0xac, 0xca, 0xed, 0xf7, 0x6c, 0xea, 0xe3, 0x30, 0x96, 0x76, 0x56, 0x4f, 0xfd, 0x0e, 0x22, 0xd4,
+	0xee, 0x74, 0x85, 0xc1, 0xea, 0xef, 0x09, 0x90, 0xff, 0x4a, 0xb0, 0xda, 0xa7, 0x36, 0x45, 0xf0,
+	0x26, 0x28, 0x06, 0xe8, 0x88, 0xb2, 0xec, 0xba, 0x98, 0xd8, 0xed, 0x86, 0xef, 0xaa, 0xca, 0x8a,
+	0xb2, 0x96, 0x34, 0xe1, 0x68, 0xa8, 0x15, 0x76, 0xd0, 0x11, 0xdd, 0x93, 0xaa, 0x5a, 0xd5, 0x2a,
+	0x04, 0x93, 0x6f, 0x17, 0x6e, 0x02, 0x10, 0x15, 0x84, 0xa8, 0xf1, 0x95, 0xc4, 0x5a, 0x6e, 0xe3,
+	0x8a, 0x2e, 0x92, 0xd0, 0xc3, 0x24, 0xf4, 0x4a, 0x30, 0x30, 0x67, 0x9f, 0x1c, 0x97, 0xb3, 0x9b,
+	0xa1, 0xad, 0x35, 0xe1, 0x06, 0xf7, 0x40, 0x36, 0x8c, 0x4e, 0xd4, 0x04, 0xc7, 0xd0, 0xf4, 0x57,
+	0xd6, 0x5a, 0x0f, 0x43, 0x9b, 0xf3, 0x8f, 0x87, 0x5a, 0xec, 0xb7, 0x17, 0x5a, 0x36, 0x94, 0x10,
+	0x6b, 0x0c, 0x02, 0xaf, 0x83, 0x99, 0x43, 0x4c, 0x11, 0x51, 0x93, 0x1c, 0xed, 0xea, 0x05, 0x68,
+	0x07, 0x98, 0x22, 0x33, 0xc9, 0x90, 0x2c, 0x61, 0x0f, 0xbf, 0x00, 0xa9, 0xae, 0xdd, 0xb3, 0x3b,
+	0x44, 0x9d, 0x59, 0x51, 0xd6, 0x72, 0x1b, 0xef, 0x5e, 0x94, 0x07, 0x37, 0x92, 0xbe, 0xd2, 0x05,
+	0x1e, 0x80, 0xa2, 0xd3, 0xc6, 0x04, 0xb9, 0x8d, 0x31, 0x9d, 0x14, 0x4f, 0xe0, 0xc3, 0x0b, 0x60,
+	0x36, 0xb9, 0x79, 0x44, 0x4a, 0xc0, 0xcd, 0x39, 0xa7, 0xa4, 0xe4, 0x46, 0xf2, 0xc1, 0x2f, 0x5a,
+	0x6c, 0xd5, 0x07, 0x29, 0x11, 0x15, 0x36, 0xc0, 0xd2, 0x99, 0x38, 0x8d, 0x1e, 0xa2, 0x28, 0x60,
+	0x73, 0xc0, 0x7b, 0x97, 0xdb, 0x58, 0x3a, 0xd7, 0x83, 0xaa, 0x1c, 0x14, 0x33, 0xc3, 0x82, 0x3c,
+	0x7a, 0xa1, 0x29, 0xd6, 0xdb, 0xa7, 0x03, 0x59, 0x21, 0xc6, 0xea, 0x5f, 0x09, 0x90, 0x09, 0xa5,
+	0x70, 0x07, 0xa4, 0x1d, 0x1c, 0x30, 0x95, 0xc4, 0x7e, 0x75, 0x7f, 0x4b, 0x4f, 0x8e, 0xcb, 0xcb,
+	0x72, 0xb8, 0x3d, 0x7c, 0x38, 0xa6, 0x28, 0x7c, 0xad, 0x10, 0x04, 0xbe, 0x05, 0xe2, 0xbe, 0xab,
+	0xc6, 0xf9, 0x88, 0xa5, 0x46, 0x43, 0x2d, 0x5e, 0xab, 0x5a, 0x71, 0xdf, 0x85, 0x1b, 0x20, 0x1f,
+	0x15, 0x88, 0x0d, 0x61, 0x82, 0x5b, 0xcc, 0x8d, 0x86, 0x5a, 0x2e, 0x1a, 0x9b, 0x5a, 0xd5, 0xca,
+	0x45, 0x46, 0x35, 0x17, 0xde, 0x02, 0x19, 0x17, 0xd9, 0x6e, 0xdb, 0x0f, 0x90, 0x9a, 0xe4, 0xc9,
+	0x2d, 0x9f, 0x4b, 0xae, 0x1e, 0x6e, 0x80, 0x60, 0xfe, 0x90, 0x31, 0x8f, 0xbc, 0xa0, 0x0b, 0x32,
+	0xa2, 0x88, 0xa8, 0xc7, 0x5b, 0x9e, 0x37, 0x6f, 0xbf, 0x1c, 0x6a, 0x65, 0xcf, 0xa7, 0xad, 0x7e,
+	0x93, 0x75, 0x4c, 0x2e, 0xac, 0xfc, 0x53, 0x26, 0xee, 0x3d, 0x83, 0x0e, 0xba, 0x88, 0xe8, 0x15,
+	0xc7, 0xa9, 0xb8, 0x6e, 0x0f, 0x11, 0xf2, 0xec, 0xb8, 0xbc, 0x20, 0x99, 0x4b, 0x89, 0x39, 0xa0,
+	0x88, 0x58, 0x11, 0x32, 0x44, 0x20, 0xed, 0xa2, 0x2e, 0x26, 0x3e, 0x95, 0x03, 0xb1, 0xa4, 0x4b,
+	0x07, 0x76, 0x34, 0x26, 0x6a, 0xe5, 0x07, 0xe6, 0xba, 0x9c, 0xec, 0xb5, 0x4b, 0xe4, 0xc0, 0x1c,
+	0x88, 0x15, 0x62, 0xc3, 0x77, 0x40, 0x16, 0x1d, 0x75, 0x91, 0xeb, 0x53, 0xe4, 0xaa, 0xe9, 0x15,
+	0x65, 0x2d, 0x63, 0x8d, 0x05, 0x37, 0x32, 0x6c, 0x8c, 0x1e, 0xb1, 0x51, 0xfa, 0x37, 0x0e, 0x92,
+	0x6c, 0xf6, 0xa1, 0x01, 0x72, 0xe7, 0xf7, 0xbe, 0x30, 0x1a, 0x6a, 0x60, 0x62, 0xe7, 0x41, 0x77,
+	0xbc, 0xef, 0xdf, 0x89, 0xc5, 0xea, 0xa9, 0xf1, 0x37, 0x5c, 0x2b, 0x01, 0x0b, 0x6f, 0x82, 0x2c,
+	0xfb, 0x68, 0x30, 0x37, 0x3e, 0x01, 0x85, 0x0b, 0x4f, 0x01, 0x23, 0x50, 0x1f, 0x74, 0x91, 0x95,
+	0x39, 0x94, 0x5f, 0xf0, 0x4b, 0x90, 0xfa, 0x09, 0xf9, 0x5e, 0x8b, 0xf2, 0x61, 0xc8, 0x9a, 0x1f,
+	0x3f, 0x1f, 0x6a, 0x8b, 0x22, 0x1a, 0x71, 0xef, 0xe9, 0x3e, 0x36, 0x3a, 0x36, 0x6d, 0xe9, 0xb5,
+	0x80, 0x3e, 0x3b, 0x2e, 0x03, 0x99, 0x46, 0x2d, 0xa0, 0x96, 0x74, 0x83, 0xdf, 0x83, 0x34, 0xee,
+	0xb2, 0x15, 0x60, 0xfb, 0xcf, 0xfa, 0xf4, 0xc9, 0x05, 0xc1, 0xbf, 0xe1, 0xf6, 0xc8, 0x65, 0x49,
+	0xec, 0x72, 0x0f, 0xf3, 0xaa, 0xec, 0xdb, 0xc2, 0x79, 0x1d, 0xb1, 0x42, 0x58, 0xb9, 0xcb, 0x3f,
+	0x2b, 0x00, 0x9e, 0x37, 0x83, 0xd7, 0x41, 0x4a, 0xd8, 0xa9, 0xca, 0xe5, 0xa8, 0x4b, 0x73, 0xb8,
+	0x1d, 0x11, 0x8f, 0x73, 0xe2, 0x3a, 0xcb, 0xe5, 0xf9, 0x50, 0xfb, 0xe8, 0x12, 0xbd, 0xa9, 0x22,
+	0x27, 0xe4, 0xbf, 0xfa, 0x4f, 0x1c, 0x14, 0x4e, 0xdf, 0x24, 0x58, 0x09, 0x17, 0xc4, 0x6e, 0xcb,
+	0xfd, 0x7f, 0xed, 0x6d, 0x16, 0x67, 0x2c, 0x72, 0x83, 0x65, 0x90, 0xc6, 0x7d, 0xea, 0xe0, 0x0e,
+	0x92, 0x6b, 0xbf, 0xf0, 0x72, 0xa8, 0xcd, 0x85, 0xe6, 0xbb, 0x42, 0x65, 0x85, 0x36, 0xf0, 0x16,
+	0x98, 0xa1, 0x76, 0xbb, 0x3d, 0xe0, 0xfd, 0xcf, 0x6d, 0x7c, 0xf0, 0x9a, 0x70, 0x75, 0x66, 0x1b,
+	0x5e, 0x71, 0xee, 0x38, 0xfd, 0xf9, 0x7f, 0x0f, 0xe4, 0xf9, 0x4d, 0x6c, 0xb4, 0x44, 0x35, 0xd9,
+	0x45, 0x48, 0x58, 0x39, 0x2e, 0xbb, 0x2d, 0x46, 0x84, 0xfd, 0xe2, 0x71, 0x13, 0xf6, 0xcb, 0xaa,
+	0xa6, 0xfe, 0xc7, 0xd1, 0xc9, 0x72, 0x3f, 0xa6, 0x91, 0x53, 0xf0, 0x47, 0x12, 0xcc, 0x9e, 0x62,
+	0x01, 0xbf, 0x06, 0xd9, 0x01, 0x22, 0x0d, 0x91, 0xbc, 0x32, 0x55, 0x2b, 0x33, 0x03, 0x44, 0x0e,
+	0x38, 0x99, 0x1a, 0xc8, 0x04, 0x58, 0x62, 0x4d, 0x37, 0x16, 0xe9, 0x00, 0x0b, 0xa8, 0x7d, 0x30,
+	0xeb, 0xf4, 0x7b, 0x3d, 0x14, 0x50, 0x89, 0x97, 0x98, 0x0a, 0x2f, 0x2f, 0x41, 0x04, 0xe8, 0x1d,
+	0x50, 0xe8, 0x62, 0x42, 0xfc, 0x66, 0x1b, 0x35, 0xc2, 0x76, 0x4d, 0x83, 0x3a, 0x1b, 0xa2, 0x44,
+	0xb0, 0xe2, 0x84, 0xb4, 0x7a, 0x88, 0xb4, 0x70, 0xdb, 0x55, 0x67, 0xa6, 0x83, 0xe5, 0x67, 0x25,
+	0x04, 0x61, 0x2b, 0xf6, 0x63, 0x1f, 0xf7, 0xfa, 0x1d, 0x35, 0x35, 0x15, 0x9c, 0xf4, 0x66, 0xa5,
+	0xb4, 0x9b, 0x84, 0xda, 0x7e, 0x20, 0x49, 0xa7, 0xa7, 0x2b, 0xa5, 0x04, 0xe1, 0x9c, 0x3f, 0xf5,
+	0x40, 0x26, 0xbc, 0x09, 0x70, 0x09, 0x2c, 0x1e, 0xec, 0xd6, 0xb7, 0x1a, 0xf5, 0xbb, 0x7b, 0x5b,
+	0x8d, 0x3b, 0x3b, 0xfb, 0x7b, 0x5b, 0x9b, 0xb5, 0xed, 0xda, 0x56, 0xb5, 0x18, 0x83, 0xf3, 0x60,
+	0x76, 0xac, 0xba, 0xbb, 0xb5, 0x5f, 0x54, 0x60, 0x11, 0xe4, 0xc7, 0xa2, 0x9d, 0xdd, 0x62, 0x1c,
+	0x2e, 0x82, 0xf9, 0xb1, 0xa4, 0x62, 0xee, 0xd7, 0x2b, 0xb5, 0x9d, 0x62, 0x62, 0x39, 0xf9, 0xe0,
+	0xd7, 0x52, 0xcc, 0xdc, 0x7e, 0x3c, 0x2a, 0x29, 0x4f, 0x47, 0x25, 0xe5, 0xef, 0x51, 0x49, 0x79,
+	0x78, 0x52, 0x8a, 0x3d, 0x3d, 0x29, 0xc5, 0xfe, 0x3c, 0x29, 0xc5, 0xbe, 0xfd, 0x6c, 0x22, 0xf1,
+	0x75, 0xaf, 0x6d, 0x37, 0x89, 0xb1, 0xee, 0x95, 0x9d, 0x96, 0xed, 0x07, 0xc6, 0xd1, 0xc4, 0xbf,
+	0xd6, 0x9c, 0x42, 0x33, 0xc5, 0x37, 0xe5, 0xf3, 0xff, 0x06, 0x00, 0xe1, 0xf6, 0x99, 0xc6, 0x78,
+	0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -263,6 +495,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClosedProposals) > 0 {
+		for iNdEx := len(m.ClosedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClosedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -313,6 +569,37 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ClosedProposalRetention, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClosedProposalRetention):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -333,12 +620,43 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
-	if err1 != nil {
-		return 0, err1
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x22
 	if m.CommitteeID != 0 {
@@ -386,6 +704,32 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Weight != nil {
+		{
+			size := m.Weight.Size()
+			i -= size
+			if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.VoteType != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.VoteType))
 		i--
@@ -406,94 +750,1267 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NextProposalID != 0 {
-		n += 1 + sovGenesis(uint64(m.NextProposalID))
-	}
-	if len(m.Committees) > 0 {
-		for _, e := range m.Committees {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Votes) > 0 {
-		for _, e := range m.Votes {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClosedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClosedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClosedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Content != nil {
-		l = m.Content.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CloseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CloseTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	if m.ID != 0 {
-		n += 1 + sovGenesis(uint64(m.ID))
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	if m.CloseHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CloseHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.CommitteeID != 0 {
-		n += 1 + sovGenesis(uint64(m.CommitteeID))
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Outcome != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AbstainVotes.Size()
+		i -= size
+		if _, err := m.AbstainVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Quorum.Size()
+		i -= size
+		if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.VoteThreshold.Size()
+		i -= size
+		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PossibleVotes.Size()
+		i -= size
+		if _, err := m.PossibleVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CurrentVotes.Size()
+		i -= size
+		if _, err := m.CurrentVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NoVotes.Size()
+		i -= size
+		if _, err := m.NoVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.YesVotes.Size()
+		i -= size
+		if _, err := m.YesVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextProposalID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextProposalID))
+	}
+	if len(m.Committees) > 0 {
+		for _, e := range m.Committees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ClosedProposals) > 0 {
+		for _, e := range m.ClosedProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClosedProposalRetention)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovGenesis(uint64(m.ID))
+	}
+	if m.CommitteeID != 0 {
+		n += 1 + sovGenesis(uint64(m.CommitteeID))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Expedited {
+		n += 2
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovGenesis(uint64(m.ProposalID))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.VoteType != 0 {
+		n += 1 + sovGenesis(uint64(m.VoteType))
+	}
+	if m.Weight != nil {
+		l = m.Weight.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGenesis(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ClosedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Outcome != 0 {
+		n += 1 + sovGenesis(uint64(m.Outcome))
+	}
+	l = m.Tally.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CloseHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.CloseHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CloseTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ProposalTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.YesVotes.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.NoVotes.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CurrentVotes.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PossibleVotes.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Quorum.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.AbstainVotes.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposalID", wireType)
+			}
+			m.NextProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Committees = append(m.Committees, &types.Any{})
+			if err := m.Committees[len(m.Committees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClosedProposals = append(m.ClosedProposals, ClosedProposal{})
+			if err := m.ClosedProposals[len(m.ClosedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedProposalRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ClosedProposalRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &types.Any{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeID", wireType)
+			}
+			m.CommitteeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types1.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			}
+			m.VoteType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteType |= VoteType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Weight = &v
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *Vote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalID != 0 {
-		n += 1 + sovGenesis(uint64(m.ProposalID))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.VoteType != 0 {
-		n += 1 + sovGenesis(uint64(m.VoteType))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *ClosedProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -516,17 +2033,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClosedProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClosedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextProposalID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			m.NextProposalID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -536,14 +2053,47 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextProposalID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= ProposalOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -570,14 +2120,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Committees = append(m.Committees, &types.Any{})
-			if err := m.Committees[len(m.Committees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -604,14 +2153,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseHeight", wireType)
+			}
+			m.CloseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -638,8 +2206,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CloseTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -664,7 +2231,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *ProposalTally) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -687,17 +2254,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalTally: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalTally: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field YesVotes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -707,33 +2274,31 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Content == nil {
-				m.Content = &types.Any{}
-			}
-			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.YesVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoVotes", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -743,16 +2308,31 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVotes", wireType)
 			}
-			m.CommitteeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -762,16 +2342,31 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitteeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PossibleVotes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -781,80 +2376,31 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PossibleVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Vote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
 			}
-			m.ProposalID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -864,16 +2410,31 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -883,31 +2444,31 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Voter == nil {
-				m.Voter = []byte{}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainVotes", wireType)
 			}
-			m.VoteType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -917,11 +2478,26 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VoteType |= VoteType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstainVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
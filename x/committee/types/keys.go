@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,6 +28,10 @@ var (
 	VoteKeyPrefix      = []byte{0x02} // prefix for keys that store votes
 
 	NextProposalIDKey = []byte{0x03} // key for the next proposal id
+
+	ClosedProposalKeyPrefix       = []byte{0x04} // prefix for keys that store closed proposals
+	ClosedProposalByTimeKeyPrefix = []byte{0x05} // prefix for keys that index closed proposals by close time
+	ParamsKey                     = []byte{0x06} // key for the module params
 )
 
 // GetKeyFromID returns the bytes to use as a key for a uint64 id
@@ -38,6 +43,11 @@ func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
 	return append(GetKeyFromID(proposalID), voter.Bytes()...)
 }
 
+// GetClosedProposalByTimeKey returns the key indexing a closed proposal by its close time
+func GetClosedProposalByTimeKey(closeTime time.Time, proposalID uint64) []byte {
+	return append(sdk.FormatTimeBytes(closeTime), GetKeyFromID(proposalID)...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -12,11 +13,13 @@ import (
 const (
 	TypeMsgSubmitProposal = "commmittee_submit_proposal" // 'committee' prefix appended to avoid potential conflicts with gov msg types
 	TypeMsgVote           = "committee_vote"
+	TypeMsgChangeParams   = "committee_change_params"
+	TypeMsgCancelProposal = "committee_cancel_proposal"
 )
 
 var (
-	_, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgVote{}
-	_    types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgVote{}, &MsgChangeParams{}, &MsgCancelProposal{}
+	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal instance
@@ -64,6 +67,9 @@ func (msg MsgSubmitProposal) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
 		return err
 	}
+	if err := msg.Deposit.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidDeposit, err.Error())
+	}
 	return msg.GetPubProposal().ValidateBasic()
 }
 
@@ -114,9 +120,57 @@ func (vo VoteType) Format(s fmt.State, verb rune) {
 	}
 }
 
+// VoteTypeFromString returns the vote type of a yes/y, no/n or abstain/a vote
+func VoteTypeFromString(str string) (VoteType, error) {
+	switch strings.ToLower(strings.TrimSpace(str)) {
+	case "yes", "y":
+		return VOTE_TYPE_YES, nil
+	case "no", "n":
+		return VOTE_TYPE_NO, nil
+	case "abstain", "a":
+		return VOTE_TYPE_ABSTAIN, nil
+	default:
+		return VOTE_TYPE_UNSPECIFIED, fmt.Errorf("must specify a valid vote type: (yes/y, no/n, abstain/a)")
+	}
+}
+
+// WeightedVoteOptionsFromString parses the options of a weighted vote, e.g. "yes=0.6,no=0.3,abstain=0.1"
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, option := range strings.Split(str, ",") {
+		fields := strings.Split(option, "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid vote option: %s", option)
+		}
+		voteType, err := VoteTypeFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		weight, err := sdk.NewDecFromStr(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, NewWeightedVoteOption(voteType, weight))
+	}
+	return options, nil
+}
+
 // NewMsgVote creates a message to cast a vote on an active proposal
 func NewMsgVote(voter sdk.AccAddress, proposalID uint64, voteType VoteType) *MsgVote {
-	return &MsgVote{proposalID, voter.String(), voteType}
+	return &MsgVote{
+		ProposalID: proposalID,
+		Voter:      voter.String(),
+		VoteType:   voteType,
+	}
+}
+
+// NewMsgWeightedVote creates a message to cast a vote split across the vote types of the options on an active proposal
+func NewMsgWeightedVote(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) *MsgVote {
+	return &MsgVote{
+		ProposalID: proposalID,
+		Voter:      voter.String(),
+		Options:    options,
+	}
 }
 
 // Route return the message type used for routing the message.
@@ -130,7 +184,7 @@ func (msg MsgVote) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
 		return err
 	}
-	return msg.VoteType.Validate()
+	return validateVote(msg.VoteType, msg.Options)
 }
 
 // GetSignBytes gets the canonical byte representation of the Msg.
@@ -151,3 +205,64 @@ func (msg MsgVote) GetVoter() sdk.AccAddress {
 	}
 	return address
 }
+
+// Route return the message type used for routing the message.
+func (msg MsgChangeParams) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgChangeParams) Type() string { return TypeMsgChangeParams }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgChangeParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if msg.Params == nil {
+		return fmt.Errorf("params cannot be nil")
+	}
+	return msg.Params.Validate()
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgChangeParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgChangeParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCancelProposal creates a message to cancel an active proposal
+func NewMsgCancelProposal(proposer sdk.AccAddress, proposalID uint64) *MsgCancelProposal {
+	return &MsgCancelProposal{
+		ProposalID: proposalID,
+		Proposer:   proposer.String(),
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgCancelProposal) Type() string { return TypeMsgCancelProposal }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCancelProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Proposer)
+	return err
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{addr}
+}
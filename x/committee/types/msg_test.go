@@ -41,6 +41,47 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 			msg:        &MsgSubmitProposal{PubProposal: &types.Any{}, Proposer: addr.String(), CommitteeID: 3},
 			expectPass: false,
 		},
+		{
+			name: "invalid deposit",
+			msg: func() *MsgSubmitProposal {
+				msg := MustNewMsgSubmitProposal(govv1beta1.NewTextProposal("A Title", "A proposal description."), addr, 3)
+				msg.Deposit = sdk.Coins{sdk.Coin{Denom: "a0gi", Amount: sdk.NewInt(-1)}}
+				return msg
+			}(),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCancelProposal_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(crypto.AddressHash([]byte("0gChainTest1")))
+	tests := []struct {
+		name       string
+		msg        *MsgCancelProposal
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			msg:        NewMsgCancelProposal(addr, 5),
+			expectPass: true,
+		},
+		{
+			name:       "empty address",
+			msg:        &MsgCancelProposal{ProposalID: 5, Proposer: ""},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -65,32 +106,57 @@ func TestMsgVote_ValidateBasic(t *testing.T) {
 	}{
 		{
 			name:       "normal",
-			msg:        MsgVote{5, addr.String(), VOTE_TYPE_YES},
+			msg:        MsgVote{ProposalID: 5, Voter: addr.String(), VoteType: VOTE_TYPE_YES},
 			expectPass: true,
 		},
 		{
 			name:       "No",
-			msg:        MsgVote{5, addr.String(), VOTE_TYPE_NO},
+			msg:        MsgVote{ProposalID: 5, Voter: addr.String(), VoteType: VOTE_TYPE_NO},
 			expectPass: true,
 		},
 		{
 			name:       "Abstain",
-			msg:        MsgVote{5, addr.String(), VOTE_TYPE_ABSTAIN},
+			msg:        MsgVote{ProposalID: 5, Voter: addr.String(), VoteType: VOTE_TYPE_ABSTAIN},
 			expectPass: true,
 		},
 		{
 			name:       "Null vote",
-			msg:        MsgVote{5, addr.String(), VOTE_TYPE_UNSPECIFIED},
+			msg:        MsgVote{ProposalID: 5, Voter: addr.String(), VoteType: VOTE_TYPE_UNSPECIFIED},
 			expectPass: false,
 		},
 		{
 			name:       "empty address",
-			msg:        MsgVote{5, "", VOTE_TYPE_YES},
+			msg:        MsgVote{ProposalID: 5, Voter: "", VoteType: VOTE_TYPE_YES},
 			expectPass: false,
 		},
 		{
 			name:       "invalid vote (greater)",
-			msg:        MsgVote{5, addr.String(), 4},
+			msg:        MsgVote{ProposalID: 5, Voter: addr.String(), VoteType: 4},
+			expectPass: false,
+		},
+		{
+			name:       "weighted",
+			msg:        *NewMsgWeightedVote(addr, 5, WeightedVoteOptions{{VOTE_TYPE_YES, sdk.MustNewDecFromStr("0.6")}, {VOTE_TYPE_ABSTAIN, sdk.MustNewDecFromStr("0.4")}}),
+			expectPass: true,
+		},
+		{
+			name:       "weighted with vote type",
+			msg:        MsgVote{ProposalID: 5, Voter: addr.String(), VoteType: VOTE_TYPE_YES, Options: NewNonSplitVoteOption(VOTE_TYPE_YES)},
+			expectPass: false,
+		},
+		{
+			name:       "weights not summing to 1",
+			msg:        *NewMsgWeightedVote(addr, 5, WeightedVoteOptions{{VOTE_TYPE_YES, sdk.MustNewDecFromStr("0.6")}, {VOTE_TYPE_NO, sdk.MustNewDecFromStr("0.3")}}),
+			expectPass: false,
+		},
+		{
+			name:       "duplicate option",
+			msg:        *NewMsgWeightedVote(addr, 5, WeightedVoteOptions{{VOTE_TYPE_YES, sdk.MustNewDecFromStr("0.5")}, {VOTE_TYPE_YES, sdk.MustNewDecFromStr("0.5")}}),
+			expectPass: false,
+		},
+		{
+			name:       "null option",
+			msg:        *NewMsgWeightedVote(addr, 5, WeightedVoteOptions{{VOTE_TYPE_UNSPECIFIED, sdk.OneDec()}}),
 			expectPass: false,
 		},
 	}
@@ -107,3 +173,19 @@ func TestMsgVote_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("yes=0.6, n=0.3,abstain=0.1")
+	require.NoError(t, err)
+	require.Equal(t, WeightedVoteOptions{
+		{VOTE_TYPE_YES, sdk.MustNewDecFromStr("0.6")},
+		{VOTE_TYPE_NO, sdk.MustNewDecFromStr("0.3")},
+		{VOTE_TYPE_ABSTAIN, sdk.MustNewDecFromStr("0.1")},
+	}, options)
+	require.NoError(t, options.Validate())
+
+	for _, str := range []string{"yes", "maybe=1", "yes=one", "yes=0.5=no"} {
+		_, err := WeightedVoteOptionsFromString(str)
+		require.Error(t, err, str)
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	fmt "fmt"
+)
+
+// DefaultClosedProposalRetention keeps closed proposals forever.
+const DefaultClosedProposalRetention = 0
+
+// DefaultParams returns the default committee module params.
+func DefaultParams() Params {
+	return Params{
+		ClosedProposalRetention: DefaultClosedProposalRetention,
+	}
+}
+
+// Validate checks the params are valid.
+func (p Params) Validate() error {
+	if p.ClosedProposalRetention < 0 {
+		return fmt.Errorf("closed proposal retention cannot be negative: %s", p.ClosedProposalRetention)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -53,10 +54,15 @@ var (
 	_ Permission = TextPermission{}
 	_ Permission = SoftwareUpgradePermission{}
 	_ Permission = ParamsChangePermission{}
+	_ Permission = MsgPermission{}
 )
 
-// Allows implement permission interface for GodPermission.
-func (GodPermission) Allows(sdk.Context, ParamKeeper, PubProposal) bool { return true }
+// Allows implement permission interface for GodPermission. ExecuteMsgsProposals are excluded, their messages being
+// signed by the gov module account, they must be allowed explicitly by a MsgPermission.
+func (GodPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	_, ok := p.(*ExecuteMsgsProposal)
+	return !ok
+}
 
 // Allows implement permission interface for TextPermission.
 func (TextPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
@@ -100,6 +106,90 @@ func (perm ParamsChangePermission) Allows(ctx sdk.Context, pk ParamKeeper, p Pub
 	return true
 }
 
+// Allows implement permission interface for MsgPermission.
+func (perm MsgPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(*ExecuteMsgsProposal)
+	if !ok {
+		return false
+	}
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return false
+	}
+
+	// Check if all proposal messages are allowed by this permission.
+	for _, msg := range msgs {
+		if !perm.AllowedMsgs.allowsMsg(msg) {
+			return false
+		}
+	}
+	return true
+}
+
+type AllowedMsgs []AllowedMsg
+
+// allowsMsg returns true if any of the AllowedMsg with the type url of the message allows it.
+func (allowed AllowedMsgs) allowsMsg(msg sdk.Msg) bool {
+	typeURL := sdk.MsgTypeURL(msg)
+	var fields map[string]interface{}
+	for _, am := range allowed {
+		if am.TypeUrl != typeURL {
+			continue
+		}
+		if len(am.FieldRequirements) == 0 {
+			return true
+		}
+		// decode the message once, only when there are requirements to check
+		if fields == nil {
+			bz, err := codec.ProtoMarshalJSON(msg, nil)
+			if err != nil {
+				return false
+			}
+			if err := json.Unmarshal(bz, &fields); err != nil {
+				return false
+			}
+		}
+		if am.allowsFields(fields) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsFields returns true if the JSON decoded message fields meet all the field requirements.
+func (am AllowedMsg) allowsFields(fields map[string]interface{}) bool {
+	for _, req := range am.FieldRequirements {
+		value, found := lookupField(fields, req.Path)
+		if !found {
+			return false
+		}
+		var required interface{}
+		if err := json.Unmarshal([]byte(req.Value), &required); err != nil {
+			return false
+		}
+		if !reflect.DeepEqual(value, required) {
+			return false
+		}
+	}
+	return true
+}
+
+// lookupField returns the value of the dot separated path in the JSON decoded fields.
+func lookupField(fields map[string]interface{}, path string) (interface{}, bool) {
+	var value interface{} = fields
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		value, ok = object[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
 type AllowedParamsChanges []AllowedParamsChange
 
 // Get searches the allowedParamsChange slice for the first item matching a subspace and key.
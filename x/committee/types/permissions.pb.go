@@ -24,7 +24,8 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// GodPermission allows any governance proposal. It is used mainly for testing.
+// GodPermission allows any governance proposal but ExecuteMsgsProposals, which are allowed by MsgPermissions only.
+// It is used mainly for testing.
 type GodPermission struct {
 }
 
@@ -293,6 +294,160 @@ func (m *ParamsChangePermission) GetAllowedParamsChanges() AllowedParamsChanges
 	return nil
 }
 
+// MsgPermission allows proposals executing messages of the allowed types.
+type MsgPermission struct {
+	AllowedMsgs AllowedMsgs `protobuf:"bytes,1,rep,name=allowed_msgs,json=allowedMsgs,proto3,castrepeated=AllowedMsgs" json:"allowed_msgs"`
+}
+
+func (m *MsgPermission) Reset()         { *m = MsgPermission{} }
+func (m *MsgPermission) String() string { return proto.CompactTextString(m) }
+func (*MsgPermission) ProtoMessage()    {}
+func (*MsgPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57b97afa685555be, []int{7}
+}
+func (m *MsgPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPermission.Merge(m, src)
+}
+func (m *MsgPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPermission proto.InternalMessageInfo
+
+func (m *MsgPermission) GetAllowedMsgs() AllowedMsgs {
+	if m != nil {
+		return m.AllowedMsgs
+	}
+	return nil
+}
+
+// AllowedMsg contains the type url of an allowed message and the requirements on its fields.
+type AllowedMsg struct {
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// Requirements on the message fields. All requirements must hold for the message to be allowed.
+	FieldRequirements []MsgFieldRequirement `protobuf:"bytes,2,rep,name=field_requirements,json=fieldRequirements,proto3" json:"field_requirements"`
+}
+
+func (m *AllowedMsg) Reset()         { *m = AllowedMsg{} }
+func (m *AllowedMsg) String() string { return proto.CompactTextString(m) }
+func (*AllowedMsg) ProtoMessage()    {}
+func (*AllowedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57b97afa685555be, []int{8}
+}
+func (m *AllowedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedMsg.Merge(m, src)
+}
+func (m *AllowedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedMsg proto.InternalMessageInfo
+
+func (m *AllowedMsg) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *AllowedMsg) GetFieldRequirements() []MsgFieldRequirement {
+	if m != nil {
+		return m.FieldRequirements
+	}
+	return nil
+}
+
+// MsgFieldRequirement requires a message field to hold a fixed value.
+type MsgFieldRequirement struct {
+	// The dot separated path of the field in the proto JSON encoding of the message, e.g. "params.max_quorums".
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The required JSON encoded value of the field.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *MsgFieldRequirement) Reset()         { *m = MsgFieldRequirement{} }
+func (m *MsgFieldRequirement) String() string { return proto.CompactTextString(m) }
+func (*MsgFieldRequirement) ProtoMessage()    {}
+func (*MsgFieldRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57b97afa685555be, []int{9}
+}
+func (m *MsgFieldRequirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFieldRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFieldRequirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFieldRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFieldRequirement.Merge(m, src)
+}
+func (m *MsgFieldRequirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFieldRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFieldRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFieldRequirement proto.InternalMessageInfo
+
+func (m *MsgFieldRequirement) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *MsgFieldRequirement) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 // AllowedParamsChange contains data on the allowed parameter changes for subspace, key, and sub params requirements.
 type AllowedParamsChange struct {
 	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
@@ -309,7 +464,7 @@ func (m *AllowedParamsChange) Reset()         { *m = AllowedParamsChange{} }
 func (m *AllowedParamsChange) String() string { return proto.CompactTextString(m) }
 func (*AllowedParamsChange) ProtoMessage()    {}
 func (*AllowedParamsChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57b97afa685555be, []int{7}
+	return fileDescriptor_57b97afa685555be, []int{10}
 }
 func (m *AllowedParamsChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +535,7 @@ func (m *SubparamRequirement) Reset()         { *m = SubparamRequirement{} }
 func (m *SubparamRequirement) String() string { return proto.CompactTextString(m) }
 func (*SubparamRequirement) ProtoMessage()    {}
 func (*SubparamRequirement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57b97afa685555be, []int{8}
+	return fileDescriptor_57b97afa685555be, []int{11}
 }
 func (m *SubparamRequirement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,6 +593,9 @@ func init() {
 	proto.RegisterType((*CommunityCDPWithdrawCollateralPermission)(nil), "zgc.committee.v1beta1.CommunityCDPWithdrawCollateralPermission")
 	proto.RegisterType((*CommunityPoolLendWithdrawPermission)(nil), "zgc.committee.v1beta1.CommunityPoolLendWithdrawPermission")
 	proto.RegisterType((*ParamsChangePermission)(nil), "zgc.committee.v1beta1.ParamsChangePermission")
+	proto.RegisterType((*MsgPermission)(nil), "zgc.committee.v1beta1.MsgPermission")
+	proto.RegisterType((*AllowedMsg)(nil), "zgc.committee.v1beta1.AllowedMsg")
+	proto.RegisterType((*MsgFieldRequirement)(nil), "zgc.committee.v1beta1.MsgFieldRequirement")
 	proto.RegisterType((*AllowedParamsChange)(nil), "zgc.committee.v1beta1.AllowedParamsChange")
 	proto.RegisterType((*SubparamRequirement)(nil), "zgc.committee.v1beta1.SubparamRequirement")
 }
@@ -447,40 +605,46 @@ func init() {
 }
 
 var fileDescriptor_57b97afa685555be = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc7, 0x9b, 0x5f, 0xa6, 0x9f, 0x98, 0x11, 0xd3, 0x94, 0x95, 0x29, 0x8b, 0x46, 0x5a, 0x95,
-	0x03, 0x15, 0xb0, 0x64, 0x05, 0x71, 0xd9, 0xad, 0xed, 0x04, 0x17, 0x0e, 0x55, 0x06, 0x42, 0xe2,
-	0x12, 0x39, 0x89, 0x71, 0x2d, 0x9c, 0x38, 0xd8, 0x4e, 0xbb, 0x4e, 0x88, 0xd7, 0xc0, 0xcb, 0x40,
-	0x9c, 0x79, 0x11, 0x13, 0xa7, 0x1d, 0x39, 0x01, 0x6a, 0xdf, 0x05, 0x27, 0x94, 0xbf, 0x8d, 0x44,
-	0x94, 0x9b, 0xfd, 0xf8, 0xf3, 0x7d, 0x92, 0x8f, 0x1f, 0x19, 0x3c, 0xb8, 0xc2, 0xbe, 0xed, 0xb3,
-	0x30, 0x24, 0x52, 0x22, 0x64, 0x2f, 0x46, 0x1e, 0x92, 0x70, 0x64, 0xc7, 0x88, 0x87, 0x44, 0x08,
-	0xc2, 0x22, 0x61, 0xc5, 0x9c, 0x49, 0xa6, 0xdd, 0xbd, 0xc2, 0xbe, 0x55, 0x81, 0x56, 0x01, 0x1a,
-	0x47, 0x3e, 0x13, 0x21, 0x13, 0x6e, 0x06, 0xd9, 0xf9, 0x26, 0x4f, 0x18, 0x5d, 0xcc, 0x30, 0xcb,
-	0xeb, 0xe9, 0x2a, 0xaf, 0x0e, 0x7a, 0xe0, 0xce, 0x0b, 0x16, 0xcc, 0xaa, 0xfe, 0x67, 0x7b, 0xdf,
-	0xbf, 0x9d, 0x80, 0xed, 0x7e, 0xf0, 0x08, 0x1c, 0x5d, 0xb0, 0x77, 0x72, 0x09, 0x39, 0x7a, 0x1d,
-	0x63, 0x0e, 0x03, 0xd4, 0x02, 0xf7, 0xc1, 0xde, 0x2b, 0x74, 0x29, 0x5b, 0x88, 0x11, 0xe8, 0x4d,
-	0x59, 0x18, 0x26, 0x11, 0x91, 0xab, 0xe9, 0xf9, 0xcc, 0x41, 0x31, 0x5c, 0x9d, 0x23, 0xaf, 0x2d,
-	0x72, 0x06, 0x86, 0xf5, 0xc8, 0x1b, 0x22, 0xe7, 0x01, 0x87, 0xcb, 0x29, 0xa3, 0x14, 0x4a, 0xc4,
-	0x21, 0x6d, 0xc9, 0x3e, 0x03, 0xf7, 0xab, 0xec, 0x8c, 0x31, 0xfa, 0x12, 0x45, 0x41, 0xd9, 0xa0,
-	0x25, 0xf6, 0x45, 0x01, 0x87, 0x33, 0xc8, 0x61, 0x28, 0xa6, 0x73, 0x18, 0xe1, 0x9a, 0xb2, 0xf6,
-	0x09, 0x1c, 0x42, 0x4a, 0xd9, 0x12, 0x05, 0x6e, 0x9c, 0x11, 0xae, 0x9f, 0x21, 0x42, 0x57, 0xfa,
-	0xea, 0xf0, 0xf6, 0x93, 0x87, 0x56, 0xe3, 0x64, 0xac, 0x71, 0x1e, 0xaa, 0x77, 0x9d, 0x1c, 0x5f,
-	0xff, 0xec, 0x75, 0xbe, 0xfe, 0xea, 0x75, 0x1b, 0x0e, 0x85, 0xd3, 0x85, 0x0d, 0xd5, 0x7f, 0x7e,
-	0xf5, 0x8f, 0x02, 0x0e, 0x1a, 0xe2, 0x9a, 0x01, 0x6e, 0x89, 0xc4, 0x13, 0x31, 0xf4, 0x91, 0xae,
-	0xf4, 0x95, 0xe1, 0xae, 0x53, 0xed, 0xb5, 0x7d, 0xa0, 0xbe, 0x47, 0x2b, 0xfd, 0xbf, 0xac, 0x9c,
-	0x2e, 0xb5, 0x31, 0xb8, 0x27, 0x48, 0x84, 0x29, 0x72, 0x45, 0xe2, 0x65, 0x5e, 0x6e, 0x69, 0x09,
-	0xa5, 0xe4, 0x42, 0x57, 0xfb, 0xea, 0x70, 0xd7, 0x31, 0x72, 0xe8, 0xa2, 0x60, 0x8a, 0xef, 0x8e,
-	0x53, 0x42, 0xe3, 0xe0, 0x38, 0x4c, 0xa8, 0x24, 0x55, 0x07, 0xe1, 0x72, 0xf4, 0x21, 0x21, 0x1c,
-	0x85, 0x28, 0x92, 0x42, 0xdf, 0x69, 0xbd, 0x9e, 0xb2, 0xa5, 0xb3, 0x8d, 0x4c, 0x76, 0xd2, 0xeb,
-	0x71, 0x8c, 0xac, 0x6b, 0x79, 0x2e, 0x6a, 0x80, 0x18, 0x7c, 0x04, 0x07, 0x0d, 0xc1, 0xd2, 0x4f,
-	0xd9, 0xfa, 0xed, 0x03, 0x75, 0x01, 0x69, 0x69, 0xbc, 0x80, 0x34, 0x35, 0x2e, 0x0d, 0xb7, 0xca,
-	0x52, 0xf2, 0x6a, 0x9c, 0x85, 0x71, 0x01, 0x55, 0xca, 0x52, 0xf2, 0x62, 0x14, 0x93, 0xe7, 0xd7,
-	0x6b, 0x53, 0xb9, 0x59, 0x9b, 0xca, 0xef, 0xb5, 0xa9, 0x7c, 0xde, 0x98, 0x9d, 0x9b, 0x8d, 0xd9,
-	0xf9, 0xb1, 0x31, 0x3b, 0x6f, 0x1f, 0x63, 0x22, 0xe7, 0x89, 0x97, 0x7a, 0xda, 0xa7, 0x98, 0x42,
-	0x4f, 0xd8, 0xa7, 0xf8, 0xc4, 0x9f, 0x43, 0x12, 0xd9, 0x97, 0xb5, 0xf7, 0x2d, 0x57, 0x31, 0x12,
-	0xde, 0xff, 0xd9, 0x53, 0x7c, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0x11, 0x7e, 0x2f, 0x02, 0xfd,
-	0x03, 0x00, 0x00,
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcf, 0x4f, 0x13, 0x41,
+	0x18, 0xed, 0x52, 0x54, 0xf8, 0x10, 0x82, 0xdb, 0x4a, 0x4a, 0x83, 0x6d, 0xad, 0x07, 0x1b, 0x95,
+	0x2e, 0x68, 0xbc, 0x70, 0x31, 0xb4, 0x04, 0x2f, 0x92, 0x34, 0x8b, 0xc4, 0xe8, 0x65, 0x33, 0xbb,
+	0x1d, 0xa6, 0x1b, 0x67, 0x77, 0xd6, 0x99, 0xd9, 0x42, 0x89, 0xf1, 0xec, 0xd1, 0x3f, 0xc3, 0x78,
+	0xf6, 0x8f, 0x20, 0x9e, 0x38, 0x7a, 0x52, 0x03, 0xff, 0x85, 0x27, 0xb3, 0xbf, 0x8b, 0xdd, 0xec,
+	0x6d, 0xbe, 0x6f, 0xdf, 0x7b, 0xf3, 0xde, 0x37, 0xb3, 0x03, 0x0f, 0xcf, 0x88, 0xa5, 0x59, 0xcc,
+	0x71, 0x6c, 0x29, 0x31, 0xd6, 0xc6, 0xdb, 0x26, 0x96, 0x68, 0x5b, 0xf3, 0x30, 0x77, 0x6c, 0x21,
+	0x6c, 0xe6, 0x8a, 0xae, 0xc7, 0x99, 0x64, 0xea, 0xdd, 0x33, 0x62, 0x75, 0x53, 0x60, 0x37, 0x06,
+	0xd6, 0xd7, 0x2d, 0x26, 0x1c, 0x26, 0x8c, 0x10, 0xa4, 0x45, 0x45, 0xc4, 0xa8, 0x57, 0x09, 0x23,
+	0x2c, 0xea, 0x07, 0xab, 0xa8, 0xdb, 0x6e, 0xc2, 0xf2, 0x4b, 0x36, 0x1c, 0xa4, 0xfa, 0x3b, 0x2b,
+	0x3f, 0xbe, 0x6f, 0x42, 0x56, 0xb7, 0x1f, 0xc3, 0xfa, 0x21, 0x3b, 0x96, 0x27, 0x88, 0xe3, 0x23,
+	0x8f, 0x70, 0x34, 0xc4, 0x05, 0xe0, 0x16, 0xac, 0xbc, 0xc6, 0xa7, 0xb2, 0x00, 0xb1, 0x0d, 0xcd,
+	0x3e, 0x73, 0x1c, 0xdf, 0xb5, 0xe5, 0xa4, 0xbf, 0x37, 0xd0, 0xb1, 0x87, 0x26, 0x7b, 0xd8, 0x2c,
+	0xa2, 0xec, 0x40, 0x67, 0x9a, 0xf2, 0xc6, 0x96, 0xa3, 0x21, 0x47, 0x27, 0x7d, 0x46, 0x29, 0x92,
+	0x98, 0x23, 0x5a, 0xc0, 0x7d, 0x0e, 0x0f, 0x52, 0xee, 0x80, 0x31, 0xfa, 0x0a, 0xbb, 0xc3, 0x44,
+	0xa0, 0x80, 0xf6, 0x55, 0x81, 0xb5, 0x01, 0xe2, 0xc8, 0x11, 0xfd, 0x11, 0x72, 0xc9, 0x54, 0x64,
+	0xf5, 0x13, 0xac, 0x21, 0x4a, 0xd9, 0x09, 0x1e, 0x1a, 0x5e, 0x88, 0x30, 0xac, 0x10, 0x22, 0x6a,
+	0x4a, 0xab, 0xdc, 0x59, 0x7a, 0xfa, 0xa8, 0x9b, 0x7b, 0x32, 0xdd, 0xdd, 0x88, 0x34, 0xad, 0xda,
+	0xdb, 0x38, 0xff, 0xd5, 0x2c, 0x7d, 0xfb, 0xdd, 0xac, 0xe6, 0x7c, 0x14, 0x7a, 0x15, 0xe5, 0x74,
+	0x67, 0xac, 0x9e, 0xc1, 0xf2, 0x81, 0x20, 0x53, 0x06, 0xdf, 0xc2, 0xed, 0xc4, 0xa0, 0x23, 0x48,
+	0x62, 0xeb, 0x7e, 0xb1, 0xad, 0x03, 0x41, 0x7a, 0x95, 0xd8, 0xcd, 0x52, 0xd6, 0x13, 0xfa, 0x12,
+	0xca, 0x8a, 0x99, 0xbd, 0x3f, 0x2b, 0x00, 0x19, 0x58, 0x5d, 0x87, 0x05, 0x39, 0xf1, 0xb0, 0xe1,
+	0x73, 0x5a, 0x53, 0x5a, 0x4a, 0x67, 0x51, 0xbf, 0x15, 0xd4, 0x47, 0x9c, 0xaa, 0x06, 0xa8, 0xc7,
+	0x36, 0xa6, 0x43, 0x83, 0xe3, 0x0f, 0xbe, 0xcd, 0xb1, 0x83, 0x5d, 0x29, 0x6a, 0x73, 0x85, 0x13,
+	0x3b, 0x10, 0x64, 0x3f, 0xe0, 0xe8, 0x19, 0xa5, 0x37, 0x1f, 0x78, 0xd4, 0xef, 0x1c, 0xff, 0xd7,
+	0x17, 0xed, 0x17, 0x50, 0xc9, 0xc1, 0xab, 0x2a, 0xcc, 0x7b, 0x48, 0x8e, 0x62, 0x3b, 0xe1, 0x5a,
+	0xad, 0xc2, 0x8d, 0x31, 0xa2, 0x3e, 0xae, 0xcd, 0x85, 0xcd, 0xa8, 0x68, 0xff, 0x55, 0xa0, 0x92,
+	0x73, 0x0c, 0x6a, 0x1d, 0x16, 0x84, 0x6f, 0x0a, 0x0f, 0x59, 0x38, 0x56, 0x49, 0x6b, 0x75, 0x15,
+	0xca, 0xef, 0xf1, 0x24, 0xd6, 0x09, 0x96, 0xea, 0x2e, 0xdc, 0x13, 0xb6, 0x4b, 0x28, 0x36, 0x84,
+	0x6f, 0x86, 0xf7, 0xc3, 0x48, 0x0e, 0x03, 0x49, 0xc9, 0x45, 0xad, 0xdc, 0x2a, 0x77, 0x16, 0xf5,
+	0x7a, 0x04, 0x3a, 0x8c, 0x31, 0xf1, 0xbe, 0xbb, 0x01, 0x42, 0xe5, 0xb0, 0xe1, 0xf8, 0x54, 0xda,
+	0xa9, 0x82, 0xb8, 0x3e, 0xb4, 0xf9, 0xc2, 0xa1, 0x25, 0x92, 0xb3, 0x43, 0xab, 0x87, 0xaa, 0xc9,
+	0x77, 0x71, 0x6d, 0x7a, 0x1f, 0xa1, 0x92, 0x43, 0x4c, 0xf2, 0x29, 0x59, 0xbe, 0x55, 0x28, 0x8f,
+	0x11, 0x4d, 0x12, 0x8f, 0x11, 0x0d, 0x12, 0x27, 0x09, 0xb3, 0xc8, 0x52, 0xf2, 0xf4, 0xb7, 0x88,
+	0x13, 0xc7, 0xa0, 0x34, 0xb2, 0x94, 0x3c, 0xbe, 0xd2, 0xbd, 0xfd, 0xf3, 0xcb, 0x86, 0x72, 0x71,
+	0xd9, 0x50, 0xfe, 0x5c, 0x36, 0x94, 0x2f, 0x57, 0x8d, 0xd2, 0xc5, 0x55, 0xa3, 0xf4, 0xf3, 0xaa,
+	0x51, 0x7a, 0xf7, 0x84, 0xd8, 0x72, 0xe4, 0x9b, 0x41, 0x4e, 0x6d, 0x8b, 0x50, 0x64, 0x0a, 0x6d,
+	0x8b, 0x6c, 0x5a, 0x23, 0x64, 0xbb, 0xda, 0xe9, 0xd4, 0x3b, 0x19, 0x5c, 0x33, 0x61, 0xde, 0x0c,
+	0x9f, 0xb4, 0x67, 0xff, 0x06, 0x00, 0x3e, 0xbd, 0xb3, 0xcf, 0x45, 0x05, 0x00, 0x00,
 }
 
 func (m *GodPermission) Marshal() (dAtA []byte, err error) {
@@ -658,6 +822,124 @@ func (m *ParamsChangePermission) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMsgs) > 0 {
+		for iNdEx := len(m.AllowedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FieldRequirements) > 0 {
+		for iNdEx := len(m.FieldRequirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FieldRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFieldRequirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFieldRequirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFieldRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AllowedParamsChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -844,6 +1126,57 @@ func (m *ParamsChangePermission) Size() (n int) {
 	return n
 }
 
+func (m *MsgPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMsgs) > 0 {
+		for _, e := range m.AllowedMsgs {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.FieldRequirements) > 0 {
+		for _, e := range m.FieldRequirements {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFieldRequirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	return n
+}
+
 func (m *AllowedParamsChange) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1286,6 +1619,320 @@ func (m *ParamsChangePermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgs = append(m.AllowedMsgs, AllowedMsg{})
+			if err := m.AllowedMsgs[len(m.AllowedMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldRequirements = append(m.FieldRequirements, MsgFieldRequirement{})
+			if err := m.FieldRequirements[len(m.FieldRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFieldRequirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFieldRequirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFieldRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AllowedParamsChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
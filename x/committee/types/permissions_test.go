@@ -7,6 +7,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
@@ -179,6 +180,102 @@ func TestParamsChangePermission_SimpleParamsChange_Allows(t *testing.T) {
 	}
 }
 
+func TestMsgPermission_Allows(t *testing.T) {
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("ua0gi", amount)))
+	}
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testcases := []struct {
+		name          string
+		allowedMsgs   types.AllowedMsgs
+		pubProposal   types.PubProposal
+		expectAllowed bool
+	}{
+		{
+			name:          "allowed type",
+			allowedMsgs:   types.AllowedMsgs{{TypeUrl: sendTypeURL}},
+			pubProposal:   newTestExecuteMsgsProposal(send(1), send(2)),
+			expectAllowed: true,
+		},
+		{
+			name:          "not allowed type",
+			allowedMsgs:   types.AllowedMsgs{{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}},
+			pubProposal:   newTestExecuteMsgsProposal(send(1)),
+			expectAllowed: false,
+		},
+		{
+			name: "field requirements met",
+			allowedMsgs: types.AllowedMsgs{{
+				TypeUrl: sendTypeURL,
+				FieldRequirements: []types.MsgFieldRequirement{
+					{Path: "to_address", Value: `"` + to.String() + `"`},
+					{Path: "amount", Value: `[{"denom": "ua0gi", "amount": "1"}]`},
+				},
+			}},
+			pubProposal:   newTestExecuteMsgsProposal(send(1)),
+			expectAllowed: true,
+		},
+		{
+			name: "field requirements not met",
+			allowedMsgs: types.AllowedMsgs{{
+				TypeUrl:           sendTypeURL,
+				FieldRequirements: []types.MsgFieldRequirement{{Path: "amount", Value: `[{"denom": "ua0gi", "amount": "1"}]`}},
+			}},
+			pubProposal:   newTestExecuteMsgsProposal(send(1), send(2)),
+			expectAllowed: false,
+		},
+		{
+			name: "any allowed msg of the type",
+			allowedMsgs: types.AllowedMsgs{
+				{TypeUrl: sendTypeURL, FieldRequirements: []types.MsgFieldRequirement{{Path: "amount", Value: `[{"denom": "ua0gi", "amount": "1"}]`}}},
+				{TypeUrl: sendTypeURL, FieldRequirements: []types.MsgFieldRequirement{{Path: "amount", Value: `[{"denom": "ua0gi", "amount": "2"}]`}}},
+			},
+			pubProposal:   newTestExecuteMsgsProposal(send(1), send(2)),
+			expectAllowed: true,
+		},
+		{
+			name: "unknown field",
+			allowedMsgs: types.AllowedMsgs{{
+				TypeUrl:           sendTypeURL,
+				FieldRequirements: []types.MsgFieldRequirement{{Path: "amount.denom", Value: `"ua0gi"`}},
+			}},
+			pubProposal:   newTestExecuteMsgsProposal(send(1)),
+			expectAllowed: false,
+		},
+		{
+			name:          "not an execute msgs proposal",
+			allowedMsgs:   types.AllowedMsgs{{TypeUrl: sendTypeURL}},
+			pubProposal:   govv1beta1.NewTextProposal("A Title", "A description for this proposal."),
+			expectAllowed: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			permission := types.MsgPermission{AllowedMsgs: tc.allowedMsgs}
+			require.Equal(t, tc.expectAllowed, permission.Allows(sdk.Context{}, nil, tc.pubProposal))
+		})
+	}
+}
+
+func TestGodPermission_Allows(t *testing.T) {
+	send := banktypes.NewMsgSend(sdk.AccAddress("from________________"), sdk.AccAddress("to__________________"), sdk.NewCoins(sdk.NewInt64Coin("ua0gi", 1)))
+	permission := types.GodPermission{}
+	require.True(t, permission.Allows(sdk.Context{}, nil, govv1beta1.NewTextProposal("A Title", "A description for this proposal.")))
+	require.False(t, permission.Allows(sdk.Context{}, nil, newTestExecuteMsgsProposal(send)))
+}
+
+func newTestExecuteMsgsProposal(msgs ...sdk.Msg) types.PubProposal {
+	proposal := types.MustNewExecuteMsgsProposal(
+		"A Title",
+		"A description for this proposal.",
+		msgs,
+	)
+	return &proposal
+}
+
 func newTestParamsChangeProposalWithChanges(changes []paramsproposal.ParamChange) types.PubProposal {
 	return paramsproposal.NewParameterChangeProposal(
 		"A Title",
@@ -105,9 +105,50 @@ func (m *CommitteeDeleteProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommitteeDeleteProposal proto.InternalMessageInfo
 
+// ExecuteMsgsProposal is a committee proposal executing messages with the committee authority as the signer.
+type ExecuteMsgsProposal struct {
+	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Messages    []*types.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *ExecuteMsgsProposal) Reset()         { *m = ExecuteMsgsProposal{} }
+func (m *ExecuteMsgsProposal) String() string { return proto.CompactTextString(m) }
+func (*ExecuteMsgsProposal) ProtoMessage()    {}
+func (*ExecuteMsgsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_120f043c81d2fa1b, []int{2}
+}
+func (m *ExecuteMsgsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteMsgsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteMsgsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecuteMsgsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteMsgsProposal.Merge(m, src)
+}
+func (m *ExecuteMsgsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteMsgsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteMsgsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteMsgsProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*CommitteeChangeProposal)(nil), "zgc.committee.v1beta1.CommitteeChangeProposal")
 	proto.RegisterType((*CommitteeDeleteProposal)(nil), "zgc.committee.v1beta1.CommitteeDeleteProposal")
+	proto.RegisterType((*ExecuteMsgsProposal)(nil), "zgc.committee.v1beta1.ExecuteMsgsProposal")
 }
 
 func init() {
@@ -115,29 +156,33 @@ func init() {
 }
 
 var fileDescriptor_120f043c81d2fa1b = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x3f, 0x6e, 0xc2, 0x30,
-	0x14, 0xc6, 0xe3, 0xfe, 0x93, 0x48, 0x40, 0x95, 0x22, 0xaa, 0x02, 0x83, 0x8b, 0x50, 0x07, 0x86,
-	0x62, 0x03, 0xdd, 0xba, 0x15, 0x18, 0x4a, 0xa7, 0x8a, 0xb1, 0x0b, 0x4a, 0x82, 0x6b, 0x2c, 0x05,
-	0xbf, 0x88, 0x18, 0x28, 0x9c, 0xa2, 0x97, 0xe8, 0x0d, 0xd8, 0x7a, 0x01, 0xc4, 0xc4, 0xd8, 0xa9,
-	0x6a, 0xc3, 0x45, 0x2a, 0x92, 0x60, 0xb1, 0x75, 0xe8, 0xe6, 0xef, 0x7b, 0x9f, 0xf5, 0x7e, 0x7e,
-	0x7e, 0xe6, 0xf5, 0x82, 0x7b, 0xd4, 0x83, 0xd1, 0x48, 0x28, 0xc5, 0x18, 0x9d, 0x36, 0x5c, 0xa6,
-	0x9c, 0x06, 0x0d, 0xc6, 0x10, 0x40, 0xe8, 0xf8, 0x24, 0x18, 0x83, 0x02, 0xfb, 0x62, 0xc1, 0x3d,
-	0xa2, 0x53, 0x24, 0x4d, 0x95, 0x8a, 0x1e, 0x84, 0x23, 0x08, 0xfb, 0x71, 0x88, 0x26, 0x22, 0xb9,
-	0x51, 0xca, 0x73, 0xe0, 0x90, 0xf8, 0xbb, 0x53, 0xea, 0x16, 0x39, 0x00, 0xf7, 0x19, 0x8d, 0x95,
-	0x3b, 0x79, 0xa1, 0x8e, 0x9c, 0x27, 0xa5, 0xca, 0x07, 0x32, 0x2f, 0xdb, 0xfb, 0x0e, 0xed, 0xa1,
-	0x23, 0x39, 0x7b, 0x4a, 0x21, 0xec, 0xbc, 0x79, 0xaa, 0x84, 0xf2, 0x59, 0x01, 0x95, 0x51, 0x35,
-	0xd3, 0x4b, 0x84, 0x5d, 0x36, 0xad, 0x01, 0x0b, 0xbd, 0xb1, 0x08, 0x94, 0x00, 0x59, 0x38, 0x8a,
-	0x6b, 0x87, 0x96, 0xfd, 0x60, 0xe6, 0x24, 0x9b, 0xf5, 0x35, 0x78, 0xe1, 0xb8, 0x8c, 0xaa, 0x56,
-	0x33, 0x4f, 0x12, 0x0c, 0xb2, 0xc7, 0x20, 0xf7, 0x72, 0xde, 0xca, 0xad, 0x97, 0xb5, 0x8c, 0x26,
-	0xe8, 0x65, 0x25, 0x9b, 0x69, 0x75, 0x87, 0xd7, 0xcb, 0x5a, 0x29, 0x7d, 0x20, 0x87, 0xe9, 0x7e,
-	0x02, 0xa4, 0x0d, 0x52, 0x31, 0xa9, 0x2a, 0xef, 0x87, 0xf4, 0x1d, 0xe6, 0x33, 0xf5, 0x7f, 0xfa,
-	0xa6, 0x99, 0xd5, 0xe4, 0x7d, 0x31, 0x88, 0xe1, 0x4f, 0x5a, 0xe7, 0xd1, 0xd7, 0x95, 0xa5, 0x5b,
-	0x75, 0x3b, 0x3d, 0x4b, 0x87, 0xba, 0x83, 0xbf, 0x38, 0x5b, 0x8f, 0xab, 0x1f, 0x6c, 0xac, 0x22,
-	0x8c, 0x36, 0x11, 0x46, 0xdf, 0x11, 0x46, 0x6f, 0x5b, 0x6c, 0x6c, 0xb6, 0xd8, 0xf8, 0xdc, 0x62,
-	0xe3, 0xf9, 0x86, 0x0b, 0x35, 0x9c, 0xb8, 0xbb, 0x9f, 0xa6, 0x75, 0xee, 0x3b, 0x6e, 0x48, 0xeb,
-	0xbc, 0xe6, 0x0d, 0x1d, 0x21, 0xe9, 0xeb, 0xc1, 0x96, 0xa8, 0x79, 0xc0, 0x42, 0xf7, 0x2c, 0x1e,
-	0xdf, 0xed, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x1b, 0x11, 0x1c, 0x43, 0x02, 0x00, 0x00,
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x63, 0x0a, 0x88, 0x39, 0x9b, 0x90, 0x42, 0xd1, 0xb2, 0x22, 0x99, 0x68, 0xe2, 0xb0,
+	0x03, 0xb1, 0xb7, 0x71, 0xe3, 0x46, 0x3b, 0x24, 0x86, 0x54, 0x09, 0xe5, 0xc8, 0xa5, 0x72, 0xd2,
+	0x0f, 0x37, 0x52, 0x62, 0x47, 0xb5, 0xbb, 0xad, 0x7b, 0x0a, 0x5e, 0x82, 0x37, 0xe8, 0x0d, 0x1e,
+	0xa0, 0xea, 0xa9, 0x47, 0x4e, 0x08, 0xd2, 0x17, 0x41, 0x4d, 0x52, 0xab, 0x97, 0xa9, 0x87, 0xde,
+	0xfc, 0xff, 0xbe, 0xbf, 0xfd, 0xfd, 0xfc, 0xe9, 0x8f, 0xdf, 0xdc, 0x8b, 0x84, 0x25, 0x2a, 0xcf,
+	0x53, 0x63, 0x00, 0xd8, 0xcd, 0x45, 0x0c, 0x86, 0x5f, 0xb0, 0x62, 0xac, 0x0a, 0xa5, 0x79, 0x46,
+	0x8b, 0xb1, 0x32, 0xca, 0x7b, 0x79, 0x2f, 0x12, 0x6a, 0x5d, 0xb4, 0x71, 0x75, 0x4e, 0x12, 0xa5,
+	0x73, 0xa5, 0x07, 0x95, 0x89, 0xd5, 0xa2, 0xbe, 0xd1, 0x69, 0x0b, 0x25, 0x54, 0x5d, 0x5f, 0x9f,
+	0x9a, 0xea, 0x89, 0x50, 0x4a, 0x64, 0xc0, 0x2a, 0x15, 0x4f, 0xbe, 0x31, 0x2e, 0xa7, 0x75, 0xeb,
+	0xf4, 0x27, 0xc2, 0xc7, 0xbd, 0xcd, 0x84, 0xde, 0x88, 0x4b, 0x01, 0x5f, 0x1a, 0x08, 0xaf, 0x8d,
+	0x9f, 0x98, 0xd4, 0x64, 0xe0, 0xa3, 0x00, 0x9d, 0x1d, 0x44, 0xb5, 0xf0, 0x02, 0xec, 0x0e, 0x41,
+	0x27, 0xe3, 0xb4, 0x30, 0xa9, 0x92, 0xfe, 0xa3, 0xaa, 0xb7, 0x5d, 0xf2, 0x3e, 0xe1, 0x23, 0x09,
+	0xb7, 0x03, 0x0b, 0xee, 0xb7, 0x02, 0x74, 0xe6, 0x5e, 0xb6, 0x69, 0x8d, 0x41, 0x37, 0x18, 0xf4,
+	0x83, 0x9c, 0x76, 0x8f, 0x16, 0xb3, 0xf0, 0xc0, 0x12, 0x44, 0x87, 0x12, 0x6e, 0xad, 0x7a, 0x4f,
+	0x16, 0xb3, 0xb0, 0xd3, 0x7c, 0x50, 0xa8, 0x9b, 0xcd, 0x06, 0x68, 0x4f, 0x49, 0x03, 0xd2, 0x9c,
+	0xfe, 0xd8, 0xa6, 0xbf, 0x82, 0x0c, 0xcc, 0xfe, 0xf4, 0x97, 0xf8, 0xd0, 0x92, 0x0f, 0xd2, 0x61,
+	0x05, 0xff, 0xb8, 0xfb, 0xbc, 0xfc, 0xf3, 0xda, 0xb5, 0xa3, 0xae, 0xaf, 0x22, 0xd7, 0x9a, 0xae,
+	0x87, 0x3b, 0x39, 0x7f, 0x21, 0xfc, 0xe2, 0xe3, 0x1d, 0x24, 0x13, 0x03, 0x7d, 0x2d, 0xf4, 0xde,
+	0x8c, 0x7d, 0xfc, 0x2c, 0x07, 0xad, 0xb9, 0x00, 0xed, 0xb7, 0x82, 0xd6, 0x83, 0xcb, 0x7d, 0xb5,
+	0x98, 0x85, 0xc7, 0x0d, 0x57, 0xcc, 0xb5, 0x8d, 0x10, 0xed, 0x6b, 0x11, 0xd9, 0x27, 0x76, 0xe1,
+	0x77, 0x3f, 0xcf, 0xff, 0x11, 0x67, 0x5e, 0x12, 0xb4, 0x2c, 0x09, 0xfa, 0x5b, 0x12, 0xf4, 0x7d,
+	0x45, 0x9c, 0xe5, 0x8a, 0x38, 0xbf, 0x57, 0xc4, 0xf9, 0xfa, 0x56, 0xa4, 0x66, 0x34, 0x89, 0xd7,
+	0x41, 0x65, 0xe7, 0x22, 0xe3, 0xb1, 0x66, 0xe7, 0x22, 0x4c, 0x46, 0x3c, 0x95, 0xec, 0x6e, 0x2b,
+	0xe4, 0x66, 0x5a, 0x80, 0x8e, 0x9f, 0x56, 0x80, 0xef, 0xfe, 0x0f, 0x00, 0x83, 0xd2, 0x6e, 0x8e,
+	0x02, 0x03, 0x00, 0x00,
 }
 
 func (m *CommitteeChangeProposal) Marshal() (dAtA []byte, err error) {
@@ -231,6 +276,57 @@ func (m *CommitteeDeleteProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecuteMsgsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteMsgsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteMsgsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposal(v)
 	base := offset
@@ -283,6 +379,29 @@ func (m *CommitteeDeleteProposal) Size() (n int) {
 	return n
 }
 
+func (m *ExecuteMsgsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovProposal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -572,6 +691,154 @@ func (m *CommitteeDeleteProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExecuteMsgsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteMsgsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteMsgsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -624,6 +624,7 @@ type QueryTallyResponse struct {
 	PossibleVotes github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=possible_votes,json=possibleVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"possible_votes"`
 	VoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold"`
 	Quorum        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum"`
+	AbstainVotes  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=abstain_votes,json=abstainVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"abstain_votes"`
 }
 
 func (m *QueryTallyResponse) Reset()         { *m = QueryTallyResponse{} }
@@ -659,6 +660,237 @@ func (m *QueryTallyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTallyResponse proto.InternalMessageInfo
 
+// QueryClosedProposalsRequest defines the request type for querying x/committee closed proposals.
+type QueryClosedProposalsRequest struct {
+	// committee_id filters the closed proposals of a committee, zero queries the closed proposals of all committees.
+	CommitteeId uint64             `protobuf:"varint,1,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClosedProposalsRequest) Reset()         { *m = QueryClosedProposalsRequest{} }
+func (m *QueryClosedProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClosedProposalsRequest) ProtoMessage()    {}
+func (*QueryClosedProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c24238147f1ffb, []int{16}
+}
+func (m *QueryClosedProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClosedProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClosedProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClosedProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClosedProposalsRequest.Merge(m, src)
+}
+func (m *QueryClosedProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClosedProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClosedProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClosedProposalsRequest proto.InternalMessageInfo
+
+// QueryClosedProposalsResponse defines the response type for querying x/committee closed proposals.
+type QueryClosedProposalsResponse struct {
+	ClosedProposals []ClosedProposal `protobuf:"bytes,1,rep,name=closed_proposals,json=closedProposals,proto3" json:"closed_proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClosedProposalsResponse) Reset()         { *m = QueryClosedProposalsResponse{} }
+func (m *QueryClosedProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClosedProposalsResponse) ProtoMessage()    {}
+func (*QueryClosedProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c24238147f1ffb, []int{17}
+}
+func (m *QueryClosedProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClosedProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClosedProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClosedProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClosedProposalsResponse.Merge(m, src)
+}
+func (m *QueryClosedProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClosedProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClosedProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClosedProposalsResponse proto.InternalMessageInfo
+
+// QueryClosedProposalRequest defines the request type for querying x/committee closed proposal.
+type QueryClosedProposalRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryClosedProposalRequest) Reset()         { *m = QueryClosedProposalRequest{} }
+func (m *QueryClosedProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClosedProposalRequest) ProtoMessage()    {}
+func (*QueryClosedProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c24238147f1ffb, []int{18}
+}
+func (m *QueryClosedProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClosedProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClosedProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClosedProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClosedProposalRequest.Merge(m, src)
+}
+func (m *QueryClosedProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClosedProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClosedProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClosedProposalRequest proto.InternalMessageInfo
+
+// QueryClosedProposalResponse defines the response type for querying x/committee closed proposal.
+type QueryClosedProposalResponse struct {
+	ClosedProposal ClosedProposal `protobuf:"bytes,1,opt,name=closed_proposal,json=closedProposal,proto3" json:"closed_proposal"`
+}
+
+func (m *QueryClosedProposalResponse) Reset()         { *m = QueryClosedProposalResponse{} }
+func (m *QueryClosedProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClosedProposalResponse) ProtoMessage()    {}
+func (*QueryClosedProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c24238147f1ffb, []int{19}
+}
+func (m *QueryClosedProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClosedProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClosedProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClosedProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClosedProposalResponse.Merge(m, src)
+}
+func (m *QueryClosedProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClosedProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClosedProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClosedProposalResponse proto.InternalMessageInfo
+
+// QueryParamsRequest defines the request type for querying x/committee params.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c24238147f1ffb, []int{20}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse defines the response type for querying x/committee params.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c24238147f1ffb, []int{21}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
 // QueryRawParamsRequest defines the request type for querying x/committee raw params.
 type QueryRawParamsRequest struct {
 	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
@@ -669,7 +901,7 @@ func (m *QueryRawParamsRequest) Reset()         { *m = QueryRawParamsRequest{} }
 func (m *QueryRawParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRawParamsRequest) ProtoMessage()    {}
 func (*QueryRawParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32c24238147f1ffb, []int{16}
+	return fileDescriptor_32c24238147f1ffb, []int{22}
 }
 func (m *QueryRawParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +939,7 @@ func (m *QueryRawParamsResponse) Reset()         { *m = QueryRawParamsResponse{}
 func (m *QueryRawParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRawParamsResponse) ProtoMessage()    {}
 func (*QueryRawParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32c24238147f1ffb, []int{17}
+	return fileDescriptor_32c24238147f1ffb, []int{23}
 }
 func (m *QueryRawParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,6 +985,12 @@ func init() {
 	proto.RegisterType((*QueryVoteResponse)(nil), "zgc.committee.v1beta1.QueryVoteResponse")
 	proto.RegisterType((*QueryTallyRequest)(nil), "zgc.committee.v1beta1.QueryTallyRequest")
 	proto.RegisterType((*QueryTallyResponse)(nil), "zgc.committee.v1beta1.QueryTallyResponse")
+	proto.RegisterType((*QueryClosedProposalsRequest)(nil), "zgc.committee.v1beta1.QueryClosedProposalsRequest")
+	proto.RegisterType((*QueryClosedProposalsResponse)(nil), "zgc.committee.v1beta1.QueryClosedProposalsResponse")
+	proto.RegisterType((*QueryClosedProposalRequest)(nil), "zgc.committee.v1beta1.QueryClosedProposalRequest")
+	proto.RegisterType((*QueryClosedProposalResponse)(nil), "zgc.committee.v1beta1.QueryClosedProposalResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "zgc.committee.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "zgc.committee.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryRawParamsRequest)(nil), "zgc.committee.v1beta1.QueryRawParamsRequest")
 	proto.RegisterType((*QueryRawParamsResponse)(nil), "zgc.committee.v1beta1.QueryRawParamsResponse")
 }
@@ -760,84 +998,96 @@ func init() {
 func init() { proto.RegisterFile("zgc/committee/v1beta1/query.proto", fileDescriptor_32c24238147f1ffb) }
 
 var fileDescriptor_32c24238147f1ffb = []byte{
-	// 1218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xc1, 0x6f, 0xe3, 0xc4,
-	0x17, 0xc7, 0xeb, 0x34, 0xed, 0x26, 0x2f, 0x6d, 0x7f, 0xfd, 0x8d, 0xba, 0x4b, 0x1a, 0x56, 0x49,
-	0x6b, 0xa4, 0xb6, 0x40, 0x63, 0xb7, 0x29, 0xab, 0x95, 0xd8, 0x45, 0x62, 0xd3, 0xb0, 0x28, 0x20,
-	0xa1, 0x62, 0x0a, 0x07, 0x56, 0x22, 0x9a, 0xc4, 0xb3, 0xae, 0xd9, 0xc4, 0x76, 0x3d, 0x4e, 0xdb,
-	0x6c, 0xe9, 0x85, 0x3b, 0x68, 0x01, 0x21, 0x81, 0x84, 0x90, 0x10, 0x07, 0xf8, 0x03, 0xf6, 0x8f,
-	0xa8, 0xf6, 0xb4, 0x12, 0x17, 0xc4, 0x21, 0x40, 0xca, 0x1f, 0x82, 0x3c, 0x1e, 0x4f, 0xdc, 0x24,
-	0x24, 0x69, 0x4e, 0x89, 0xed, 0xf7, 0xbe, 0xf3, 0x79, 0x6f, 0x66, 0xde, 0x7b, 0xb0, 0xfa, 0xd8,
-	0xa8, 0xa9, 0x35, 0xbb, 0xd1, 0x30, 0x3d, 0x8f, 0x10, 0xf5, 0x68, 0xbb, 0x4a, 0x3c, 0xbc, 0xad,
-	0x1e, 0x36, 0x89, 0xdb, 0x52, 0x1c, 0xd7, 0xf6, 0x6c, 0x74, 0xfd, 0xb1, 0x51, 0x53, 0x84, 0x89,
-	0xc2, 0x4d, 0x32, 0xaf, 0xd4, 0x6c, 0xda, 0xb0, 0xa9, 0x5a, 0xc5, 0x94, 0x04, 0xf6, 0xc2, 0xdb,
-	0xc1, 0x86, 0x69, 0x61, 0xcf, 0xb4, 0xad, 0x40, 0x22, 0xb3, 0x1c, 0xd8, 0x56, 0xd8, 0x93, 0x1a,
-	0x3c, 0xf0, 0x4f, 0x4b, 0x86, 0x6d, 0xd8, 0xc1, 0x7b, 0xff, 0x1f, 0x7f, 0x7b, 0xd3, 0xb0, 0x6d,
-	0xa3, 0x4e, 0x54, 0xec, 0x98, 0x2a, 0xb6, 0x2c, 0xdb, 0x63, 0x6a, 0xa1, 0xcf, 0x32, 0xff, 0xca,
-	0x9e, 0xaa, 0xcd, 0x87, 0x2a, 0xb6, 0x38, 0x6c, 0x26, 0xd7, 0xfb, 0xc9, 0x33, 0x1b, 0x84, 0x7a,
-	0xb8, 0xe1, 0x70, 0x83, 0x97, 0x06, 0x07, 0x6c, 0x10, 0x8b, 0x50, 0x93, 0x2f, 0x20, 0xa7, 0xe1,
-	0xc6, 0xfb, 0x7e, 0x44, 0xbb, 0xa1, 0x1d, 0xd5, 0xc8, 0x61, 0x93, 0x50, 0x4f, 0xfe, 0x04, 0x5e,
-	0xe8, 0xfb, 0x42, 0x1d, 0xdb, 0xa2, 0x04, 0xed, 0x02, 0x08, 0x5d, 0x9a, 0x96, 0x56, 0xa6, 0x37,
-	0x52, 0x85, 0x25, 0x25, 0xe0, 0x51, 0x42, 0x1e, 0xe5, 0x9e, 0xd5, 0x2a, 0xce, 0x3f, 0x7b, 0x9a,
-	0x4f, 0x0a, 0x05, 0x2d, 0xe2, 0x26, 0xbf, 0x0e, 0xd7, 0x2f, 0xeb, 0xf3, 0x85, 0xd1, 0x2a, 0xcc,
-	0x09, 0xb3, 0x8a, 0xa9, 0xa7, 0xa5, 0x15, 0x69, 0x23, 0xae, 0xa5, 0xc4, 0xbb, 0xb2, 0x2e, 0x3f,
-	0xe8, 0xa5, 0x16, 0x68, 0xf7, 0x20, 0x29, 0x0c, 0x99, 0xe7, 0x98, 0x64, 0x5d, 0x2f, 0x01, 0xb6,
-	0xe7, 0xda, 0x8e, 0x4d, 0x71, 0x9d, 0x5e, 0x01, 0xec, 0x53, 0x0e, 0x16, 0xf1, 0xe5, 0x60, 0x7b,
-	0x90, 0x74, 0xc2, 0x97, 0x3c, 0x65, 0x9b, 0xca, 0xc0, 0xf3, 0xa6, 0x5c, 0x52, 0x08, 0x05, 0x8a,
-	0xf1, 0xf3, 0x76, 0x6e, 0x4a, 0xeb, 0x8a, 0xc8, 0xb7, 0x61, 0xa9, 0xc7, 0x32, 0xc0, 0xcc, 0x41,
-	0x2a, 0x34, 0xea, 0x52, 0x42, 0xf8, 0xaa, 0xac, 0xcb, 0x5f, 0xc4, 0x7a, 0x22, 0x14, 0x90, 0x0f,
-	0x61, 0xce, 0x69, 0x56, 0x2b, 0xa1, 0xed, 0xd0, 0x04, 0xe6, 0x3b, 0xed, 0x5c, 0x6a, 0xaf, 0x59,
-	0x0d, 0x45, 0x9e, 0x3d, 0xcd, 0x67, 0xf8, 0x79, 0x37, 0xec, 0x23, 0x11, 0xcc, 0xae, 0x6d, 0x79,
-	0xc4, 0xf2, 0xb4, 0x94, 0xd3, 0x35, 0x45, 0x37, 0x20, 0x66, 0xea, 0xe9, 0x98, 0x4f, 0x56, 0x9c,
-	0xed, 0xb4, 0x73, 0xb1, 0x72, 0x49, 0x8b, 0x99, 0x3a, 0x2a, 0xf4, 0x64, 0x78, 0x9a, 0x59, 0xfc,
-	0xcf, 0x5f, 0x49, 0x6c, 0x55, 0xb9, 0x74, 0x29, 0xe5, 0xe8, 0x4d, 0x48, 0xe8, 0x04, 0xeb, 0x75,
-	0xd3, 0x22, 0xe9, 0x38, 0xe3, 0xcd, 0xf4, 0xf1, 0xee, 0x87, 0x57, 0xa3, 0x98, 0xf0, 0xb3, 0xf8,
-	0xe4, 0xcf, 0x9c, 0xa4, 0x09, 0x2f, 0xf9, 0x26, 0x64, 0x58, 0x3a, 0xde, 0x23, 0x27, 0x5e, 0x88,
-	0x58, 0x2e, 0x85, 0xf7, 0xe0, 0x01, 0xbc, 0x38, 0xf0, 0x2b, 0x4f, 0xd9, 0x5d, 0x58, 0xb4, 0xc8,
-	0x89, 0x57, 0xe9, 0x4b, 0x79, 0x11, 0x75, 0xda, 0xb9, 0x85, 0x1e, 0xaf, 0x05, 0x2b, 0xfa, 0xac,
-	0xcb, 0x9f, 0xc1, 0xff, 0x99, 0xf8, 0x47, 0xb6, 0x27, 0x6e, 0xde, 0xc8, 0x0d, 0x44, 0xf7, 0x01,
-	0xba, 0x85, 0x87, 0xa5, 0x31, 0x55, 0x58, 0x53, 0x78, 0xf2, 0xfd, 0x2a, 0xa5, 0x04, 0x55, 0x2d,
-	0xdc, 0x83, 0x3d, 0x6c, 0x84, 0xb7, 0x4b, 0x8b, 0x78, 0xca, 0x3f, 0x4b, 0x80, 0xa2, 0xcb, 0xf3,
-	0x90, 0x4a, 0x30, 0x73, 0xe4, 0xbf, 0xe0, 0xc7, 0x74, 0x63, 0xd8, 0x31, 0xf5, 0x3d, 0x7b, 0x8e,
-	0x68, 0xe0, 0x8c, 0xde, 0x1e, 0x00, 0xb9, 0x3e, 0x12, 0x32, 0x50, 0xba, 0x44, 0x59, 0x86, 0xc5,
-	0xc8, 0x52, 0x63, 0xa6, 0x68, 0x29, 0x88, 0xc1, 0x65, 0x0b, 0x27, 0x03, 0x26, 0x57, 0xfe, 0x4e,
-	0x8a, 0xe4, 0x5b, 0xc4, 0xab, 0x0e, 0x10, 0x2b, 0x2e, 0x74, 0xda, 0x39, 0x88, 0xec, 0xdc, 0x48,
-	0x71, 0x74, 0x17, 0x92, 0xfe, 0x9f, 0x8a, 0xd7, 0x72, 0x08, 0x3b, 0xb9, 0x0b, 0x85, 0xdc, 0x7f,
-	0xa4, 0xce, 0x5f, 0x7e, 0xbf, 0xe5, 0x10, 0x2d, 0x71, 0xc4, 0xff, 0xc9, 0xaf, 0x71, 0xb2, 0x7d,
-	0x5c, 0xaf, 0xb7, 0xc6, 0xbe, 0xca, 0xbf, 0xc6, 0xf9, 0x0e, 0x72, 0xb7, 0x49, 0x23, 0x7a, 0x17,
-	0x92, 0x2d, 0x42, 0x2b, 0xc1, 0xb6, 0xb3, 0xa8, 0x8a, 0x8a, 0xbf, 0x99, 0x7f, 0xb4, 0x73, 0x6b,
-	0x86, 0xe9, 0x1d, 0x34, 0xab, 0x7e, 0x14, 0xbc, 0x9f, 0xf1, 0x9f, 0x3c, 0xd5, 0x1f, 0xa9, 0x7e,
-	0xb0, 0x54, 0x29, 0x91, 0x9a, 0x96, 0x68, 0x11, 0xca, 0xce, 0x11, 0x2a, 0x43, 0xc2, 0xb2, 0xb9,
-	0xd6, 0xf4, 0x44, 0x5a, 0xd7, 0x2c, 0x3b, 0x90, 0xfa, 0x00, 0xe6, 0x6b, 0x4d, 0xd7, 0x25, 0x96,
-	0xc7, 0xf5, 0xe2, 0x13, 0xe9, 0xcd, 0x71, 0x91, 0x40, 0xf4, 0x43, 0x58, 0x70, 0x6c, 0x4a, 0xcd,
-	0x6a, 0x9d, 0x70, 0xd5, 0x99, 0x89, 0x54, 0xe7, 0x43, 0x15, 0x21, 0x1b, 0xec, 0xff, 0x81, 0x4b,
-	0xe8, 0x81, 0x5d, 0xd7, 0xd3, 0xb3, 0x93, 0xc9, 0xb2, 0x33, 0x11, 0x8a, 0xa0, 0xfb, 0x30, 0x7b,
-	0xd8, 0xb4, 0xdd, 0x66, 0x23, 0x7d, 0x6d, 0x22, 0x39, 0xee, 0x2d, 0xbf, 0xc5, 0x8b, 0xbe, 0x86,
-	0x8f, 0xf7, 0xb0, 0x8b, 0x1b, 0xa2, 0xdc, 0x64, 0x20, 0x41, 0x9b, 0x55, 0xea, 0xe0, 0x5a, 0xd0,
-	0x31, 0x93, 0x9a, 0x78, 0x46, 0x8b, 0x30, 0xfd, 0x88, 0xb4, 0xf8, 0x39, 0xf7, 0xff, 0xca, 0x3b,
-	0xbc, 0xc3, 0x45, 0x64, 0xf8, 0xa1, 0x5b, 0x86, 0x84, 0x8b, 0x8f, 0x2b, 0x3a, 0xf6, 0x30, 0xd7,
-	0xb9, 0xe6, 0xe2, 0xe3, 0x12, 0xf6, 0x70, 0xe1, 0xcb, 0x14, 0xcc, 0x30, 0x2f, 0xf4, 0xad, 0x04,
-	0xd0, 0x9d, 0x28, 0x50, 0x7e, 0x58, 0x6d, 0xe9, 0x9b, 0x49, 0x32, 0xca, 0xb8, 0xe6, 0x01, 0x92,
-	0xbc, 0xf1, 0xf9, 0x6f, 0xff, 0x7c, 0x13, 0x93, 0xd1, 0x8a, 0xba, 0x65, 0x0c, 0x18, 0x85, 0xba,
-	0xd3, 0x08, 0xfa, 0x49, 0x82, 0xee, 0x34, 0x80, 0x36, 0xc7, 0x5a, 0x27, 0xa4, 0xca, 0x8f, 0x69,
-	0xcd, 0xa1, 0x6e, 0x33, 0xa8, 0x6d, 0xa4, 0x8e, 0x82, 0x52, 0x4f, 0xa3, 0xcd, 0xf0, 0x0c, 0x7d,
-	0x25, 0x41, 0x52, 0x0c, 0x16, 0x68, 0xac, 0xe9, 0x81, 0x8e, 0xc5, 0xd8, 0x37, 0xad, 0xc8, 0xeb,
-	0x8c, 0x71, 0x15, 0xe5, 0x06, 0x33, 0x8a, 0x21, 0x04, 0xfd, 0x20, 0x41, 0x42, 0xb4, 0xf5, 0x57,
-	0xc7, 0x1b, 0x68, 0x02, 0xa2, 0x2b, 0x4d, 0x3f, 0xf2, 0x2d, 0x06, 0xa4, 0xa2, 0xfc, 0x08, 0x20,
-	0xf5, 0x34, 0x52, 0xf8, 0xce, 0xd0, 0x2f, 0x12, 0xf4, 0xb4, 0x60, 0xb4, 0x3d, 0x6c, 0xdd, 0x81,
-	0x23, 0x40, 0xa6, 0x70, 0x15, 0x17, 0x0e, 0xac, 0x30, 0xe0, 0x0d, 0xb4, 0x36, 0x18, 0xd8, 0x9f,
-	0x03, 0xf2, 0x21, 0x6a, 0xde, 0xd4, 0xd1, 0xf7, 0x12, 0xcc, 0x04, 0x75, 0x64, 0x64, 0xbf, 0x15,
-	0x9b, 0xfa, 0xf2, 0x18, 0x96, 0x1c, 0xe7, 0x0e, 0xc3, 0xb9, 0x85, 0x76, 0xae, 0x94, 0x3f, 0x35,
-	0x68, 0xe5, 0x3f, 0x4a, 0x10, 0xf7, 0xe5, 0xd0, 0xfa, 0xe8, 0x51, 0x20, 0x20, 0x1b, 0x7b, 0x66,
-	0x90, 0x77, 0x19, 0xd8, 0x1b, 0xe8, 0xce, 0x04, 0x60, 0xea, 0x29, 0xeb, 0xbc, 0x67, 0x2c, 0x79,
-	0xac, 0x03, 0x0e, 0x4f, 0x5e, 0xb4, 0xb7, 0x0e, 0x4f, 0xde, 0xa5, 0x76, 0x3a, 0x69, 0xf2, 0x3c,
-	0x46, 0xf4, 0xb5, 0x04, 0x49, 0x51, 0x2c, 0x87, 0xdf, 0xda, 0xde, 0xd2, 0x3c, 0xfc, 0xd6, 0xf6,
-	0x55, 0xe0, 0x51, 0xe5, 0xce, 0xc5, 0xc7, 0x79, 0x87, 0x79, 0x14, 0xdf, 0x39, 0xff, 0x3b, 0x3b,
-	0x75, 0xde, 0xc9, 0x4a, 0xcf, 0x3b, 0x59, 0xe9, 0xaf, 0x4e, 0x56, 0x7a, 0x72, 0x91, 0x9d, 0x7a,
-	0x7e, 0x91, 0x9d, 0xfa, 0xfd, 0x22, 0x3b, 0xf5, 0xf1, 0x66, 0xa4, 0xb5, 0x6c, 0x19, 0x75, 0x5c,
-	0xa5, 0xea, 0x96, 0x91, 0xaf, 0x1d, 0x60, 0xd3, 0x52, 0x4f, 0x22, 0xc2, 0xac, 0xc9, 0x54, 0x67,
-	0xd9, 0x98, 0xbd, 0xf3, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xbe, 0xe5, 0xfd, 0x61, 0x0f,
-	0x00, 0x00,
+	// 1418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xdd, 0x6f, 0xdb, 0xd4,
+	0x1b, 0xc7, 0xeb, 0xbe, 0x2d, 0x79, 0xba, 0xa5, 0xfd, 0x9d, 0x5f, 0x57, 0x32, 0x53, 0x92, 0xcd,
+	0xc0, 0xd6, 0x8d, 0xc5, 0x5e, 0xd3, 0x8d, 0x49, 0x6c, 0x93, 0x58, 0x5a, 0x86, 0x02, 0x12, 0x2a,
+	0xa1, 0xec, 0x82, 0x49, 0x44, 0x27, 0xc9, 0x99, 0x6b, 0x96, 0xd8, 0x9e, 0x8f, 0xd3, 0x2e, 0x1b,
+	0xbb, 0x41, 0x42, 0xe2, 0x06, 0x69, 0x80, 0x90, 0x40, 0x42, 0x48, 0x88, 0x0b, 0x24, 0xae, 0xc7,
+	0x35, 0xb7, 0xd3, 0xae, 0x26, 0x71, 0x83, 0xb8, 0x28, 0x90, 0xf2, 0x5f, 0x20, 0x24, 0xe4, 0x73,
+	0x8e, 0x1d, 0xc7, 0x75, 0x53, 0x27, 0xec, 0xaa, 0xb1, 0xfd, 0x3c, 0xdf, 0xe7, 0xf3, 0x3c, 0xe7,
+	0xe5, 0x79, 0x54, 0x38, 0x71, 0x57, 0xaf, 0x6b, 0x75, 0xab, 0xd5, 0x32, 0x5c, 0x97, 0x10, 0x6d,
+	0x6b, 0xb9, 0x46, 0x5c, 0xbc, 0xac, 0xdd, 0x6e, 0x13, 0xa7, 0xa3, 0xda, 0x8e, 0xe5, 0x5a, 0xe8,
+	0xe8, 0x5d, 0xbd, 0xae, 0x06, 0x26, 0xaa, 0x30, 0x91, 0xcf, 0xd4, 0x2d, 0xda, 0xb2, 0xa8, 0x56,
+	0xc3, 0x94, 0x70, 0xfb, 0xc0, 0xdb, 0xc6, 0xba, 0x61, 0x62, 0xd7, 0xb0, 0x4c, 0x2e, 0x21, 0x1f,
+	0xe3, 0xb6, 0x55, 0xf6, 0xa4, 0xf1, 0x07, 0xf1, 0x69, 0x5e, 0xb7, 0x74, 0x8b, 0xbf, 0xf7, 0x7e,
+	0x89, 0xb7, 0x8b, 0xba, 0x65, 0xe9, 0x4d, 0xa2, 0x61, 0xdb, 0xd0, 0xb0, 0x69, 0x5a, 0x2e, 0x53,
+	0xf3, 0x7d, 0x8e, 0x89, 0xaf, 0xec, 0xa9, 0xd6, 0xbe, 0xa9, 0x61, 0x53, 0xc0, 0xca, 0xf9, 0xe8,
+	0x27, 0xd7, 0x68, 0x11, 0xea, 0xe2, 0x96, 0x2d, 0x0c, 0x9e, 0x8f, 0x4f, 0x58, 0x27, 0x26, 0xa1,
+	0x86, 0x08, 0xa0, 0x64, 0x61, 0xe1, 0x6d, 0x2f, 0xa3, 0x55, 0xdf, 0x8e, 0x56, 0xc8, 0xed, 0x36,
+	0xa1, 0xae, 0xf2, 0x3e, 0x3c, 0xb3, 0xe7, 0x0b, 0xb5, 0x2d, 0x93, 0x12, 0xb4, 0x0a, 0x10, 0xe8,
+	0xd2, 0xac, 0x74, 0x7c, 0x62, 0x69, 0xa6, 0x38, 0xaf, 0x72, 0x1e, 0xd5, 0xe7, 0x51, 0xaf, 0x9a,
+	0x9d, 0xd2, 0x91, 0xc7, 0x0f, 0x0b, 0xe9, 0x40, 0xa1, 0x12, 0x72, 0x53, 0x5e, 0x81, 0xa3, 0xfd,
+	0xfa, 0x22, 0x30, 0x3a, 0x01, 0x87, 0x03, 0xb3, 0xaa, 0xd1, 0xc8, 0x4a, 0xc7, 0xa5, 0xa5, 0xc9,
+	0xca, 0x4c, 0xf0, 0xae, 0xdc, 0x50, 0x6e, 0x44, 0xa9, 0x03, 0xb4, 0xab, 0x90, 0x0e, 0x0c, 0x99,
+	0x67, 0x42, 0xb2, 0x9e, 0x57, 0x00, 0xb6, 0xee, 0x58, 0xb6, 0x45, 0x71, 0x93, 0x0e, 0x01, 0xf6,
+	0x01, 0x2c, 0x44, 0x7d, 0x05, 0xd8, 0x3a, 0xa4, 0x6d, 0xff, 0xa5, 0x28, 0xd9, 0x59, 0x35, 0x76,
+	0xbf, 0xa9, 0x7d, 0x0a, 0xbe, 0x40, 0x69, 0xf2, 0xd1, 0x4e, 0x7e, 0xac, 0xd2, 0x13, 0x51, 0x2e,
+	0xc2, 0x7c, 0xc4, 0x92, 0x63, 0xe6, 0x61, 0xc6, 0x37, 0xea, 0x51, 0x82, 0xff, 0xaa, 0xdc, 0x50,
+	0x3e, 0x1d, 0x87, 0xa3, 0xb1, 0x31, 0xd0, 0x4d, 0x38, 0x6c, 0xb7, 0x6b, 0x55, 0xdf, 0x76, 0x60,
+	0x01, 0x0b, 0xdd, 0x9d, 0xfc, 0xcc, 0x7a, 0xbb, 0xe6, 0x8b, 0x3c, 0x7e, 0x58, 0x90, 0xc5, 0x7e,
+	0xd7, 0xad, 0xad, 0x20, 0x99, 0x55, 0xcb, 0x74, 0x89, 0xe9, 0x56, 0x66, 0xec, 0x9e, 0x29, 0x5a,
+	0x80, 0x71, 0xa3, 0x91, 0x1d, 0xf7, 0xc8, 0x4a, 0xd3, 0xdd, 0x9d, 0xfc, 0x78, 0x79, 0xad, 0x32,
+	0x6e, 0x34, 0x50, 0x31, 0x52, 0xe1, 0x09, 0x66, 0x31, 0xeb, 0x45, 0x0a, 0x96, 0xaa, 0xbc, 0xd6,
+	0x57, 0x72, 0xf4, 0x2a, 0xa4, 0x1a, 0x04, 0x37, 0x9a, 0x86, 0x49, 0xb2, 0x93, 0x8c, 0x57, 0xde,
+	0xc3, 0xbb, 0xe1, 0x1f, 0x8d, 0x52, 0xca, 0xab, 0xe2, 0x83, 0xdf, 0xf3, 0x52, 0x25, 0xf0, 0x52,
+	0x16, 0x41, 0x66, 0xe5, 0x78, 0x8b, 0xdc, 0x71, 0x7d, 0xc4, 0xf2, 0x9a, 0x7f, 0x0e, 0x6e, 0xc0,
+	0xb3, 0xb1, 0x5f, 0x45, 0xc9, 0x2e, 0xc3, 0x9c, 0x49, 0xee, 0xb8, 0xd5, 0x3d, 0x25, 0x2f, 0xa1,
+	0xee, 0x4e, 0x3e, 0x13, 0xf1, 0xca, 0x98, 0xe1, 0xe7, 0x86, 0xf2, 0x21, 0xfc, 0x8f, 0x89, 0x5f,
+	0xb7, 0x5c, 0x42, 0x93, 0x2e, 0x20, 0xba, 0x06, 0xd0, 0xbb, 0x78, 0x58, 0x19, 0x67, 0x8a, 0x27,
+	0x55, 0x51, 0x7c, 0xef, 0x96, 0x52, 0xf9, 0xad, 0xe6, 0xaf, 0xc1, 0x3a, 0xd6, 0xfd, 0xd3, 0x55,
+	0x09, 0x79, 0x2a, 0xdf, 0x4b, 0x80, 0xc2, 0xe1, 0x45, 0x4a, 0x6b, 0x30, 0xb5, 0xe5, 0xbd, 0x10,
+	0xdb, 0x74, 0x69, 0xd0, 0x36, 0xf5, 0x3c, 0x23, 0x5b, 0x94, 0x3b, 0xa3, 0xd7, 0x63, 0x20, 0x4f,
+	0x1d, 0x08, 0xc9, 0x95, 0xfa, 0x28, 0xcb, 0x30, 0x17, 0x0a, 0x95, 0xb0, 0x44, 0xf3, 0x3c, 0x07,
+	0x87, 0x05, 0x4e, 0x73, 0x26, 0x47, 0xf9, 0x4a, 0x0a, 0xd5, 0x3b, 0xc8, 0x57, 0x8b, 0x11, 0x2b,
+	0x65, 0xba, 0x3b, 0x79, 0x08, 0xad, 0xdc, 0x81, 0xe2, 0xe8, 0x32, 0xa4, 0xbd, 0x1f, 0x55, 0xb7,
+	0x63, 0x13, 0xb6, 0x73, 0x33, 0xc5, 0xfc, 0x3e, 0xa5, 0xf3, 0xc2, 0x6f, 0x74, 0x6c, 0x52, 0x49,
+	0x6d, 0x89, 0x5f, 0xca, 0x79, 0x41, 0xb6, 0x81, 0x9b, 0xcd, 0x4e, 0xe2, 0xa3, 0xfc, 0xcf, 0x24,
+	0xa0, 0xb0, 0xdb, 0xa8, 0x19, 0xbd, 0x09, 0xe9, 0x0e, 0xa1, 0x55, 0xbe, 0xec, 0x2c, 0xab, 0x92,
+	0xea, 0x2d, 0xe6, 0x6f, 0x3b, 0xf9, 0x93, 0xba, 0xe1, 0x6e, 0xb6, 0x6b, 0x5e, 0x16, 0xa2, 0x9f,
+	0x89, 0x3f, 0x05, 0xda, 0xb8, 0xa5, 0x79, 0xc9, 0x52, 0x75, 0x8d, 0xd4, 0x2b, 0xa9, 0x0e, 0xa1,
+	0x6c, 0x1f, 0xa1, 0x32, 0xa4, 0x4c, 0x4b, 0x68, 0x4d, 0x8c, 0xa4, 0x75, 0xc8, 0xb4, 0xb8, 0xd4,
+	0x3b, 0x70, 0xa4, 0xde, 0x76, 0x1c, 0x62, 0xba, 0x42, 0x6f, 0x72, 0x24, 0xbd, 0xc3, 0x42, 0x84,
+	0x8b, 0xbe, 0x0b, 0x19, 0xdb, 0xa2, 0xd4, 0xa8, 0x35, 0x89, 0x50, 0x9d, 0x1a, 0x49, 0xf5, 0x88,
+	0xaf, 0x12, 0xc8, 0xf2, 0xf5, 0xdf, 0x74, 0x08, 0xdd, 0xb4, 0x9a, 0x8d, 0xec, 0xf4, 0x68, 0xb2,
+	0x6c, 0x4f, 0xf8, 0x22, 0xe8, 0x1a, 0x4c, 0xdf, 0x6e, 0x5b, 0x4e, 0xbb, 0x95, 0x3d, 0x34, 0x92,
+	0x9c, 0xf0, 0xf6, 0x4a, 0x89, 0x6b, 0xd4, 0xc5, 0x86, 0x29, 0x92, 0x4e, 0x8d, 0x56, 0x4a, 0x21,
+	0xc2, 0x72, 0x56, 0x3e, 0x91, 0xc4, 0xed, 0xb8, 0xda, 0xb4, 0x28, 0x69, 0x8c, 0xd0, 0x32, 0x9f,
+	0xda, 0x65, 0xf6, 0xb3, 0x04, 0x8b, 0xf1, 0x28, 0xe2, 0x50, 0x5c, 0x87, 0xb9, 0x3a, 0xfb, 0x54,
+	0x8d, 0x36, 0xe2, 0x17, 0xf7, 0x39, 0xa6, 0xfd, 0x4a, 0xe2, 0x7a, 0x9b, 0xad, 0xf7, 0xeb, 0x3f,
+	0xbd, 0x8b, 0xee, 0x8a, 0xe8, 0x43, 0xfd, 0x61, 0x13, 0xdf, 0x05, 0x34, 0x76, 0x29, 0x82, 0xf4,
+	0x37, 0x60, 0x36, 0x92, 0xbe, 0x68, 0xef, 0x43, 0x65, 0x9f, 0xe9, 0xcf, 0x5e, 0x99, 0x17, 0xf7,
+	0xcf, 0x3a, 0x76, 0x70, 0x2b, 0x98, 0x1d, 0x2b, 0xf0, 0xff, 0xbe, 0xb7, 0x02, 0xe1, 0x12, 0x4c,
+	0xdb, 0xec, 0x8d, 0x88, 0xfc, 0xdc, 0x3e, 0x91, 0xb9, 0x9b, 0x88, 0x28, 0x5c, 0x94, 0xd7, 0xc4,
+	0xd0, 0x52, 0xc1, 0xdb, 0x7d, 0xc1, 0x90, 0x0c, 0x29, 0xda, 0xae, 0x51, 0x1b, 0xd7, 0xf9, 0xc4,
+	0x97, 0xae, 0x04, 0xcf, 0x68, 0x0e, 0x26, 0x6e, 0x91, 0x8e, 0xb8, 0xa7, 0xbd, 0x9f, 0xca, 0x0a,
+	0x2c, 0x44, 0x65, 0x04, 0xdd, 0x31, 0x48, 0x39, 0x78, 0xbb, 0xda, 0xc0, 0x2e, 0x16, 0x3a, 0x87,
+	0x1c, 0xbc, 0xbd, 0x86, 0x5d, 0x5c, 0xfc, 0x3b, 0x03, 0x53, 0xcc, 0x0b, 0x7d, 0x29, 0x01, 0xf4,
+	0x26, 0x62, 0x54, 0x18, 0xd4, 0x1b, 0xf7, 0xcc, 0xd4, 0xb2, 0x9a, 0xd4, 0x9c, 0x23, 0x29, 0x4b,
+	0x1f, 0xfd, 0xf2, 0xd7, 0x17, 0xe3, 0x0a, 0x3a, 0xae, 0x9d, 0xd3, 0x63, 0x46, 0xf9, 0x7a, 0x0f,
+	0xe4, 0x3b, 0x09, 0x7a, 0xd3, 0x2c, 0x3a, 0x9b, 0x28, 0x8e, 0x4f, 0x55, 0x48, 0x68, 0x2d, 0xa0,
+	0x2e, 0x32, 0xa8, 0x65, 0xa4, 0x1d, 0x04, 0xa5, 0xdd, 0x0b, 0x9f, 0xfd, 0xfb, 0xe8, 0x33, 0x09,
+	0xd2, 0xbd, 0x63, 0x93, 0x68, 0xfa, 0xa5, 0x89, 0x18, 0xf7, 0x9c, 0x75, 0xe5, 0x14, 0x63, 0x3c,
+	0x81, 0xf2, 0xf1, 0x8c, 0xc1, 0x05, 0x80, 0xbe, 0x91, 0x20, 0xe5, 0xbb, 0xa3, 0x97, 0x92, 0x0d,
+	0xe4, 0x9c, 0x68, 0xa8, 0xe9, 0x5d, 0xb9, 0xc0, 0x80, 0x34, 0x54, 0x38, 0x00, 0x48, 0xbb, 0x17,
+	0x3a, 0xe4, 0xf7, 0xd1, 0x0f, 0x12, 0x44, 0x46, 0x48, 0xb4, 0x3c, 0x28, 0x6e, 0xec, 0x08, 0x2b,
+	0x17, 0x87, 0x71, 0x11, 0xc0, 0x2a, 0x03, 0x5e, 0x42, 0x27, 0xe3, 0x81, 0xbd, 0x39, 0xb6, 0xe0,
+	0xa3, 0x16, 0x8c, 0x06, 0xfa, 0x5a, 0x82, 0x29, 0xde, 0x07, 0x0f, 0x9c, 0x17, 0x83, 0x45, 0x3d,
+	0x9d, 0xc0, 0x52, 0xe0, 0x5c, 0x62, 0x38, 0x17, 0xd0, 0xca, 0x50, 0xf5, 0xd3, 0xf8, 0x28, 0xfa,
+	0xad, 0x04, 0x93, 0x9e, 0x1c, 0x3a, 0x75, 0xf0, 0x28, 0xcb, 0xc9, 0x12, 0xcf, 0xbc, 0xca, 0x2a,
+	0x03, 0xbb, 0x82, 0x2e, 0x8d, 0x00, 0xa6, 0xdd, 0xf3, 0xfe, 0x38, 0xf7, 0x59, 0xf1, 0xd8, 0x04,
+	0x37, 0xb8, 0x78, 0xe1, 0xd9, 0x50, 0x3e, 0x9d, 0xc0, 0xf2, 0xbf, 0x15, 0xcf, 0x65, 0x44, 0x3f,
+	0x4a, 0x30, 0x1b, 0x69, 0xa9, 0x68, 0xe0, 0x86, 0x8a, 0x1f, 0x05, 0xe4, 0x95, 0xa1, 0x7c, 0x92,
+	0xed, 0x42, 0xde, 0x8c, 0x0a, 0xbd, 0xe3, 0xfc, 0x93, 0x04, 0x99, 0x7e, 0xad, 0xc1, 0xe7, 0x25,
+	0xb6, 0xd5, 0xca, 0xc5, 0x61, 0x5c, 0x04, 0xe9, 0x65, 0x46, 0xfa, 0x32, 0x3a, 0x9f, 0x8c, 0x34,
+	0x72, 0xce, 0x3f, 0x96, 0x60, 0x9a, 0xb7, 0x23, 0x34, 0x70, 0x5d, 0xfb, 0x3a, 0x9f, 0x7c, 0x26,
+	0x89, 0xa9, 0xe0, 0x7b, 0x81, 0xf1, 0xe5, 0xd0, 0xe2, 0x3e, 0x7b, 0x80, 0x07, 0xff, 0x5c, 0x82,
+	0x74, 0xd0, 0x19, 0x07, 0x5f, 0xd1, 0xd1, 0x3e, 0x2c, 0x17, 0x12, 0x5a, 0x27, 0xeb, 0x6d, 0x0e,
+	0xde, 0x2e, 0x70, 0xa8, 0xd2, 0x1b, 0x8f, 0xfe, 0xcc, 0x8d, 0x3d, 0xea, 0xe6, 0xa4, 0x27, 0xdd,
+	0x9c, 0xf4, 0x47, 0x37, 0x27, 0x3d, 0xd8, 0xcd, 0x8d, 0x3d, 0xd9, 0xcd, 0x8d, 0xfd, 0xba, 0x9b,
+	0x1b, 0x7b, 0xef, 0x6c, 0x68, 0x70, 0x3d, 0xa7, 0x37, 0x71, 0x8d, 0x6a, 0xe7, 0xf4, 0x42, 0x7d,
+	0x13, 0x1b, 0xa6, 0x76, 0x27, 0x24, 0xcc, 0x46, 0xd8, 0xda, 0x34, 0xfb, 0x9f, 0xc0, 0xca, 0xbf,
+	0x03, 0x00, 0x1d, 0x6f, 0x48, 0xc6, 0x0e, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -868,6 +1118,12 @@ type QueryClient interface {
 	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
 	// Tally queries the tally of a single proposal ID.
 	Tally(ctx context.Context, in *QueryTallyRequest, opts ...grpc.CallOption) (*QueryTallyResponse, error)
+	// ClosedProposals queries the archived closed proposals, optionally of a single committee.
+	ClosedProposals(ctx context.Context, in *QueryClosedProposalsRequest, opts ...grpc.CallOption) (*QueryClosedProposalsResponse, error)
+	// ClosedProposal queries an archived closed proposal based on proposal ID.
+	ClosedProposal(ctx context.Context, in *QueryClosedProposalRequest, opts ...grpc.CallOption) (*QueryClosedProposalResponse, error)
+	// Params queries the parameters of the committee module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// RawParams queries the raw params data of any subspace and key.
 	RawParams(ctx context.Context, in *QueryRawParamsRequest, opts ...grpc.CallOption) (*QueryRawParamsResponse, error)
 }
@@ -952,6 +1208,33 @@ func (c *queryClient) Tally(ctx context.Context, in *QueryTallyRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) ClosedProposals(ctx context.Context, in *QueryClosedProposalsRequest, opts ...grpc.CallOption) (*QueryClosedProposalsResponse, error) {
+	out := new(QueryClosedProposalsResponse)
+	err := c.cc.Invoke(ctx, "/zgc.committee.v1beta1.Query/ClosedProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClosedProposal(ctx context.Context, in *QueryClosedProposalRequest, opts ...grpc.CallOption) (*QueryClosedProposalResponse, error) {
+	out := new(QueryClosedProposalResponse)
+	err := c.cc.Invoke(ctx, "/zgc.committee.v1beta1.Query/ClosedProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/zgc.committee.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) RawParams(ctx context.Context, in *QueryRawParamsRequest, opts ...grpc.CallOption) (*QueryRawParamsResponse, error) {
 	out := new(QueryRawParamsResponse)
 	err := c.cc.Invoke(ctx, "/zgc.committee.v1beta1.Query/RawParams", in, out, opts...)
@@ -979,6 +1262,12 @@ type QueryServer interface {
 	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
 	// Tally queries the tally of a single proposal ID.
 	Tally(context.Context, *QueryTallyRequest) (*QueryTallyResponse, error)
+	// ClosedProposals queries the archived closed proposals, optionally of a single committee.
+	ClosedProposals(context.Context, *QueryClosedProposalsRequest) (*QueryClosedProposalsResponse, error)
+	// ClosedProposal queries an archived closed proposal based on proposal ID.
+	ClosedProposal(context.Context, *QueryClosedProposalRequest) (*QueryClosedProposalResponse, error)
+	// Params queries the parameters of the committee module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// RawParams queries the raw params data of any subspace and key.
 	RawParams(context.Context, *QueryRawParamsRequest) (*QueryRawParamsResponse, error)
 }
@@ -1011,6 +1300,15 @@ func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest
 func (*UnimplementedQueryServer) Tally(ctx context.Context, req *QueryTallyRequest) (*QueryTallyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Tally not implemented")
 }
+func (*UnimplementedQueryServer) ClosedProposals(ctx context.Context, req *QueryClosedProposalsRequest) (*QueryClosedProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosedProposals not implemented")
+}
+func (*UnimplementedQueryServer) ClosedProposal(ctx context.Context, req *QueryClosedProposalRequest) (*QueryClosedProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosedProposal not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) RawParams(ctx context.Context, req *QueryRawParamsRequest) (*QueryRawParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RawParams not implemented")
 }
@@ -1163,6 +1461,60 @@ func _Query_Tally_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClosedProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClosedProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClosedProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.committee.v1beta1.Query/ClosedProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClosedProposals(ctx, req.(*QueryClosedProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClosedProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClosedProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClosedProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.committee.v1beta1.Query/ClosedProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClosedProposal(ctx, req.(*QueryClosedProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.committee.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_RawParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRawParamsRequest)
 	if err := dec(in); err != nil {
@@ -1217,6 +1569,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Tally",
 			Handler:    _Query_Tally_Handler,
 		},
+		{
+			MethodName: "ClosedProposals",
+			Handler:    _Query_ClosedProposals_Handler,
+		},
+		{
+			MethodName: "ClosedProposal",
+			Handler:    _Query_ClosedProposal_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "RawParams",
 			Handler:    _Query_RawParams_Handler,
@@ -1758,6 +2122,16 @@ func (m *QueryTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AbstainVotes.Size()
+		i -= size
+		if _, err := m.AbstainVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.Quorum.Size()
 		i -= size
@@ -1826,7 +2200,7 @@ func (m *QueryTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRawParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClosedProposalsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1836,34 +2210,37 @@ func (m *QueryRawParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRawParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClosedProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRawParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClosedProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Subspace) > 0 {
-		i -= len(m.Subspace)
-		copy(dAtA[i:], m.Subspace)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+	if m.CommitteeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CommitteeId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRawParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClosedProposalsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1873,31 +2250,234 @@ func (m *QueryRawParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRawParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClosedProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRawParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClosedProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RawData) > 0 {
-		i -= len(m.RawData)
-		copy(dAtA[i:], m.RawData)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RawData)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
+	if len(m.ClosedProposals) > 0 {
+		for iNdEx := len(m.ClosedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClosedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClosedProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClosedProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClosedProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClosedProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClosedProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClosedProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClosedProposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RawData) > 0 {
+		i -= len(m.RawData)
+		copy(dAtA[i:], m.RawData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RawData)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
@@ -2137,6 +2717,86 @@ func (m *QueryTallyResponse) Size() (n int) {
 	n += 1 + l + sovQuery(uint64(l))
 	l = m.Quorum.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.AbstainVotes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClosedProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitteeId != 0 {
+		n += 1 + sovQuery(uint64(m.CommitteeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClosedProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClosedProposals) > 0 {
+		for _, e := range m.ClosedProposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClosedProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryClosedProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClosedProposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -3730,6 +4390,550 @@ func (m *QueryTallyResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstainVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClosedProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClosedProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClosedProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeId", wireType)
+			}
+			m.CommitteeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClosedProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClosedProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClosedProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClosedProposals = append(m.ClosedProposals, ClosedProposal{})
+			if err := m.ClosedProposals[len(m.ClosedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClosedProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClosedProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClosedProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClosedProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClosedProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClosedProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClosedProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
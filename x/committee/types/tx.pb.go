@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -35,6 +37,9 @@ type MsgSubmitProposal struct {
 	PubProposal *types.Any `protobuf:"bytes,1,opt,name=pub_proposal,json=pubProposal,proto3" json:"pub_proposal,omitempty"`
 	Proposer    string     `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	CommitteeID uint64     `protobuf:"varint,3,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+	// deposit must cover the min deposit of the committee.
+	Deposit   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	Expedited bool                                     `protobuf:"varint,5,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -113,6 +118,8 @@ type MsgVote struct {
 	ProposalID uint64   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 	Voter      string   `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
 	VoteType   VoteType `protobuf:"varint,3,opt,name=vote_type,json=voteType,proto3,enum=zgc.committee.v1beta1.VoteType" json:"vote_type,omitempty"`
+	// options split the vote across vote types, the vote_type must be unspecified when set.
+	Options WeightedVoteOptions `protobuf:"bytes,4,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
 }
 
 func (m *MsgVote) Reset()         { *m = MsgVote{} }
@@ -185,46 +192,216 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
+// MsgChangeParams is submitted by the authority to change the module params.
+type MsgChangeParams struct {
+	Authority string  `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *MsgChangeParams) Reset()         { *m = MsgChangeParams{} }
+func (m *MsgChangeParams) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeParams) ProtoMessage()    {}
+func (*MsgChangeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_323a2f7ecd37af6f, []int{4}
+}
+func (m *MsgChangeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChangeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeParams.Merge(m, src)
+}
+func (m *MsgChangeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeParams proto.InternalMessageInfo
+
+// MsgChangeParamsResponse defines the ChangeParams response type
+type MsgChangeParamsResponse struct {
+}
+
+func (m *MsgChangeParamsResponse) Reset()         { *m = MsgChangeParamsResponse{} }
+func (m *MsgChangeParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeParamsResponse) ProtoMessage()    {}
+func (*MsgChangeParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_323a2f7ecd37af6f, []int{5}
+}
+func (m *MsgChangeParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChangeParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeParamsResponse.Merge(m, src)
+}
+func (m *MsgChangeParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeParamsResponse proto.InternalMessageInfo
+
+// MsgCancelProposal is submitted by the proposer to cancel an active proposal.
+type MsgCancelProposal struct {
+	ProposalID uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Proposer   string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *MsgCancelProposal) Reset()         { *m = MsgCancelProposal{} }
+func (m *MsgCancelProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposal) ProtoMessage()    {}
+func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_323a2f7ecd37af6f, []int{6}
+}
+func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposal.Merge(m, src)
+}
+func (m *MsgCancelProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposal proto.InternalMessageInfo
+
+// MsgCancelProposalResponse defines the CancelProposal response type
+type MsgCancelProposalResponse struct {
+}
+
+func (m *MsgCancelProposalResponse) Reset()         { *m = MsgCancelProposalResponse{} }
+func (m *MsgCancelProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposalResponse) ProtoMessage()    {}
+func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_323a2f7ecd37af6f, []int{7}
+}
+func (m *MsgCancelProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "zgc.committee.v1beta1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "zgc.committee.v1beta1.MsgSubmitProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "zgc.committee.v1beta1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "zgc.committee.v1beta1.MsgVoteResponse")
+	proto.RegisterType((*MsgChangeParams)(nil), "zgc.committee.v1beta1.MsgChangeParams")
+	proto.RegisterType((*MsgChangeParamsResponse)(nil), "zgc.committee.v1beta1.MsgChangeParamsResponse")
+	proto.RegisterType((*MsgCancelProposal)(nil), "zgc.committee.v1beta1.MsgCancelProposal")
+	proto.RegisterType((*MsgCancelProposalResponse)(nil), "zgc.committee.v1beta1.MsgCancelProposalResponse")
 }
 
 func init() { proto.RegisterFile("zgc/committee/v1beta1/tx.proto", fileDescriptor_323a2f7ecd37af6f) }
 
 var fileDescriptor_323a2f7ecd37af6f = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0xd2, 0x02, 0xed, 0xb8, 0x4a, 0x55, 0x2b, 0x48, 0x89, 0x0f, 0x9b, 0x28, 0x48, 0x28,
-	0x07, 0xba, 0x9b, 0x86, 0x2b, 0x17, 0xd2, 0x5e, 0x82, 0x08, 0x42, 0x06, 0x81, 0xc4, 0x25, 0xb2,
-	0x9d, 0x65, 0x6b, 0x29, 0xf1, 0x58, 0xd9, 0x75, 0x54, 0xf7, 0x47, 0x20, 0x7e, 0x0c, 0x47, 0x6e,
-	0x5c, 0x2a, 0x4e, 0x3d, 0x72, 0xaa, 0xc0, 0xf9, 0x23, 0xc8, 0x1f, 0x6b, 0x21, 0xda, 0x22, 0xb8,
-	0xcd, 0xc7, 0x9b, 0x37, 0xef, 0xcd, 0x2e, 0xd0, 0x73, 0x19, 0xf0, 0x00, 0x97, 0xcb, 0x50, 0x6b,
-	0x21, 0xf8, 0xfa, 0xc8, 0x17, 0xda, 0x3b, 0xe2, 0xfa, 0x8c, 0xc5, 0x2b, 0xd4, 0x68, 0x3f, 0x38,
-	0x97, 0x01, 0xab, 0xfb, 0xac, 0xea, 0x3b, 0x9d, 0x00, 0xd5, 0x12, 0xd5, 0xac, 0x00, 0xf1, 0x32,
-	0x29, 0x27, 0x9c, 0x96, 0x44, 0x89, 0x65, 0x3d, 0x8f, 0xaa, 0x6a, 0x47, 0x22, 0xca, 0x85, 0xe0,
-	0x45, 0xe6, 0x27, 0x1f, 0xb8, 0x17, 0xa5, 0x55, 0xeb, 0xe1, 0xcd, 0x12, 0xa4, 0x88, 0x84, 0x0a,
-	0x2b, 0xd6, 0xfe, 0x17, 0x02, 0x07, 0x53, 0x25, 0x5f, 0x27, 0xfe, 0x32, 0xd4, 0xaf, 0x56, 0x18,
-	0xa3, 0xf2, 0x16, 0xf6, 0x3b, 0xd8, 0x8b, 0x13, 0x3f, 0x57, 0x51, 0xe4, 0x6d, 0xd2, 0x23, 0x03,
-	0x6b, 0xd4, 0x62, 0xe5, 0x32, 0x66, 0x96, 0xb1, 0x67, 0x51, 0x3a, 0xa6, 0xdf, 0x3e, 0x1f, 0x3a,
-	0x95, 0x52, 0x89, 0x6b, 0x63, 0x85, 0x1d, 0x63, 0xa4, 0x45, 0xa4, 0x5d, 0x2b, 0x4e, 0xfc, 0x9a,
-	0xd8, 0x81, 0x9d, 0x92, 0x54, 0xac, 0xda, 0x77, 0x7a, 0x64, 0xb0, 0xeb, 0xd6, 0xb9, 0x3d, 0x82,
-	0xbd, 0x5a, 0xed, 0x2c, 0x9c, 0xb7, 0xb7, 0x7a, 0x64, 0xb0, 0x3d, 0xde, 0xcf, 0xae, 0xba, 0xd6,
-	0xb1, 0xa9, 0x4f, 0x4e, 0x5c, 0xab, 0x06, 0x4d, 0xe6, 0xfd, 0x17, 0xd0, 0xb9, 0xa6, 0xde, 0x15,
-	0x2a, 0xc6, 0x48, 0x09, 0x9b, 0x83, 0x65, 0x1c, 0xe4, 0x7c, 0xa4, 0xe0, 0x6b, 0x66, 0x57, 0x5d,
-	0x30, 0xd0, 0xc9, 0x89, 0x0b, 0x06, 0x32, 0x99, 0xf7, 0x3f, 0x12, 0xb8, 0x3f, 0x55, 0xf2, 0x2d,
-	0xea, 0xff, 0x1f, 0xb6, 0x5b, 0x70, 0x77, 0x8d, 0xba, 0xf6, 0x55, 0x26, 0xf6, 0x53, 0xd8, 0xcd,
-	0x83, 0x99, 0x4e, 0x63, 0x51, 0x38, 0x6a, 0x8e, 0xba, 0xec, 0xc6, 0xb7, 0x67, 0xf9, 0xda, 0x37,
-	0x69, 0x2c, 0xdc, 0x9d, 0x75, 0x15, 0xf5, 0x0f, 0x60, 0xbf, 0xd2, 0x63, 0x4c, 0x8d, 0xbe, 0x12,
-	0xd8, 0x9a, 0x2a, 0x69, 0x2f, 0xa0, 0xf9, 0xc7, 0xa3, 0x0d, 0x6e, 0xe1, 0xbd, 0x76, 0x20, 0x67,
-	0xf8, 0xaf, 0xc8, 0xfa, 0x94, 0x2f, 0x61, 0xbb, 0xb8, 0x0a, 0xbd, 0x7d, 0x32, 0xef, 0x3b, 0x8f,
-	0xfe, 0xde, 0x37, 0x7c, 0xe3, 0xe7, 0x17, 0x3f, 0x69, 0xe3, 0x22, 0xa3, 0xe4, 0x32, 0xa3, 0xe4,
-	0x47, 0x46, 0xc9, 0xa7, 0x0d, 0x6d, 0x5c, 0x6e, 0x68, 0xe3, 0xfb, 0x86, 0x36, 0xde, 0x3f, 0x96,
-	0xa1, 0x3e, 0x4d, 0xfc, 0x9c, 0x87, 0x0f, 0xe5, 0xc2, 0xf3, 0x15, 0x1f, 0xca, 0xc3, 0xe0, 0xd4,
-	0x0b, 0x23, 0x7e, 0xf6, 0xdb, 0x97, 0xce, 0x8f, 0xaa, 0xfc, 0x7b, 0xc5, 0x77, 0x7c, 0xf2, 0x2b,
-	0x00, 0x00, 0xff, 0xff, 0xca, 0xbf, 0x24, 0x94, 0x73, 0x03, 0x00, 0x00,
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x9b, 0xbe, 0x32, 0xae, 0x52, 0xd5, 0x14, 0x91, 0xb8, 0xe0, 0x44, 0x46, 0x42, 0x46,
+	0xa2, 0x76, 0x1a, 0xc4, 0x8e, 0x0d, 0x49, 0x37, 0x41, 0x14, 0x2a, 0x83, 0xa8, 0xc4, 0x26, 0xf5,
+	0x63, 0x3a, 0x19, 0x91, 0x78, 0xac, 0xcc, 0x24, 0x6a, 0xfa, 0x15, 0x6c, 0xf9, 0x05, 0xd6, 0xfc,
+	0x00, 0xbb, 0x8a, 0x55, 0x97, 0xac, 0x0a, 0xa4, 0x3f, 0xc0, 0x27, 0x20, 0x8f, 0x67, 0xdc, 0xb4,
+	0x69, 0xa2, 0x76, 0xe5, 0xb9, 0x73, 0xcf, 0xb9, 0x8f, 0x73, 0xaf, 0x07, 0x18, 0x27, 0x28, 0x70,
+	0x02, 0xd2, 0xeb, 0x61, 0xc6, 0x20, 0x74, 0x86, 0x3b, 0x3e, 0x64, 0xde, 0x8e, 0xc3, 0x8e, 0xed,
+	0xb8, 0x4f, 0x18, 0xd1, 0xee, 0x9f, 0xa0, 0xc0, 0xce, 0xfc, 0xb6, 0xf0, 0xeb, 0x46, 0x40, 0x68,
+	0x8f, 0x50, 0xc7, 0xf7, 0xe8, 0x25, 0x29, 0x20, 0x38, 0x4a, 0x69, 0x7a, 0x39, 0xf5, 0xb7, 0xb9,
+	0xe5, 0xa4, 0x86, 0x70, 0x6d, 0x22, 0x82, 0x48, 0x7a, 0x9f, 0x9c, 0x24, 0x01, 0x11, 0x82, 0xba,
+	0xd0, 0xe1, 0x96, 0x3f, 0x38, 0x72, 0xbc, 0x68, 0x24, 0x5c, 0x8f, 0x6f, 0x2e, 0x11, 0xc1, 0x08,
+	0x52, 0x2c, 0xa2, 0x9a, 0x3f, 0x16, 0xc0, 0xc6, 0x1e, 0x45, 0xef, 0x07, 0x7e, 0x0f, 0xb3, 0xfd,
+	0x3e, 0x89, 0x09, 0xf5, 0xba, 0xda, 0x01, 0x58, 0x8b, 0x07, 0x7e, 0x3b, 0x16, 0x76, 0x49, 0xa9,
+	0x2a, 0x96, 0x5a, 0xdf, 0xb4, 0xd3, 0x64, 0xb6, 0x4c, 0x66, 0xbf, 0x8a, 0x46, 0x0d, 0xe3, 0xe7,
+	0xf7, 0x6d, 0x5d, 0x54, 0x8a, 0xc8, 0x50, 0xb6, 0x6a, 0x37, 0x49, 0xc4, 0x60, 0xc4, 0x5c, 0x35,
+	0x1e, 0xf8, 0x59, 0x60, 0x1d, 0xac, 0xa6, 0x41, 0x61, 0xbf, 0xb4, 0x50, 0x55, 0xac, 0x82, 0x9b,
+	0xd9, 0x5a, 0x1d, 0xac, 0x65, 0xd5, 0xb6, 0x71, 0x58, 0xca, 0x57, 0x15, 0x6b, 0xb1, 0xb1, 0x3e,
+	0x3e, 0xaf, 0xa8, 0x4d, 0x79, 0xdf, 0xda, 0x75, 0xd5, 0x0c, 0xd4, 0x0a, 0x35, 0x08, 0x56, 0x42,
+	0x18, 0x13, 0x8a, 0x59, 0x69, 0xb1, 0x9a, 0xb7, 0xd4, 0x7a, 0xd9, 0x16, 0xa5, 0x24, 0x0a, 0x4f,
+	0xd4, 0x82, 0xa3, 0x46, 0xed, 0xf4, 0xbc, 0x92, 0xfb, 0xf6, 0xbb, 0x62, 0x21, 0xcc, 0x3a, 0x03,
+	0x3f, 0x99, 0x8e, 0x50, 0x58, 0x7c, 0xb6, 0x69, 0xf8, 0xd9, 0x61, 0xa3, 0x18, 0x52, 0x4e, 0xa0,
+	0xae, 0x8c, 0xad, 0x3d, 0x04, 0x05, 0x78, 0x1c, 0xc3, 0x10, 0x33, 0x18, 0x96, 0x96, 0xaa, 0x8a,
+	0xb5, 0xea, 0x5e, 0x5e, 0x98, 0x6f, 0x40, 0x79, 0x4a, 0x42, 0x17, 0xd2, 0x98, 0x44, 0x14, 0x6a,
+	0x0e, 0x50, 0xa5, 0x8c, 0x49, 0x53, 0x0a, 0x6f, 0xaa, 0x38, 0x3e, 0xaf, 0x00, 0x09, 0x6d, 0xed,
+	0xba, 0x40, 0x42, 0x5a, 0xa1, 0xf9, 0x4f, 0x01, 0x2b, 0x7b, 0x14, 0x7d, 0x24, 0xec, 0xee, 0x64,
+	0x6d, 0x13, 0x2c, 0x0d, 0x09, 0xcb, 0xc4, 0x4d, 0x0d, 0xed, 0x25, 0x28, 0x24, 0x87, 0x76, 0xd2,
+	0x1b, 0x97, 0xb5, 0x58, 0xaf, 0xd8, 0x37, 0x2e, 0xa8, 0x9d, 0xa4, 0xfd, 0x30, 0x8a, 0xa1, 0xbb,
+	0x3a, 0x14, 0x27, 0xed, 0x10, 0xac, 0x90, 0x98, 0x61, 0x12, 0x51, 0xa1, 0xf1, 0xd3, 0x19, 0xdc,
+	0x03, 0x88, 0x51, 0x87, 0xc1, 0x30, 0x89, 0xf1, 0x8e, 0x33, 0x1a, 0x5b, 0x42, 0xf3, 0x7b, 0xd3,
+	0x3e, 0xea, 0xca, 0xb0, 0xe6, 0x06, 0x58, 0x17, 0x1d, 0x4b, 0xd9, 0xcc, 0x23, 0x7e, 0xd5, 0xec,
+	0x78, 0x11, 0x82, 0xfb, 0x5e, 0xdf, 0xeb, 0xd1, 0x64, 0x08, 0xde, 0x80, 0x75, 0x48, 0x1f, 0xb3,
+	0x11, 0x97, 0xa2, 0xe0, 0x5e, 0x5e, 0x68, 0x2f, 0xc0, 0x72, 0xcc, 0x71, 0xbc, 0x75, 0xb5, 0xfe,
+	0x68, 0x46, 0x91, 0x69, 0x30, 0x57, 0x80, 0xcd, 0x32, 0x78, 0x70, 0x2d, 0x4f, 0x56, 0xc2, 0x21,
+	0xff, 0x33, 0x9a, 0x5e, 0x14, 0xc0, 0x6e, 0xb6, 0xc0, 0x77, 0x9e, 0xc8, 0x9c, 0x8d, 0x37, 0xb7,
+	0x40, 0x79, 0x2a, 0x83, 0x4c, 0x5f, 0xff, 0x9a, 0x07, 0xf9, 0x3d, 0x8a, 0xb4, 0x2e, 0x28, 0x5e,
+	0xfb, 0x3b, 0xad, 0x19, 0xad, 0x4d, 0x2d, 0xa1, 0x5e, 0xbb, 0x2d, 0x32, 0x5b, 0xd7, 0xb7, 0x60,
+	0x91, 0x6f, 0x9e, 0x31, 0x9b, 0x99, 0xf8, 0xf5, 0x27, 0xf3, 0xfd, 0x59, 0xbc, 0x23, 0xb0, 0x76,
+	0x65, 0x88, 0x73, 0x78, 0x93, 0x38, 0xdd, 0xbe, 0x1d, 0x2e, 0xcb, 0xd3, 0x05, 0xc5, 0x6b, 0x93,
+	0x9a, 0xa3, 0xd2, 0x55, 0xa4, 0x5e, 0xbb, 0x2d, 0x52, 0x66, 0x6b, 0xbc, 0x3e, 0xfd, 0x6b, 0xe4,
+	0x4e, 0xc7, 0x86, 0x72, 0x36, 0x36, 0x94, 0x3f, 0x63, 0x43, 0xf9, 0x72, 0x61, 0xe4, 0xce, 0x2e,
+	0x8c, 0xdc, 0xaf, 0x0b, 0x23, 0xf7, 0xe9, 0xd9, 0xc4, 0x03, 0x53, 0x43, 0x5d, 0xcf, 0xa7, 0x4e,
+	0x0d, 0x6d, 0x07, 0x1d, 0x0f, 0x47, 0xce, 0xf1, 0xc4, 0x8b, 0xcc, 0x9f, 0x1a, 0x7f, 0x99, 0xbf,
+	0xa6, 0xcf, 0xff, 0x0f, 0x00, 0x2f, 0x82, 0xc0, 0x94, 0x52, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -243,6 +420,10 @@ type MsgClient interface {
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
 	// Vote defines a method for voting on a proposal
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// ChangeParams defines a method for changing the module params through governance
+	ChangeParams(ctx context.Context, in *MsgChangeParams, opts ...grpc.CallOption) (*MsgChangeParamsResponse, error)
+	// CancelProposal defines a method for the proposer to cancel an active proposal
+	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
 }
 
 type msgClient struct {
@@ -271,12 +452,34 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) ChangeParams(ctx context.Context, in *MsgChangeParams, opts ...grpc.CallOption) (*MsgChangeParamsResponse, error) {
+	out := new(MsgChangeParamsResponse)
+	err := c.cc.Invoke(ctx, "/zgc.committee.v1beta1.Msg/ChangeParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error) {
+	out := new(MsgCancelProposalResponse)
+	err := c.cc.Invoke(ctx, "/zgc.committee.v1beta1.Msg/CancelProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method for submitting a committee proposal
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// Vote defines a method for voting on a proposal
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// ChangeParams defines a method for changing the module params through governance
+	ChangeParams(context.Context, *MsgChangeParams) (*MsgChangeParamsResponse, error)
+	// CancelProposal defines a method for the proposer to cancel an active proposal
+	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -289,6 +492,12 @@ func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmi
 func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (*UnimplementedMsgServer) ChangeParams(ctx context.Context, req *MsgChangeParams) (*MsgChangeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeParams not implemented")
+}
+func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -330,6 +539,42 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ChangeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.committee.v1beta1.Msg/ChangeParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeParams(ctx, req.(*MsgChangeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.committee.v1beta1.Msg/CancelProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProposal(ctx, req.(*MsgCancelProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zgc.committee.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -342,6 +587,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "ChangeParams",
+			Handler:    _Msg_ChangeParams_Handler,
+		},
+		{
+			MethodName: "CancelProposal",
+			Handler:    _Msg_CancelProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zgc/committee/v1beta1/tx.proto",
@@ -367,6 +620,30 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.CommitteeID != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.CommitteeID))
 		i--
@@ -442,6 +719,20 @@ func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.VoteType != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.VoteType))
 		i--
@@ -485,6 +776,129 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgChangeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -513,6 +927,15 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.CommitteeID != 0 {
 		n += 1 + sovTx(uint64(m.CommitteeID))
 	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Expedited {
+		n += 2
+	}
 	return n
 }
 
@@ -544,6 +967,12 @@ func (m *MsgVote) Size() (n int) {
 	if m.VoteType != 0 {
 		n += 1 + sovTx(uint64(m.VoteType))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -556,11 +985,62 @@ func (m *MsgVoteResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgChangeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTx(uint64(m.ProposalID))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -678,6 +1158,60 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types1.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -867,6 +1401,40 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -938,6 +1506,325 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgChangeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
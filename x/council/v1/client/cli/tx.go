@@ -2,13 +2,11 @@ package cli
 
 import (
 	"bufio"
-	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
 
-	sdkmath "cosmossdk.io/math"
 	"github.com/0glabs/0g-chain/crypto/vrf"
 	"github.com/0glabs/0g-chain/x/council/v1/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -35,6 +33,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewRegisterCmd(),
 		NewVoteCmd(),
+		NewDeregisterCmd(),
+		NewUpdateKeyCmd(),
 	)
 	return cmd
 }
@@ -53,48 +53,8 @@ func NewRegisterCmd() *cobra.Command {
 				return err
 			}
 
-			kr := clientCtx.Keyring
-			// get account name by address
-			accAddr := clientCtx.GetFromAddress()
-			accRecord, err := kr.KeyByAddress(accAddr)
-			if err != nil {
-				// not found record by address in keyring
-				return nil
-			}
-
-			// check voter account record exists
-			voterAccName := accRecord.Name + "-voter"
-			_, err = kr.Key(voterAccName)
-			if err == nil {
-				// account exists, ask for user confirmation
-				response, err2 := input.GetConfirmation(fmt.Sprintf("override the existing name %s", voterAccName), bufio.NewReader(clientCtx.Input), cmd.ErrOrStderr())
-				if err2 != nil {
-					return err2
-				}
-
-				if !response {
-					return errors.New("aborted")
-				}
-
-				err2 = kr.Delete(voterAccName)
-				if err2 != nil {
-					return err2
-				}
-			}
-
-			keyringAlgos, _ := kr.SupportedAlgorithms()
-			algo, err := sdkkr.NewSigningAlgoFromString("vrf", keyringAlgos)
-			if err != nil {
-				return err
-			}
-
-			newRecord, err := kr.NewAccount(voterAccName, "", "", "", algo)
-			if err != nil {
-				return err
-			}
-
-			pubKey, err := newRecord.GetPubKey()
-			if err != nil {
+			key, err := newVoterKey(cmd, clientCtx)
+			if err != nil || key == nil {
 				return err
 			}
 
@@ -105,7 +65,7 @@ func NewRegisterCmd() *cobra.Command {
 
 			msg := &types.MsgRegister{
 				Voter: valAddr.String(),
-				Key:   pubKey.Bytes(),
+				Key:   key,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -118,7 +78,7 @@ func NewRegisterCmd() *cobra.Command {
 func NewVoteCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "vote council-id",
-		Short: "Vote on a proposal",
+		Short: "Vote for a council with VRF ballots",
 		Args:  cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -156,31 +116,33 @@ func NewVoteCmd() *cobra.Command {
 				return err
 			}
 
-			votingStartHeight := types.DefaultVotingStartHeight + (councilID-1)*types.DefaultVotingPeriod
-
-			rsp, err := stakingtypes.NewQueryClient(clientCtx).HistoricalInfo(cmd.Context(), &stakingtypes.QueryHistoricalInfoRequest{Height: int64(votingStartHeight)})
+			queryClient := types.NewQueryClient(clientCtx)
+			params, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			seed, err := queryClient.VrfSeed(cmd.Context(), &types.QueryVrfSeedRequest{CouncilId: councilID})
+			if err != nil {
+				return err
+			}
+			validator, err := stakingtypes.NewQueryClient(clientCtx).Validator(cmd.Context(), &stakingtypes.QueryValidatorRequest{ValidatorAddr: valAddr.String()})
 			if err != nil {
 				return err
 			}
 
-			var tokens sdkmath.Int
-			for _, val := range rsp.Hist.Valset {
-				thisValAddr := val.GetOperator()
-
-				if thisValAddr.Equals(valAddr) {
-					tokens = val.GetTokens()
-				}
+			// the number of ballots is bounded by the bonded tokens
+			numBallots := params.Params.MaxBallots(validator.Validator.GetBondedTokens())
+			if numBallots == 0 {
+				return fmt.Errorf("insufficient bonded tokens to vote")
 			}
-			// the denom of token is evm denom, need to convert to A0GI
-			a0giTokenCnt := tokens.Quo(sdk.NewInt(1_000_000_000_000_000_000))
-			// 1_000 0AGI token / vote
-			numBallots := a0giTokenCnt.Quo(sdk.NewInt(1_000)).Uint64()
 			ballots := make([]*types.Ballot, numBallots)
 			for i := range ballots {
 				ballotID := uint64(i)
+				content, proof := sk.Prove(types.BallotVrfInput(seed.Seed, ballotID))
 				ballots[i] = &types.Ballot{
 					ID:      ballotID,
-					Content: sk.Compute(bytes.Join([][]byte{rsp.Hist.Header.LastCommitHash, types.Uint64ToBytes(ballotID)}, nil)),
+					Content: content,
+					Proof:   proof,
 				}
 			}
 
@@ -196,3 +158,116 @@ func NewVoteCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// newVoterKey generates a new VRF key named after the from account in the keyring,
+// an existing key is overridden on confirmation.
+func newVoterKey(cmd *cobra.Command, clientCtx client.Context) ([]byte, error) {
+	kr := clientCtx.Keyring
+	// get account name by address
+	accAddr := clientCtx.GetFromAddress()
+	accRecord, err := kr.KeyByAddress(accAddr)
+	if err != nil {
+		// not found record by address in keyring
+		return nil, nil
+	}
+
+	// check voter account record exists
+	voterAccName := accRecord.Name + "-voter"
+	_, err = kr.Key(voterAccName)
+	if err == nil {
+		// account exists, ask for user confirmation
+		response, err2 := input.GetConfirmation(fmt.Sprintf("override the existing name %s", voterAccName), bufio.NewReader(clientCtx.Input), cmd.ErrOrStderr())
+		if err2 != nil {
+			return nil, err2
+		}
+
+		if !response {
+			return nil, errors.New("aborted")
+		}
+
+		err2 = kr.Delete(voterAccName)
+		if err2 != nil {
+			return nil, err2
+		}
+	}
+
+	keyringAlgos, _ := kr.SupportedAlgorithms()
+	algo, err := sdkkr.NewSigningAlgoFromString("vrf", keyringAlgos)
+	if err != nil {
+		return nil, err
+	}
+
+	newRecord, err := kr.NewAccount(voterAccName, "", "", "", algo)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := newRecord.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	return pubKey.Bytes(), nil
+}
+
+func NewDeregisterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister",
+		Short: "Deregister a voter, removing its votes of the councils in voting",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromHex(hex.EncodeToString(clientCtx.GetFromAddress().Bytes()))
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDeregister{
+				Voter: valAddr.String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewUpdateKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-key",
+		Short: "Replace the VRF key of a voter, removing its votes of the councils in voting",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// bypass the restriction of set keyring options
+			ctx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(vrf.VrfOption())
+			client.SetCmdClientContext(cmd, ctx)
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := newVoterKey(cmd, clientCtx)
+			if err != nil || key == nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromHex(hex.EncodeToString(clientCtx.GetFromAddress().Bytes()))
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateKey{
+				Voter: valAddr.String(),
+				Key:   key,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	vrfalgo "github.com/coniks-sys/coniks-go/crypto/vrf"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/0glabs/0g-chain/x/council/v1/keeper"
+	"github.com/0glabs/0g-chain/x/council/v1/testutil"
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+type AbciTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *AbciTestSuite) setParams(policy types.ElectionPolicy) {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.CouncilSize = 3
+	params.ElectionPolicy = policy
+	suite.Require().NoError(suite.Keeper.SetParams(suite.Ctx, params))
+}
+
+// startVoting moves to the start of the current council, when the voting of the next council starts.
+func (suite *AbciTestSuite) startVoting() types.Council {
+	council, found := suite.Keeper.GetCouncil(suite.Ctx, 1)
+	suite.Require().True(found)
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(council.StartHeight))
+	suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+	next, found := suite.Keeper.GetCouncil(suite.Ctx, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(council.StartHeight, next.VotingStartHeight)
+	suite.Require().Equal(council.EndHeight, next.StartHeight)
+	return next
+}
+
+// endCouncil moves to the end of the current council.
+func (suite *AbciTestSuite) endCouncil() {
+	councilID, err := suite.Keeper.GetCurrentCouncilID(suite.Ctx)
+	suite.Require().NoError(err)
+	council, found := suite.Keeper.GetCouncil(suite.Ctx, councilID)
+	suite.Require().True(found)
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(council.EndHeight))
+	suite.Require().NotPanics(func() {
+		suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+	})
+}
+
+// addVoter adds a registered voter entitled to the number of ballots.
+func (suite *AbciTestSuite) addVoter(ballots uint64) (sdk.ValAddress, vrfalgo.PrivateKey) {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	voter := suite.AddValidator(params.TokensPerBallot * ballots)
+	return voter, suite.AddVoter(voter)
+}
+
+func (suite *AbciTestSuite) vote(councilID uint64, voter sdk.ValAddress, sk vrfalgo.PrivateKey, ids ...uint64) {
+	suite.Require().NoError(suite.Keeper.AddVote(suite.Ctx, councilID, voter, suite.Ballots(councilID, sk, ids...)))
+}
+
+func (suite *AbciTestSuite) requireElected(councilID uint64, members ...sdk.ValAddress) {
+	currentID, err := suite.Keeper.GetCurrentCouncilID(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(councilID, currentID)
+	council, found := suite.Keeper.GetCouncil(suite.Ctx, councilID)
+	suite.Require().True(found)
+	suite.Require().ElementsMatch(members, council.Members)
+	_, broken := keeper.AllInvariants(suite.Keeper)(suite.Ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *AbciTestSuite) TestBeginBlock_FullCouncil() {
+	suite.setParams(types.ELECTION_POLICY_CARRY_OVER)
+	council := suite.startVoting()
+	voters := make([]sdk.ValAddress, 4)
+	for i := range voters {
+		voter, sk := suite.addVoter(1)
+		suite.vote(council.ID, voter, sk, 0)
+		voters[i] = voter
+	}
+	// the voting of the next council is not restarted
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+	suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+	stored, found := suite.Keeper.GetCouncil(suite.Ctx, council.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(council, stored)
+
+	suite.endCouncil()
+	elected := suite.Keeper.TallyBallots(suite.Ctx, council.ID, 3)
+	suite.Require().Len(elected, 3)
+	suite.requireElected(council.ID, elected...)
+	// the voting of the following council starts
+	_, found = suite.Keeper.GetCouncil(suite.Ctx, council.ID+1)
+	suite.Require().False(found)
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+	suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+	_, found = suite.Keeper.GetCouncil(suite.Ctx, council.ID+1)
+	suite.Require().True(found)
+}
+
+func (suite *AbciTestSuite) TestBeginBlock_CarryOver() {
+	suite.setParams(types.ELECTION_POLICY_CARRY_OVER)
+	previous, found := suite.Keeper.GetCouncil(suite.Ctx, 1)
+	suite.Require().True(found)
+	previous.Members = []sdk.ValAddress{suite.AddValidator(1), suite.AddValidator(1)}
+	suite.Keeper.SetCouncil(suite.Ctx, previous)
+	council := suite.startVoting()
+
+	// zero ballots
+	suite.endCouncil()
+	suite.requireElected(council.ID, previous.Members...)
+}
+
+func (suite *AbciTestSuite) TestBeginBlock_CarryOverPartial() {
+	suite.setParams(types.ELECTION_POLICY_CARRY_OVER)
+	previous, found := suite.Keeper.GetCouncil(suite.Ctx, 1)
+	suite.Require().True(found)
+	voter, sk := suite.addVoter(2)
+	carried := suite.AddValidator(1)
+	previous.Members = []sdk.ValAddress{voter, carried, suite.AddValidator(1)}
+	suite.Keeper.SetCouncil(suite.Ctx, previous)
+	council := suite.startVoting()
+
+	// duplicated ballots of a voter take one seat, the re-elected member is not carried over twice
+	suite.vote(council.ID, voter, sk, 0, 1)
+	other, otherSk := suite.addVoter(1)
+	suite.vote(council.ID, other, otherSk, 0)
+	suite.endCouncil()
+	suite.requireElected(council.ID, voter, other, carried)
+}
+
+func (suite *AbciTestSuite) TestBeginBlock_CarryOverUnbonded() {
+	suite.setParams(types.ELECTION_POLICY_CARRY_OVER)
+	previous, found := suite.Keeper.GetCouncil(suite.Ctx, 1)
+	suite.Require().True(found)
+	bonded, unbonded := suite.AddValidator(1), suite.AddValidator(1)
+	previous.Members = []sdk.ValAddress{bonded, unbonded}
+	suite.Keeper.SetCouncil(suite.Ctx, previous)
+	council := suite.startVoting()
+
+	// the members left the active set are not carried over
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, unbonded)
+	suite.Require().True(found)
+	validator.Status = stakingtypes.Unbonding
+	suite.StakingKeeper.SetValidator(suite.Ctx, validator)
+	suite.endCouncil()
+	suite.requireElected(council.ID, bonded)
+}
+
+func (suite *AbciTestSuite) TestBeginBlock_TopValidators() {
+	suite.setParams(types.ELECTION_POLICY_TOP_VALIDATORS)
+	council := suite.startVoting()
+	voter, sk := suite.addVoter(5)
+	suite.vote(council.ID, voter, sk, 0, 1, 2, 3, 4)
+	suite.AddValidator(100)
+	suite.AddValidator(200)
+
+	suite.endCouncil()
+	expected := []sdk.ValAddress{voter}
+	for _, validator := range suite.StakingKeeper.GetBondedValidatorsByPower(suite.Ctx) {
+		if len(expected) == 3 {
+			break
+		}
+		if !validator.GetOperator().Equals(voter) {
+			expected = append(expected, validator.GetOperator())
+		}
+	}
+	suite.requireElected(council.ID, expected...)
+}
+
+func (suite *AbciTestSuite) TestBeginBlock_ExtendVoting() {
+	suite.setParams(types.ELECTION_POLICY_EXTEND_VOTING)
+	previous, found := suite.Keeper.GetCouncil(suite.Ctx, 1)
+	suite.Require().True(found)
+	council := suite.startVoting()
+	votingPeriod, err := suite.Keeper.GetVotingPeriod(suite.Ctx)
+	suite.Require().NoError(err)
+
+	voters := make([]sdk.ValAddress, 3)
+	sks := make([]vrfalgo.PrivateKey, 3)
+	for i := range voters {
+		voters[i], sks[i] = suite.addVoter(1)
+	}
+	// zero and partial ballots extend the voting
+	for i := 0; i < 3; i++ {
+		suite.endCouncil()
+		currentID, err := suite.Keeper.GetCurrentCouncilID(suite.Ctx)
+		suite.Require().NoError(err)
+		suite.Require().Equal(previous.ID, currentID)
+		extended, found := suite.Keeper.GetCouncil(suite.Ctx, council.ID)
+		suite.Require().True(found)
+		suite.Require().Equal(council.StartHeight+votingPeriod*uint64(i+1), extended.StartHeight)
+		suite.Require().Equal(council.EndHeight+votingPeriod*uint64(i+1), extended.EndHeight)
+		suite.Require().Empty(extended.Members)
+		_, broken := keeper.AllInvariants(suite.Keeper)(suite.Ctx)
+		suite.Require().False(broken)
+		suite.vote(council.ID, voters[i], sks[i], 0)
+	}
+	suite.endCouncil()
+	suite.requireElected(council.ID, voters...)
+}
+
+func TestAbciSuite(t *testing.T) {
+	suite.Run(t, new(AbciTestSuite))
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+// ElectCouncil elects the members of the council succeeding the previous one from the ballots.
+// The ballots are ordered by their VRF outputs and each voter takes at most one seat, the seats
+// left vacant are filled according to the election policy.
+func (k Keeper) ElectCouncil(ctx sdk.Context, previous types.Council, council types.Council) error {
+	params := k.GetParams(ctx)
+	members := k.TallyBallots(ctx, council.ID, params.CouncilSize)
+	if uint64(len(members)) < params.CouncilSize {
+		switch params.ElectionPolicy {
+		case types.ELECTION_POLICY_CARRY_OVER:
+			// the previous members whose validators left the active set are not carried over
+			candidates := []sdk.ValAddress{}
+			for _, member := range previous.Members {
+				if validator, found := k.stakingKeeper.GetValidator(ctx, member); found && validator.IsBonded() {
+					candidates = append(candidates, member)
+				}
+			}
+			members = fillSeats(members, candidates, params.CouncilSize)
+		case types.ELECTION_POLICY_TOP_VALIDATORS:
+			validators := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+			candidates := make([]sdk.ValAddress, len(validators))
+			for i, validator := range validators {
+				candidates[i] = validator.GetOperator()
+			}
+			members = fillSeats(members, candidates, params.CouncilSize)
+		case types.ELECTION_POLICY_EXTEND_VOTING:
+			return k.extendVoting(ctx, previous, council)
+		default:
+			return fmt.Errorf("unknown election policy %v", params.ElectionPolicy)
+		}
+	}
+
+	council.Members = members
+	k.SetCouncil(ctx, council)
+	if err := k.IncrementCurrentCouncilID(ctx); err != nil {
+		return err
+	}
+
+	addrs := make([]string, len(members))
+	for i, member := range members {
+		addrs[i] = member.String()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeElect,
+			sdk.NewAttribute(types.AttributeKeyCouncilID, fmt.Sprintf("%d", council.ID)),
+			sdk.NewAttribute(types.AttributeKeyElectionPolicy, params.ElectionPolicy.String()),
+			sdk.NewAttribute(types.AttributeKeyMembers, strings.Join(addrs, ",")),
+		),
+	)
+	return nil
+}
+
+// TallyBallots returns up to size distinct voters of the council in the order of their lowest ballots,
+// duplicated ballot contents are counted once.
+func (k Keeper) TallyBallots(ctx sdk.Context, councilID uint64, size uint64) []sdk.ValAddress {
+	members := []sdk.ValAddress{}
+	for _, ballot := range k.RankBallots(ctx, councilID, size) {
+		if ballot.Elected {
+			members = append(members, ballot.Voter)
+		}
+	}
+	return members
+}
+
+// RankBallots returns the ballots of the council ordered by their contents, duplicated ballot contents
+// are counted once. The first ballot of each voter is elected until size voters are elected.
+func (k Keeper) RankBallots(ctx sdk.Context, councilID uint64, size uint64) []types.RankedBallot {
+	ballots := []types.RankedBallot{}
+	seen := make(map[string]struct{})
+	for _, vote := range k.GetVotesByCouncil(ctx, councilID) {
+		for _, ballot := range vote.Ballots {
+			if _, ok := seen[string(ballot.Content)]; ok {
+				continue
+			}
+			ballots = append(ballots, types.RankedBallot{
+				Voter:    vote.Voter,
+				BallotID: ballot.ID,
+				Content:  ballot.Content,
+			})
+			seen[string(ballot.Content)] = struct{}{}
+		}
+	}
+	sort.Slice(ballots, func(i, j int) bool {
+		return bytes.Compare(ballots[i].Content, ballots[j].Content) < 0
+	})
+
+	elected := make(map[string]struct{})
+	for i := range ballots {
+		if uint64(len(elected)) >= size {
+			break
+		}
+		if _, ok := elected[string(ballots[i].Voter)]; ok {
+			continue
+		}
+		ballots[i].Elected = true
+		elected[string(ballots[i].Voter)] = struct{}{}
+	}
+	return ballots
+}
+
+// extendVoting keeps the previous council and postpones the council by a voting period,
+// the votes cast so far stay valid.
+func (k Keeper) extendVoting(ctx sdk.Context, previous types.Council, council types.Council) error {
+	votingPeriod, err := k.GetVotingPeriod(ctx)
+	if err != nil {
+		return err
+	}
+	previous.EndHeight += votingPeriod
+	council.StartHeight += votingPeriod
+	council.EndHeight += votingPeriod
+	k.SetCouncil(ctx, previous)
+	k.SetCouncil(ctx, council)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExtend,
+			sdk.NewAttribute(types.AttributeKeyCouncilID, fmt.Sprintf("%d", council.ID)),
+			sdk.NewAttribute(types.AttributeKeyVotingEndHeight, fmt.Sprintf("%d", council.StartHeight)),
+		),
+	)
+	return nil
+}
+
+// fillSeats appends the candidates not elected yet to the members until the size is reached.
+func fillSeats(members []sdk.ValAddress, candidates []sdk.ValAddress, size uint64) []sdk.ValAddress {
+	for _, candidate := range candidates {
+		if uint64(len(members)) >= size {
+			break
+		}
+		if containsMember(members, candidate) {
+			continue
+		}
+		members = append(members, candidate)
+	}
+	return members
+}
+
+func containsMember(members []sdk.ValAddress, addr sdk.ValAddress) bool {
+	for _, member := range members {
+		if member.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,8 +3,12 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/0glabs/0g-chain/x/council/v1/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -33,3 +37,159 @@ func (k Keeper) RegisteredVoters(
 	}
 	return &types.QueryRegisteredVotersResponse{Voters: voters}, nil
 }
+
+func (k Keeper) Council(
+	c context.Context,
+	request *types.QueryCouncilRequest,
+) (*types.QueryCouncilResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	council, found := k.GetCouncil(ctx, request.CouncilId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrUnknownCouncil, "%d", request.CouncilId)
+	}
+	return &types.QueryCouncilResponse{Council: council}, nil
+}
+
+func (k Keeper) VrfSeed(
+	c context.Context,
+	request *types.QueryVrfSeedRequest,
+) (*types.QueryVrfSeedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	council, found := k.GetCouncil(ctx, request.CouncilId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrUnknownCouncil, "%d", request.CouncilId)
+	}
+	return &types.QueryVrfSeedResponse{Seed: k.GetVrfSeed(ctx, council)}, nil
+}
+
+func (k Keeper) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) Councils(
+	c context.Context,
+	request *types.QueryCouncilsRequest,
+) (*types.QueryCouncilsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CouncilKeyPrefix)
+	councils := make([]types.Council, 0)
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var council types.Council
+		if err := k.cdc.Unmarshal(value, &council); err != nil {
+			return err
+		}
+		councils = append(councils, council)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCouncilsResponse{Councils: councils, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Votes(
+	c context.Context,
+	request *types.QueryVotesRequest,
+) (*types.QueryVotesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetCouncil(ctx, request.CouncilId); !found {
+		return nil, errorsmod.Wrapf(types.ErrUnknownCouncil, "%d", request.CouncilId)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.VoteKeyPrefix, types.GetKeyFromID(request.CouncilId)...))
+	votes := make([]types.Vote, 0)
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var vote types.Vote
+		if err := k.cdc.Unmarshal(value, &vote); err != nil {
+			return err
+		}
+		votes = append(votes, vote)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Voters(
+	c context.Context,
+	request *types.QueryVotersRequest,
+) (*types.QueryVotersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoterKeyPrefix)
+	voters := make([]types.VoterKey, 0)
+	pageRes, err := query.Paginate(store, request.Pagination, func(key []byte, value []byte) error {
+		voters = append(voters, types.VoterKey{
+			Voter:     sdk.ValAddress(key).String(),
+			PublicKey: value,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryVotersResponse{Voters: voters, Pagination: pageRes}, nil
+}
+
+func (k Keeper) VoterKey(
+	c context.Context,
+	request *types.QueryVoterKeyRequest,
+) (*types.QueryVoterKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	voter, err := sdk.ValAddressFromBech32(request.Voter)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	pk, found := k.GetVoter(ctx, voter)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrVoterNotFound, "%s", voter)
+	}
+	return &types.QueryVoterKeyResponse{VoterKey: types.VoterKey{Voter: voter.String(), PublicKey: pk}}, nil
+}
+
+func (k Keeper) Ranking(
+	c context.Context,
+	request *types.QueryRankingRequest,
+) (*types.QueryRankingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetCouncil(ctx, request.CouncilId); !found {
+		return nil, errorsmod.Wrapf(types.ErrUnknownCouncil, "%d", request.CouncilId)
+	}
+	ballots := k.RankBallots(ctx, request.CouncilId, k.GetParams(ctx).CouncilSize)
+	start, end, pageRes, err := paginateSlice(len(ballots), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRankingResponse{Ballots: ballots[start:end], Pagination: pageRes}, nil
+}
+
+// paginateSlice returns the bounds of the page of a slice in memory, only offset based pagination is supported.
+func paginateSlice(length int, pageReq *query.PageRequest) (int, int, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 {
+		return 0, 0, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "key based pagination is not supported")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	start := pageReq.Offset
+	if start > uint64(length) {
+		start = uint64(length)
+	}
+	end := start + limit
+	if end > uint64(length) {
+		end = uint64(length)
+	}
+	pageRes := &query.PageResponse{}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(length)
+	}
+	return int(start), int(end), pageRes, nil
+}
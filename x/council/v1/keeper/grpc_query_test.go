@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/0glabs/0g-chain/x/council/v1/testutil"
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+type GrpcQueryTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *GrpcQueryTestSuite) TestCouncils() {
+	suite.Require().NoError(suite.Keeper.StoreNewCouncil(suite.Ctx, 10))
+	suite.Require().NoError(suite.Keeper.StoreNewCouncil(suite.Ctx, 20))
+
+	res, err := suite.QueryClient.Councils(sdk.WrapSDKContext(suite.Ctx), &types.QueryCouncilsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Councils, 1)
+	suite.Require().Equal(uint64(1), res.Councils[0].ID)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.QueryClient.Councils(sdk.WrapSDKContext(suite.Ctx), &types.QueryCouncilsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Councils, 1)
+	suite.Require().Equal(uint64(2), res.Councils[0].ID)
+	suite.Require().Equal(uint64(20), res.Councils[0].VotingStartHeight)
+}
+
+func (suite *GrpcQueryTestSuite) TestVotesAndRanking() {
+	council, found := suite.Keeper.GetCouncil(suite.Ctx, 1)
+	suite.Require().True(found)
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.CouncilSize = 2
+	suite.Require().NoError(suite.Keeper.SetParams(suite.Ctx, params))
+
+	voters := make([]sdk.ValAddress, 3)
+	for i := range voters {
+		voters[i] = suite.AddValidator(params.TokensPerBallot * 2)
+		sk := suite.AddVoter(voters[i])
+		suite.Require().NoError(suite.Keeper.AddVote(suite.Ctx, council.ID, voters[i], suite.Ballots(council.ID, sk, 0, 1)))
+	}
+
+	votes, err := suite.QueryClient.Votes(sdk.WrapSDKContext(suite.Ctx), &types.QueryVotesRequest{
+		CouncilId:  council.ID,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(votes.Votes, 2)
+	suite.Require().Equal(uint64(3), votes.Pagination.Total)
+	suite.Require().NotNil(votes.Pagination.NextKey)
+
+	ranking, err := suite.QueryClient.Ranking(sdk.WrapSDKContext(suite.Ctx), &types.QueryRankingRequest{CouncilId: council.ID})
+	suite.Require().NoError(err)
+	suite.Require().Len(ranking.Ballots, 6)
+	elected := []sdk.ValAddress{}
+	for i, ballot := range ranking.Ballots {
+		if i > 0 {
+			suite.Require().Less(string(ranking.Ballots[i-1].Content), string(ballot.Content))
+		}
+		if ballot.Elected {
+			elected = append(elected, ballot.Voter)
+		}
+	}
+	suite.Require().Equal(suite.Keeper.TallyBallots(suite.Ctx, council.ID, params.CouncilSize), elected)
+
+	page, err := suite.QueryClient.Ranking(sdk.WrapSDKContext(suite.Ctx), &types.QueryRankingRequest{
+		CouncilId:  council.ID,
+		Pagination: &query.PageRequest{Offset: 4, Limit: 4, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ranking.Ballots[4:], page.Ballots)
+	suite.Require().Equal(uint64(6), page.Pagination.Total)
+
+	_, err = suite.QueryClient.Ranking(sdk.WrapSDKContext(suite.Ctx), &types.QueryRankingRequest{CouncilId: 10})
+	suite.Require().ErrorContains(err, types.ErrUnknownCouncil.Error())
+	_, err = suite.QueryClient.Votes(sdk.WrapSDKContext(suite.Ctx), &types.QueryVotesRequest{CouncilId: 10})
+	suite.Require().ErrorContains(err, types.ErrUnknownCouncil.Error())
+}
+
+func (suite *GrpcQueryTestSuite) TestVoters() {
+	voters := make([]sdk.ValAddress, 3)
+	for i := range voters {
+		voters[i] = suite.AddValidator(1)
+		suite.AddVoter(voters[i])
+	}
+
+	res, err := suite.QueryClient.Voters(sdk.WrapSDKContext(suite.Ctx), &types.QueryVotersRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Voters, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	pk, found := suite.Keeper.GetVoter(suite.Ctx, voters[0])
+	suite.Require().True(found)
+	key, err := suite.QueryClient.VoterKey(sdk.WrapSDKContext(suite.Ctx), &types.QueryVoterKeyRequest{Voter: voters[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(voters[0].String(), key.VoterKey.Voter)
+	suite.Require().Equal([]byte(pk), key.VoterKey.PublicKey)
+
+	_, err = suite.QueryClient.VoterKey(sdk.WrapSDKContext(suite.Ctx), &types.QueryVoterKeyRequest{Voter: sdk.ValAddress("unknown").String()})
+	suite.Require().ErrorContains(err, types.ErrVoterNotFound.Error())
+	_, err = suite.QueryClient.VoterKey(sdk.WrapSDKContext(suite.Ctx), &types.QueryVoterKeyRequest{Voter: "invalid"})
+	suite.Require().Error(err)
+}
+
+func TestGrpcQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(GrpcQueryTestSuite))
+}
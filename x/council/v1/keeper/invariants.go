@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+// RegisterInvariants registers the council module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "current-council", CurrentCouncilInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "council-schedule", CouncilScheduleInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "council-members", CouncilMembersInvariant(k))
+}
+
+// AllInvariants runs all invariants of the council module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := CurrentCouncilInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := CouncilScheduleInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return CouncilMembersInvariant(k)(ctx)
+	}
+}
+
+// CurrentCouncilInvariant checks the current council is stored.
+func CurrentCouncilInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		broken := false
+		msg := ""
+		councilID, err := k.GetCurrentCouncilID(ctx)
+		if err != nil {
+			broken = true
+			msg = err.Error()
+		} else if _, found := k.GetCouncil(ctx, councilID); !found {
+			broken = true
+			msg = fmt.Sprintf("current council %d not found", councilID)
+		}
+		return sdk.FormatInvariant(types.ModuleName, "current council", msg), broken
+	}
+}
+
+// CouncilScheduleInvariant checks the heights of each council are ordered, and the voting of a council
+// starts when its predecessor starts serving, and it starts serving when its predecessor ends.
+func CouncilScheduleInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		broken := false
+		msg := ""
+		var previous *types.Council
+		k.IterateCouncil(ctx, func(council types.Council) bool {
+			if council.VotingStartHeight > council.StartHeight || council.StartHeight > council.EndHeight {
+				broken = true
+				msg = fmt.Sprintf("unordered heights of council %d", council.ID)
+				return true
+			}
+			if previous != nil && previous.ID+1 == council.ID &&
+				(council.VotingStartHeight != previous.StartHeight || council.StartHeight != previous.EndHeight) {
+				broken = true
+				msg = fmt.Sprintf("council %d not scheduled after council %d", council.ID, previous.ID)
+				return true
+			}
+			previous = &council
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "council schedule", msg), broken
+	}
+}
+
+// CouncilMembersInvariant checks the members of each council are distinct and the councils succeeding
+// the current council have no members.
+func CouncilMembersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		broken := false
+		msg := ""
+		currentID, err := k.GetCurrentCouncilID(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "council members", err.Error()), true
+		}
+		k.IterateCouncil(ctx, func(council types.Council) bool {
+			if err := council.Validate(); err != nil {
+				broken = true
+				msg = err.Error()
+				return true
+			}
+			if council.ID > currentID && len(council.Members) > 0 {
+				broken = true
+				msg = fmt.Sprintf("council %d has members before being elected", council.ID)
+				return true
+			}
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "council members", msg), broken
+	}
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/0glabs/0g-chain/x/council/v1/keeper"
+	"github.com/0glabs/0g-chain/x/council/v1/testutil"
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+type InvariantTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *InvariantTestSuite) TestInvariants() {
+	member := suite.AddValidator(1)
+	testCases := []struct {
+		name      string
+		modify    func()
+		invariant func(k keeper.Keeper) sdk.Invariant
+		broken    bool
+	}{
+		{
+			name:      "valid",
+			modify:    func() {},
+			invariant: keeper.AllInvariants,
+		},
+		{
+			name:      "current council not found",
+			modify:    func() { suite.Keeper.SetCurrentCouncilID(suite.Ctx, 2) },
+			invariant: keeper.CurrentCouncilInvariant,
+			broken:    true,
+		},
+		{
+			name: "unordered heights",
+			modify: func() {
+				suite.Keeper.SetCouncil(suite.Ctx, types.Council{ID: 2, VotingStartHeight: 10, StartHeight: 5, EndHeight: 20})
+			},
+			invariant: keeper.CouncilScheduleInvariant,
+			broken:    true,
+		},
+		{
+			name: "council not scheduled after its predecessor",
+			modify: func() {
+				council, _ := suite.Keeper.GetCouncil(suite.Ctx, 1)
+				suite.Keeper.SetCouncil(suite.Ctx, types.Council{
+					ID:                2,
+					VotingStartHeight: council.StartHeight,
+					StartHeight:       council.EndHeight + 1,
+					EndHeight:         council.EndHeight + 100,
+				})
+			},
+			invariant: keeper.CouncilScheduleInvariant,
+			broken:    true,
+		},
+		{
+			name: "duplicated members",
+			modify: func() {
+				council, _ := suite.Keeper.GetCouncil(suite.Ctx, 1)
+				council.Members = []sdk.ValAddress{member, member}
+				suite.Keeper.SetCouncil(suite.Ctx, council)
+			},
+			invariant: keeper.CouncilMembersInvariant,
+			broken:    true,
+		},
+		{
+			name: "members before election",
+			modify: func() {
+				council, _ := suite.Keeper.GetCouncil(suite.Ctx, 1)
+				suite.Keeper.SetCouncil(suite.Ctx, types.Council{
+					ID:                2,
+					VotingStartHeight: council.StartHeight,
+					StartHeight:       council.EndHeight,
+					EndHeight:         council.EndHeight + 100,
+					Members:           []sdk.ValAddress{member},
+				})
+			},
+			invariant: keeper.CouncilMembersInvariant,
+			broken:    true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			member = suite.AddValidator(1)
+			tc.modify()
+			_, broken := tc.invariant(suite.Keeper)(suite.Ctx)
+			suite.Require().Equal(tc.broken, broken)
+		})
+	}
+}
+
+func TestInvariantSuite(t *testing.T) {
+	suite.Run(t, new(InvariantTestSuite))
+}
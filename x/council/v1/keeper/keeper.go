@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strconv"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cometbft/cometbft/libs/log"
@@ -10,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/0glabs/0g-chain/x/council/v1/types"
 )
@@ -112,12 +116,26 @@ func (k Keeper) StoreNewCouncil(ctx sdk.Context, votingStartHeight uint64) error
 		EndHeight:         votingStartHeight + votingPeriod*2,
 		Votes:             []types.Vote{},
 		Members:           []sdk.ValAddress{},
+		Randomness:        ctx.BlockHeader().LastCommitHash,
 	}
 	k.SetCouncil(ctx, com)
 
 	return nil
 }
 
+// GetCurrentCouncilMembers returns the members of the council currently serving.
+func (k Keeper) GetCurrentCouncilMembers(ctx sdk.Context) []sdk.ValAddress {
+	councilID, err := k.GetCurrentCouncilID(ctx)
+	if err != nil {
+		return nil
+	}
+	council, found := k.GetCouncil(ctx, councilID)
+	if !found {
+		return nil
+	}
+	return council.Members
+}
+
 func (k Keeper) GetCouncil(ctx sdk.Context, councilID uint64) (types.Council, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CouncilKeyPrefix)
 	bz := store.Get(types.GetKeyFromID(councilID))
@@ -255,6 +273,22 @@ func (k Keeper) SetVoter(ctx sdk.Context, voter sdk.ValAddress, pk vrf.PublicKey
 	fmt.Printf("voterStoreKey: %v, publicKey: %v\n", types.GetVoterKey(voter), pk)
 }
 
+// GetVoter returns the VRF public key of a registered voter.
+func (k Keeper) GetVoter(ctx sdk.Context, voter sdk.ValAddress) (vrf.PublicKey, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoterKeyPrefix)
+	bz := store.Get(types.GetVoterKey(voter))
+	if bz == nil {
+		return nil, false
+	}
+	return vrf.PublicKey(bz), true
+}
+
+// DeleteVoter removes a voter from the store.
+func (k Keeper) DeleteVoter(ctx sdk.Context, voter sdk.ValAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoterKeyPrefix)
+	store.Delete(types.GetVoterKey(voter))
+}
+
 func (k Keeper) IterateVoters(ctx sdk.Context, cb func(voter sdk.ValAddress, pk vrf.PublicKey) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VoterKeyPrefix)
 
@@ -280,6 +314,9 @@ func (k Keeper) AddVoter(ctx sdk.Context, voter sdk.ValAddress, key []byte) erro
 	if len(key) != vrf.PublicKeySize {
 		return types.ErrInvalidPublicKey
 	}
+	if _, found := k.GetVoter(ctx, voter); found {
+		return errorsmod.Wrapf(types.ErrVoterAlreadyRegistered, "%s", voter)
+	}
 
 	k.SetVoter(ctx, voter, vrf.PublicKey(key))
 
@@ -287,13 +324,72 @@ func (k Keeper) AddVoter(ctx sdk.Context, voter sdk.ValAddress, key []byte) erro
 		sdk.NewEvent(
 			types.EventTypeRegister,
 			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
-			// TODO: types.AttributeKeyPublicKey
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(key)),
 		),
 	)
 
 	return nil
 }
 
+// UpdateVoterKey replaces the VRF public key of a registered voter. The ballots proved by the
+// previous key are removed from the councils in voting, so the voter has to vote again.
+func (k Keeper) UpdateVoterKey(ctx sdk.Context, voter sdk.ValAddress, key []byte) error {
+	if len(key) != vrf.PublicKeySize {
+		return types.ErrInvalidPublicKey
+	}
+	if _, found := k.GetVoter(ctx, voter); !found {
+		return errorsmod.Wrapf(types.ErrVoterNotFound, "%s", voter)
+	}
+
+	k.SetVoter(ctx, voter, vrf.PublicKey(key))
+	k.deletePendingVotes(ctx, voter)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateKey,
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(key)),
+		),
+	)
+
+	return nil
+}
+
+// RemoveVoter removes a registered voter and its votes of the councils in voting.
+func (k Keeper) RemoveVoter(ctx sdk.Context, voter sdk.ValAddress, reason string) error {
+	if _, found := k.GetVoter(ctx, voter); !found {
+		return errorsmod.Wrapf(types.ErrVoterNotFound, "%s", voter)
+	}
+
+	k.DeleteVoter(ctx, voter)
+	k.deletePendingVotes(ctx, voter)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregister,
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+
+	return nil
+}
+
+// deletePendingVotes removes the votes of the voter for the councils succeeding the current one,
+// the votes of the past councils are kept.
+func (k Keeper) deletePendingVotes(ctx sdk.Context, voter sdk.ValAddress) {
+	currentCouncilID, err := k.GetCurrentCouncilID(ctx)
+	if err != nil {
+		return
+	}
+	for councilID := currentCouncilID + 1; ; councilID++ {
+		if _, found := k.GetCouncil(ctx, councilID); !found {
+			return
+		}
+		k.DeleteVote(ctx, councilID, voter)
+	}
+}
+
 func (k Keeper) AddVote(ctx sdk.Context, councilID uint64, voter sdk.ValAddress, ballots []*types.Ballot) error {
 	// Validate
 	com, found := k.GetCouncil(ctx, councilID)
@@ -304,8 +400,13 @@ func (k Keeper) AddVote(ctx sdk.Context, councilID uint64, voter sdk.ValAddress,
 		return errorsmod.Wrapf(types.ErrProposalExpired, "%d ≥ %d", ctx.BlockHeight(), com.StartHeight)
 	}
 
-	// TODO: verify if the voter is registered
-	// TODO: verify whether ballots are valid or not
+	pk, found := k.GetVoter(ctx, voter)
+	if !found {
+		return errorsmod.Wrapf(types.ErrVoterNotFound, "%s", voter)
+	}
+	if err := k.verifyBallots(ctx, com, voter, pk, ballots); err != nil {
+		return err
+	}
 
 	// Store vote, overwriting any prior vote
 	k.SetVote(ctx, types.NewVote(councilID, voter, ballots))
@@ -315,9 +416,51 @@ func (k Keeper) AddVote(ctx sdk.Context, councilID uint64, voter sdk.ValAddress,
 			types.EventTypeVote,
 			sdk.NewAttribute(types.AttributeKeyCouncilID, fmt.Sprintf("%d", com.ID)),
 			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
-			// TODO: types.AttributeKeyBallots
+			sdk.NewAttribute(types.AttributeKeyBallots, ballotIDs(ballots)),
 		),
 	)
 
 	return nil
 }
+
+// GetVrfSeed returns the seed of the VRF of the ballots for the council.
+func (k Keeper) GetVrfSeed(ctx sdk.Context, council types.Council) []byte {
+	return types.CouncilVrfSeed(council.Randomness, council.ID, ctx.ChainID())
+}
+
+// verifyBallots checks the number of ballots is bounded by the bonded tokens of the voter,
+// and each ballot is the VRF output of the voter over the council seed and the ballot ID.
+func (k Keeper) verifyBallots(ctx sdk.Context, council types.Council, voter sdk.ValAddress, pk vrf.PublicKey, ballots []*types.Ballot) error {
+	validator, found := k.stakingKeeper.GetValidator(ctx, voter)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+	maxBallots := k.GetParams(ctx).MaxBallots(validator.GetBondedTokens())
+	if uint64(len(ballots)) > maxBallots {
+		return errorsmod.Wrapf(types.ErrInvalidBallot, "%d ballots exceed the limit %d", len(ballots), maxBallots)
+	}
+	seed := k.GetVrfSeed(ctx, council)
+	seen := make(map[uint64]struct{})
+	for _, ballot := range ballots {
+		if ballot.ID >= maxBallots {
+			return errorsmod.Wrapf(types.ErrInvalidBallot, "ballot ID %d exceeds the limit %d", ballot.ID, maxBallots)
+		}
+		if _, ok := seen[ballot.ID]; ok {
+			return errorsmod.Wrapf(types.ErrInvalidBallot, "duplicated ballot %d", ballot.ID)
+		}
+		seen[ballot.ID] = struct{}{}
+		if !pk.Verify(types.BallotVrfInput(seed, ballot.ID), ballot.Content, ballot.Proof) {
+			return errorsmod.Wrapf(types.ErrInvalidBallot, "invalid VRF proof of ballot %d", ballot.ID)
+		}
+	}
+	return nil
+}
+
+// ballotIDs returns the comma separated IDs of the ballots.
+func ballotIDs(ballots []*types.Ballot) string {
+	ids := make([]string, len(ballots))
+	for i, ballot := range ballots {
+		ids[i] = strconv.FormatUint(ballot.ID, 10)
+	}
+	return strings.Join(ids, ",")
+}
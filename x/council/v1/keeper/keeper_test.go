@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"testing"
+
+	vrfalgo "github.com/coniks-sys/coniks-go/crypto/vrf"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/0glabs/0g-chain/x/council/v1/testutil"
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+type KeeperTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *KeeperTestSuite) TestAddVote() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	// entitled to 3 ballots
+	voter := suite.AddValidator(params.TokensPerBallot*3 + params.TokensPerBallot/2)
+	unregistered := suite.AddValidator(params.TokensPerBallot)
+
+	err := suite.Keeper.AddVote(suite.Ctx, 1, unregistered, suite.Ballots(1, suite.NewVrfKey(), 0))
+	suite.Require().ErrorIs(err, types.ErrVoterNotFound)
+
+	sk := suite.AddVoter(voter)
+	other := suite.NewVrfKey()
+
+	testCases := []struct {
+		name    string
+		ballots []*types.Ballot
+		expErr  error
+	}{
+		{name: "too many ballots", ballots: suite.Ballots(1, sk, 0, 1, 2, 3), expErr: types.ErrInvalidBallot},
+		{name: "ballot ID out of range", ballots: suite.Ballots(1, sk, 3), expErr: types.ErrInvalidBallot},
+		{name: "duplicated ballots", ballots: suite.Ballots(1, sk, 1, 1), expErr: types.ErrInvalidBallot},
+		{name: "ballot of another key", ballots: suite.Ballots(1, other, 0), expErr: types.ErrInvalidBallot},
+		{name: "ballot of another council", ballots: suite.ballotsOfCouncil(2, sk, 0), expErr: types.ErrInvalidBallot},
+		{name: "forged content", ballots: func() []*types.Ballot {
+			ballots := suite.Ballots(1, sk, 0)
+			ballots[0].Content[0] ^= 1
+			return ballots
+		}(), expErr: types.ErrInvalidBallot},
+		{name: "valid", ballots: suite.Ballots(1, sk, 0, 2)},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+			err := suite.Keeper.AddVote(suite.Ctx, 1, voter, tc.ballots)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			vote, found := suite.Keeper.GetVote(suite.Ctx, 1, voter)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.ballots, vote.Ballots)
+			suite.Require().Contains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+				types.EventTypeVote,
+				sdk.NewAttribute(types.AttributeKeyCouncilID, "1"),
+				sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+				sdk.NewAttribute(types.AttributeKeyBallots, "0,2"),
+			))
+		})
+	}
+
+	// unbonded validators have no ballots
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, voter)
+	suite.Require().True(found)
+	validator.Status = stakingtypes.Unbonded
+	suite.StakingKeeper.SetValidator(suite.Ctx, validator)
+	err = suite.Keeper.AddVote(suite.Ctx, 1, voter, suite.Ballots(1, sk, 0))
+	suite.Require().ErrorIs(err, types.ErrInvalidBallot)
+}
+
+// voteInCouncils registers a voter voting for the current council and the next council in voting.
+func (suite *KeeperTestSuite) voteInCouncils() (sdk.ValAddress, vrfalgo.PrivateKey) {
+	suite.Require().NoError(suite.Keeper.StoreNewCouncil(suite.Ctx, 1))
+	voter := suite.AddValidator(suite.Keeper.GetParams(suite.Ctx).TokensPerBallot)
+	sk := suite.AddVoter(voter)
+	for _, councilID := range []uint64{1, 2} {
+		suite.Require().NoError(suite.Keeper.AddVote(suite.Ctx, councilID, voter, suite.Ballots(councilID, sk, 0)))
+	}
+	return voter, sk
+}
+
+func (suite *KeeperTestSuite) TestRemoveVoter() {
+	voter, _ := suite.voteInCouncils()
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.Keeper.RemoveVoter(suite.Ctx, voter, types.AttributeValueRequested))
+
+	_, found := suite.Keeper.GetVoter(suite.Ctx, voter)
+	suite.Require().False(found)
+	// the vote of the council in voting is removed, the vote of the current council is kept
+	_, found = suite.Keeper.GetVote(suite.Ctx, 2, voter)
+	suite.Require().False(found)
+	_, found = suite.Keeper.GetVote(suite.Ctx, 1, voter)
+	suite.Require().True(found)
+	suite.Require().Contains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeDeregister,
+		sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+		sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueRequested),
+	))
+
+	suite.Require().ErrorIs(suite.Keeper.RemoveVoter(suite.Ctx, voter, types.AttributeValueRequested), types.ErrVoterNotFound)
+	err := suite.Keeper.AddVote(suite.Ctx, 2, voter, suite.Ballots(2, suite.NewVrfKey(), 0))
+	suite.Require().ErrorIs(err, types.ErrVoterNotFound)
+	// deregistered voters may register again
+	suite.AddVoter(voter)
+}
+
+func (suite *KeeperTestSuite) TestUpdateVoterKey() {
+	voter, sk := suite.voteInCouncils()
+	pk, _ := suite.NewVrfKey().Public()
+	suite.Require().ErrorIs(suite.Keeper.AddVoter(suite.Ctx, voter, pk), types.ErrVoterAlreadyRegistered)
+	suite.Require().ErrorIs(suite.Keeper.UpdateVoterKey(suite.Ctx, voter, pk[1:]), types.ErrInvalidPublicKey)
+	suite.Require().ErrorIs(suite.Keeper.UpdateVoterKey(suite.Ctx, suite.AddValidator(1), pk), types.ErrVoterNotFound)
+
+	newSk := suite.NewVrfKey()
+	newPk, _ := newSk.Public()
+	suite.Require().NoError(suite.Keeper.UpdateVoterKey(suite.Ctx, voter, newPk))
+	stored, found := suite.Keeper.GetVoter(suite.Ctx, voter)
+	suite.Require().True(found)
+	suite.Require().Equal(newPk, stored)
+	_, found = suite.Keeper.GetVote(suite.Ctx, 2, voter)
+	suite.Require().False(found)
+	_, found = suite.Keeper.GetVote(suite.Ctx, 1, voter)
+	suite.Require().True(found)
+
+	// the ballots are proved by the new key
+	err := suite.Keeper.AddVote(suite.Ctx, 2, voter, suite.Ballots(2, sk, 0))
+	suite.Require().ErrorIs(err, types.ErrInvalidBallot)
+	suite.Require().NoError(suite.Keeper.AddVote(suite.Ctx, 2, voter, suite.Ballots(2, newSk, 0)))
+}
+
+func (suite *KeeperTestSuite) TestHooks() {
+	for _, tc := range []struct {
+		reason string
+		hook   func(valAddr sdk.ValAddress) error
+	}{
+		{reason: types.AttributeValueUnbonding, hook: func(valAddr sdk.ValAddress) error {
+			return suite.Keeper.Hooks().AfterValidatorBeginUnbonding(suite.Ctx, sdk.ConsAddress(valAddr), valAddr)
+		}},
+		{reason: types.AttributeValueRemoved, hook: func(valAddr sdk.ValAddress) error {
+			return suite.Keeper.Hooks().AfterValidatorRemoved(suite.Ctx, sdk.ConsAddress(valAddr), valAddr)
+		}},
+	} {
+		suite.Run(tc.reason, func() {
+			suite.SetupTest()
+			voter, _ := suite.voteInCouncils()
+			suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+			suite.Require().NoError(tc.hook(voter))
+			_, found := suite.Keeper.GetVoter(suite.Ctx, voter)
+			suite.Require().False(found)
+			_, found = suite.Keeper.GetVote(suite.Ctx, 2, voter)
+			suite.Require().False(found)
+			suite.Require().Contains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+				types.EventTypeDeregister,
+				sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+				sdk.NewAttribute(types.AttributeKeyReason, tc.reason),
+			))
+			// validators not registered are ignored
+			suite.Require().NoError(tc.hook(voter))
+		})
+	}
+}
+
+// ballotsOfCouncil proves the ballots over the seed of a council not stored yet.
+func (suite *KeeperTestSuite) ballotsOfCouncil(councilID uint64, sk vrfalgo.PrivateKey, ids ...uint64) []*types.Ballot {
+	seed := suite.Keeper.GetVrfSeed(suite.Ctx, types.Council{ID: councilID})
+	ballots := make([]*types.Ballot, len(ids))
+	for i, id := range ids {
+		content, proof := sk.Prove(types.BallotVrfInput(seed, id))
+		ballots[i] = &types.Ballot{ID: id, Content: content, Proof: proof}
+	}
+	return ballots
+}
+
+func TestKeeperSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
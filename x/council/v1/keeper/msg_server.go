@@ -49,3 +49,35 @@ func (k Keeper) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteR
 
 	return &types.MsgVoteResponse{}, nil
 }
+
+// Deregister handles MsgDeregister messages
+func (k Keeper) Deregister(goCtx context.Context, msg *types.MsgDeregister) (*types.MsgDeregisterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	voter, err := sdk.ValAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveVoter(ctx, voter, types.AttributeValueRequested); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeregisterResponse{}, nil
+}
+
+// UpdateKey handles MsgUpdateKey messages
+func (k Keeper) UpdateKey(goCtx context.Context, msg *types.MsgUpdateKey) (*types.MsgUpdateKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	voter, err := sdk.ValAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.UpdateVoterKey(ctx, voter, msg.Key); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateKeyResponse{}, nil
+}
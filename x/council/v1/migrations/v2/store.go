@@ -0,0 +1,27 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 adds the params bounding the ballots of a voter by the bonded tokens.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	migrateParams(store, cdc)
+	return nil
+}
+
+// migrateParams sets the default ballot params
+func migrateParams(store sdk.KVStore, cdc codec.BinaryCodec) {
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	defaultParams := types.DefaultGenesisState().Params
+	params.TokensPerBallot = defaultParams.TokensPerBallot
+	params.MaxBallotsPerVoter = defaultParams.MaxBallotsPerVoter
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+}
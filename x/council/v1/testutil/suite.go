@@ -0,0 +1,88 @@
+package testutil
+
+import (
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	vrfalgo "github.com/coniks-sys/coniks-go/crypto/vrf"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/chaincfg"
+	"github.com/0glabs/0g-chain/x/council/v1/keeper"
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+// Suite implements a test suite for the module integration tests
+type Suite struct {
+	suite.Suite
+
+	Keeper        keeper.Keeper
+	StakingKeeper *stakingkeeper.Keeper
+	App           app.TestApp
+	Ctx           sdk.Context
+	QueryClient   types.QueryClient
+}
+
+// SetupTest instantiates a new app, keepers, and sets suite state
+func (suite *Suite) SetupTest() {
+	chaincfg.SetSDKConfig()
+	suite.App = app.NewTestApp()
+	suite.App.InitializeFromGenesisStates()
+	suite.Keeper = suite.App.GetCouncilKeeper()
+	suite.StakingKeeper = suite.App.GetStakingKeeper()
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, ChainID: app.TestChainId})
+
+	// Set query client
+	queryHelper := suite.App.NewQueryServerTestHelper(suite.Ctx)
+	queryHandler := suite.Keeper
+	types.RegisterQueryServer(queryHelper, queryHandler)
+	suite.QueryClient = types.NewQueryClient(queryHelper)
+}
+
+// AddValidator stores a bonded validator with the tokens in the standard denom.
+func (suite *Suite) AddValidator(tokens uint64) sdk.ValAddress {
+	consPriv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	valAddr := sdk.ValAddress(consPriv.PubKey().Address())
+	validator, err := stakingtypes.NewValidator(valAddr, consPriv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	amount := math.NewIntFromUint64(tokens).Mul(types.BondedConversionRate)
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = amount
+	validator.DelegatorShares = amount.ToLegacyDec()
+	suite.StakingKeeper.SetValidator(suite.Ctx, validator)
+	suite.StakingKeeper.SetValidatorByPowerIndex(suite.Ctx, validator)
+	return valAddr
+}
+
+// NewVrfKey generates a new VRF key.
+func (suite *Suite) NewVrfKey() vrfalgo.PrivateKey {
+	sk, err := vrfalgo.GenerateKey(nil)
+	suite.Require().NoError(err)
+	return sk
+}
+
+// AddVoter registers a new VRF key of the voter.
+func (suite *Suite) AddVoter(voter sdk.ValAddress) vrfalgo.PrivateKey {
+	sk := suite.NewVrfKey()
+	pk, _ := sk.Public()
+	suite.Require().NoError(suite.Keeper.AddVoter(suite.Ctx, voter, pk))
+	return sk
+}
+
+// Ballots returns the ballots of the given IDs proved by the VRF key over the council seed.
+func (suite *Suite) Ballots(councilID uint64, sk vrfalgo.PrivateKey, ids ...uint64) []*types.Ballot {
+	council, found := suite.Keeper.GetCouncil(suite.Ctx, councilID)
+	suite.Require().True(found)
+	seed := suite.Keeper.GetVrfSeed(suite.Ctx, council)
+	ballots := make([]*types.Ballot, len(ids))
+	for i, id := range ids {
+		content, proof := sk.Prove(types.BallotVrfInput(seed, id))
+		ballots[i] = &types.Ballot{ID: id, Content: content, Proof: proof}
+	}
+	return ballots
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,6 +12,21 @@ func (c Council) HasVotingEndedBy(height int64) bool {
 	return height >= int64(c.StartHeight)
 }
 
+// Validate checks the heights are ordered and the members are distinct.
+func (c Council) Validate() error {
+	if c.VotingStartHeight > c.StartHeight || c.StartHeight > c.EndHeight {
+		return errorsmod.Wrapf(ErrInvalidCouncil, "unordered heights of council %d", c.ID)
+	}
+	seen := make(map[string]struct{})
+	for _, member := range c.Members {
+		if _, ok := seen[string(member)]; ok {
+			return errorsmod.Wrapf(ErrInvalidCouncil, "duplicated member %s of council %d", member, c.ID)
+		}
+		seen[string(member)] = struct{}{}
+	}
+	return nil
+}
+
 // NewVote instantiates a new instance of Vote
 func NewVote(councilID uint64, voter sdk.ValAddress, ballots []*Ballot) Vote {
 	return Vote{
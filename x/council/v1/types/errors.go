@@ -16,4 +16,8 @@ var (
 	ErrNotFoundProposalTally   = errorsmod.Register(ModuleName, 12, "proposal tally not found")
 	ErrInvalidPublicKey        = errorsmod.Register(ModuleName, 13, "invalid public key")
 	ErrInvalidValidatorAddress = errorsmod.Register(ModuleName, 14, "invalid validator address")
+	ErrVoterNotFound           = errorsmod.Register(ModuleName, 15, "voter not registered")
+	ErrInvalidBallot           = errorsmod.Register(ModuleName, 16, "invalid ballot")
+	ErrInvalidParams           = errorsmod.Register(ModuleName, 17, "invalid params")
+	ErrVoterAlreadyRegistered  = errorsmod.Register(ModuleName, 18, "voter already registered")
 )
@@ -2,18 +2,28 @@ package types
 
 // Module event types
 const (
-	EventTypeRegister = "register"
-	EventTypeVote     = "vote"
+	EventTypeRegister   = "register"
+	EventTypeDeregister = "deregister"
+	EventTypeUpdateKey  = "update_key"
+	EventTypeVote       = "vote"
+	EventTypeElect      = "elect"
+	EventTypeExtend     = "extend_voting"
 
 	AttributeValueCategory          = "council"
+	AttributeValueRequested         = "requested"
+	AttributeValueUnbonding         = "validator_unbonding"
+	AttributeValueRemoved           = "validator_removed"
 	AttributeKeyCouncilID           = "council_id"
 	AttributeKeyProposalID          = "proposal_id"
 	AttributeKeyVotingStartHeight   = "voting_start_height"
 	AttributeKeyVotingEndHeight     = "voting_end_height"
 	AttributeKeyProposalCloseStatus = "status"
 	AttributeKeyVoter               = "voter"
+	AttributeKeyMembers             = "members"
+	AttributeKeyElectionPolicy      = "election_policy"
 	AttributeKeyBallots             = "ballots"
 	AttributeKeyPublicKey           = "public_key"
+	AttributeKeyReason              = "reason"
 	AttributeKeyProposalOutcome     = "proposal_outcome"
 	AttributeKeyProposalTally       = "proposal_tally"
 )
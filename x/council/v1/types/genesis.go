@@ -1,8 +1,15 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 const (
 	DefaultVotingStartHeight = 1
 	DefaultVotingPeriod      = 200
+
+	DefaultTokensPerBallot    = 1_000
+	DefaultMaxBallotsPerVoter = 1024
 )
 
 // NewGenesisState returns a new genesis state object for the module.
@@ -20,7 +27,9 @@ func NewGenesisState(params Params, votingStartHeight uint64, votingPeriod uint6
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		Params{
-			CouncilSize: 1,
+			CouncilSize:        1,
+			TokensPerBallot:    DefaultTokensPerBallot,
+			MaxBallotsPerVoter: DefaultMaxBallotsPerVoter,
 		},
 		DefaultVotingStartHeight,
 		DefaultVotingPeriod,
@@ -38,5 +47,24 @@ func DefaultGenesisState() *GenesisState {
 
 // Validate performs basic validation of genesis data.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.VotingPeriod == 0 {
+		return errorsmod.Wrap(ErrInvalidGenesis, "voting period must be positive")
+	}
+	seen := make(map[uint64]struct{})
+	for _, council := range gs.Councils {
+		if _, ok := seen[council.ID]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicated council %d", council.ID)
+		}
+		seen[council.ID] = struct{}{}
+		if err := council.Validate(); err != nil {
+			return err
+		}
+	}
+	if _, ok := seen[gs.CurrentCouncilID]; !ok {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "current council %d not found", gs.CurrentCouncilID)
+	}
 	return nil
 }
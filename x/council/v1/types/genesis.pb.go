@@ -27,8 +27,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ElectionPolicy enumerates the ways to fill the vacant seats of a council.
+type ElectionPolicy int32
+
+const (
+	// ELECTION_POLICY_CARRY_OVER fills the vacant seats with the members of the previous council
+	ELECTION_POLICY_CARRY_OVER ElectionPolicy = 0
+	// ELECTION_POLICY_TOP_VALIDATORS fills the vacant seats with the bonded validators of the most power
+	ELECTION_POLICY_TOP_VALIDATORS ElectionPolicy = 1
+	// ELECTION_POLICY_EXTEND_VOTING keeps the previous council and extends the voting by a voting period
+	ELECTION_POLICY_EXTEND_VOTING ElectionPolicy = 2
+)
+
+var ElectionPolicy_name = map[int32]string{
+	0: "ELECTION_POLICY_CARRY_OVER",
+	1: "ELECTION_POLICY_TOP_VALIDATORS",
+	2: "ELECTION_POLICY_EXTEND_VOTING",
+}
+
+var ElectionPolicy_value = map[string]int32{
+	"ELECTION_POLICY_CARRY_OVER":     0,
+	"ELECTION_POLICY_TOP_VALIDATORS": 1,
+	"ELECTION_POLICY_EXTEND_VOTING":  2,
+}
+
+func (x ElectionPolicy) String() string {
+	return proto.EnumName(ElectionPolicy_name, int32(x))
+}
+
+func (ElectionPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_35f7661c22f951dd, []int{0}
+}
+
 type Params struct {
 	CouncilSize uint64 `protobuf:"varint,1,opt,name=council_size,json=councilSize,proto3" json:"council_size,omitempty"`
+	// tokens_per_ballot defines the bonded tokens in the standard denom of a voter entitled to one ballot
+	TokensPerBallot uint64 `protobuf:"varint,2,opt,name=tokens_per_ballot,json=tokensPerBallot,proto3" json:"tokens_per_ballot,omitempty"`
+	// max_ballots_per_voter defines the maximal number of ballots of a voter in a council
+	MaxBallotsPerVoter uint64 `protobuf:"varint,3,opt,name=max_ballots_per_voter,json=maxBallotsPerVoter,proto3" json:"max_ballots_per_voter,omitempty"`
+	// election_policy defines how the seats are filled when the distinct voters are fewer than the council size
+	ElectionPolicy ElectionPolicy `protobuf:"varint,4,opt,name=election_policy,json=electionPolicy,proto3,enum=zgc.council.v1.ElectionPolicy" json:"election_policy,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,6 +109,27 @@ func (m *Params) GetCouncilSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetTokensPerBallot() uint64 {
+	if m != nil {
+		return m.TokensPerBallot
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBallotsPerVoter() uint64 {
+	if m != nil {
+		return m.MaxBallotsPerVoter
+	}
+	return 0
+}
+
+func (m *Params) GetElectionPolicy() ElectionPolicy {
+	if m != nil {
+		return m.ElectionPolicy
+	}
+	return ELECTION_POLICY_CARRY_OVER
+}
+
 // GenesisState defines the council module's genesis state.
 type GenesisState struct {
 	Params            Params    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
@@ -120,6 +179,8 @@ type Council struct {
 	EndHeight         uint64                                          `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 	Votes             []Vote                                          `protobuf:"bytes,5,rep,name=votes,proto3" json:"votes"`
 	Members           []github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,6,rep,name=members,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"members,omitempty"`
+	// randomness is the last commit hash of the block the voting started at, which seeds the VRF of the ballots
+	Randomness []byte `protobuf:"bytes,7,opt,name=randomness,proto3" json:"randomness,omitempty"`
 }
 
 func (m *Council) Reset()         { *m = Council{} }
@@ -197,6 +258,13 @@ func (m *Council) GetMembers() []github_com_cosmos_cosmos_sdk_types.ValAddress {
 	return nil
 }
 
+func (m *Council) GetRandomness() []byte {
+	if m != nil {
+		return m.Randomness
+	}
+	return nil
+}
+
 type Vote struct {
 	CouncilID uint64                                        `protobuf:"varint,1,opt,name=council_id,json=councilId,proto3" json:"council_id,omitempty"`
 	Voter     github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"voter,omitempty"`
@@ -236,9 +304,11 @@ func (m *Vote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Vote proto.InternalMessageInfo
 
+// Ballot is the VRF output of a voter over the seed of a council and the ballot ID.
 type Ballot struct {
 	ID      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Proof   []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
 func (m *Ballot) Reset()         { *m = Ballot{} }
@@ -288,7 +358,15 @@ func (m *Ballot) GetContent() []byte {
 	return nil
 }
 
+func (m *Ballot) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("zgc.council.v1.ElectionPolicy", ElectionPolicy_name, ElectionPolicy_value)
 	proto.RegisterType((*Params)(nil), "zgc.council.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "zgc.council.v1.GenesisState")
 	proto.RegisterType((*Council)(nil), "zgc.council.v1.Council")
@@ -299,45 +377,57 @@ func init() {
 func init() { proto.RegisterFile("zgc/council/v1/genesis.proto", fileDescriptor_35f7661c22f951dd) }
 
 var fileDescriptor_35f7661c22f951dd = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x1c, 0xc6, 0x63, 0x27, 0x75, 0x7e, 0xbd, 0xb8, 0x3f, 0x95, 0x6b, 0x55, 0xdc, 0x0a, 0xec, 0x36,
-	0x2c, 0x95, 0x20, 0x76, 0x5a, 0x58, 0xe8, 0x86, 0x5b, 0xa9, 0xed, 0x56, 0xb9, 0x52, 0x07, 0x06,
-	0x22, 0xff, 0x39, 0x2e, 0x27, 0x6c, 0x5f, 0xe4, 0xbb, 0x44, 0x34, 0xaf, 0x80, 0x91, 0x57, 0x80,
-	0x58, 0xd9, 0x79, 0x11, 0x1d, 0x18, 0x2a, 0x26, 0xa6, 0x08, 0x39, 0xef, 0x82, 0x09, 0xe5, 0xee,
-	0x5c, 0x92, 0x20, 0x06, 0x24, 0x26, 0xfb, 0x9e, 0xe7, 0x73, 0x7f, 0x9e, 0xef, 0xf7, 0x6c, 0xf0,
-	0x60, 0x8c, 0x63, 0x2f, 0xa6, 0xc3, 0x3c, 0x26, 0xa9, 0x37, 0x3a, 0xf0, 0x30, 0xca, 0x11, 0x23,
-	0xcc, 0x1d, 0x14, 0x94, 0x53, 0xf8, 0xff, 0x18, 0xc7, 0xae, 0x72, 0xdd, 0xd1, 0xc1, 0xce, 0x76,
-	0x4c, 0x59, 0x46, 0x59, 0x4f, 0xb8, 0x9e, 0x1c, 0x48, 0x74, 0x67, 0x13, 0x53, 0x4c, 0xa5, 0x3e,
-	0x7b, 0x53, 0xea, 0x36, 0xa6, 0x14, 0xa7, 0xc8, 0x13, 0xa3, 0x68, 0xf8, 0xda, 0x0b, 0xf3, 0x6b,
-	0x65, 0x39, 0xcb, 0x16, 0x27, 0x19, 0x62, 0x3c, 0xcc, 0x06, 0x12, 0x68, 0x3f, 0x06, 0xc6, 0x45,
-	0x58, 0x84, 0x19, 0x83, 0x7b, 0xc0, 0x54, 0x87, 0xe8, 0x31, 0x32, 0x46, 0x96, 0xb6, 0xab, 0xed,
-	0x37, 0x82, 0x96, 0xd2, 0x2e, 0xc9, 0x18, 0xb5, 0x3f, 0xe8, 0xc0, 0x3c, 0x95, 0x67, 0xbf, 0xe4,
-	0x21, 0x47, 0xf0, 0x19, 0x30, 0x06, 0x62, 0xb6, 0xa0, 0x5b, 0x87, 0x5b, 0xee, 0x62, 0x16, 0x57,
-	0xae, 0xed, 0x37, 0x6e, 0x26, 0x4e, 0x2d, 0x50, 0x2c, 0x74, 0xc1, 0xc6, 0x88, 0x72, 0x92, 0xe3,
-	0x1e, 0xe3, 0x61, 0xc1, 0x7b, 0x7d, 0x44, 0x70, 0x9f, 0x5b, 0xba, 0xd8, 0xf0, 0x9e, 0xb4, 0x2e,
-	0x67, 0xce, 0x99, 0x30, 0xe0, 0x23, 0xb0, 0xa6, 0xf8, 0x01, 0x2a, 0x08, 0x4d, 0xac, 0xba, 0x20,
-	0x4d, 0x29, 0x5e, 0x08, 0x0d, 0xfa, 0x00, 0xc6, 0xc3, 0xa2, 0x40, 0x39, 0xef, 0x55, 0x31, 0x48,
-	0x62, 0x35, 0x66, 0xa4, 0xbf, 0x59, 0x4e, 0x9c, 0xf5, 0x63, 0xe9, 0x1e, 0x4b, 0xf3, 0xfc, 0x24,
-	0x58, 0x8f, 0x17, 0x95, 0x04, 0x3e, 0x07, 0xff, 0xa9, 0xb9, 0xcc, 0x5a, 0xd9, 0xad, 0xef, 0xb7,
-	0x0e, 0xef, 0x2f, 0x07, 0x52, 0xb0, 0x4a, 0x74, 0x87, 0x1f, 0x35, 0xde, 0x7d, 0x74, 0x6a, 0xed,
-	0x4f, 0x3a, 0x68, 0x2a, 0x02, 0x6e, 0x01, 0x9d, 0x24, 0xb2, 0x8a, 0xbe, 0x51, 0x4e, 0x1c, 0xfd,
-	0xfc, 0x24, 0xd0, 0x49, 0xf2, 0xd7, 0xe9, 0xf7, 0x80, 0xb9, 0x00, 0xca, 0xf0, 0x2d, 0x36, 0x87,
-	0x3c, 0x04, 0x00, 0xe5, 0x49, 0x05, 0x88, 0xcc, 0xc1, 0x2a, 0xca, 0x13, 0x65, 0x77, 0xc1, 0xca,
-	0x88, 0x72, 0x54, 0x65, 0xda, 0x5c, 0xce, 0x74, 0x45, 0x39, 0x52, 0x81, 0x24, 0x08, 0x23, 0xd0,
-	0xcc, 0x50, 0x16, 0xa1, 0x82, 0x59, 0xc6, 0x6e, 0x7d, 0xdf, 0xf4, 0xcf, 0x7e, 0x4c, 0x9c, 0x0e,
-	0x26, 0xbc, 0x3f, 0x8c, 0xdc, 0x98, 0x66, 0xea, 0x56, 0xaa, 0x47, 0x87, 0x25, 0x6f, 0x3c, 0x7e,
-	0x3d, 0x40, 0xcc, 0xbd, 0x0a, 0xd3, 0x17, 0x49, 0x52, 0x20, 0xc6, 0xbe, 0x7e, 0xee, 0x6c, 0xa8,
-	0xbb, 0xab, 0x14, 0xff, 0x9a, 0x23, 0x16, 0x54, 0x0b, 0xb7, 0xbf, 0x68, 0xa0, 0x31, 0xdb, 0x19,
-	0x3e, 0x01, 0x60, 0xae, 0x63, 0xb2, 0x60, 0x6b, 0xe5, 0xc4, 0x59, 0xfd, 0xd5, 0xaa, 0xd5, 0xf8,
-	0xae, 0x47, 0xaf, 0x64, 0x98, 0x42, 0x14, 0xec, 0x5f, 0x1e, 0x4c, 0x2e, 0x0b, 0xbb, 0xa0, 0x19,
-	0x85, 0x69, 0x4a, 0x39, 0xb3, 0xea, 0xa2, 0x5c, 0xbf, 0xdd, 0x69, 0x5f, 0xd8, 0x41, 0x85, 0xa9,
-	0xd6, 0x1f, 0x01, 0x43, 0x1a, 0x7f, 0x6c, 0xbc, 0x05, 0x9a, 0x31, 0xcd, 0x39, 0xca, 0x65, 0xb3,
-	0xcd, 0xa0, 0x1a, 0xfa, 0xa7, 0x37, 0xa5, 0xad, 0xdd, 0x96, 0xb6, 0xf6, 0xbd, 0xb4, 0xb5, 0xf7,
-	0x53, 0xbb, 0x76, 0x3b, 0xb5, 0x6b, 0xdf, 0xa6, 0x76, 0xed, 0xe5, 0x7c, 0xb4, 0x2e, 0x4e, 0xc3,
-	0x88, 0x79, 0x5d, 0xdc, 0x89, 0xfb, 0x21, 0xc9, 0xbd, 0xb7, 0xf3, 0xbf, 0x14, 0x91, 0x32, 0x32,
-	0xc4, 0x47, 0xfd, 0xf4, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0x4d, 0xc7, 0xe0, 0x71, 0x04,
-	0x00, 0x00,
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x8f, 0xda, 0x46,
+	0x18, 0xc6, 0x31, 0xb0, 0xd0, 0x7d, 0xd7, 0xd9, 0x90, 0xc9, 0x36, 0x75, 0x56, 0x8d, 0x61, 0xe9,
+	0x05, 0x45, 0x5d, 0x7b, 0x77, 0xdb, 0x4b, 0x7b, 0x5b, 0x03, 0x22, 0x48, 0xab, 0x05, 0x19, 0x84,
+	0x9a, 0x1e, 0x6a, 0x19, 0x7b, 0x62, 0xac, 0xd8, 0x1e, 0xcb, 0x33, 0xa0, 0x05, 0xa9, 0xf7, 0x1c,
+	0xfb, 0x09, 0xaa, 0x4a, 0xfd, 0x0a, 0xfd, 0x10, 0xa9, 0xd4, 0x43, 0xd4, 0x5e, 0x7a, 0x42, 0x15,
+	0xfb, 0x2d, 0x7a, 0xaa, 0x98, 0x19, 0x52, 0x40, 0xed, 0xa1, 0x52, 0x4f, 0xf0, 0xbe, 0xcf, 0x6f,
+	0x46, 0xf3, 0xbc, 0x7f, 0x0c, 0x1f, 0x2f, 0x02, 0xcf, 0xf4, 0xc8, 0x34, 0xf1, 0xc2, 0xc8, 0x9c,
+	0x5d, 0x9a, 0x01, 0x4e, 0x30, 0x0d, 0xa9, 0x91, 0x66, 0x84, 0x11, 0x74, 0xbc, 0x08, 0x3c, 0x43,
+	0xaa, 0xc6, 0xec, 0xf2, 0xf4, 0xa9, 0x47, 0x68, 0x4c, 0xa8, 0xc3, 0x55, 0x53, 0x04, 0x02, 0x3d,
+	0x3d, 0x09, 0x48, 0x40, 0x44, 0x7e, 0xfd, 0x4f, 0x66, 0x9f, 0x06, 0x84, 0x04, 0x11, 0x36, 0x79,
+	0x34, 0x9e, 0xbe, 0x32, 0xdd, 0x64, 0x2e, 0xa5, 0xea, 0xbe, 0xc4, 0xc2, 0x18, 0x53, 0xe6, 0xc6,
+	0xa9, 0x00, 0xea, 0xbf, 0x29, 0x50, 0xea, 0xbb, 0x99, 0x1b, 0x53, 0x74, 0x06, 0xaa, 0x7c, 0x85,
+	0x43, 0xc3, 0x05, 0xd6, 0x94, 0x9a, 0xd2, 0x28, 0xda, 0x47, 0x32, 0x37, 0x08, 0x17, 0x18, 0x3d,
+	0x87, 0x47, 0x8c, 0xbc, 0xc6, 0x09, 0x75, 0x52, 0x9c, 0x39, 0x63, 0x37, 0x8a, 0x08, 0xd3, 0xf2,
+	0x9c, 0x7b, 0x28, 0x84, 0x3e, 0xce, 0x2c, 0x9e, 0x46, 0x97, 0xf0, 0x61, 0xec, 0xde, 0x49, 0x48,
+	0x1c, 0x98, 0x11, 0x86, 0x33, 0xad, 0xc0, 0x79, 0x14, 0xbb, 0x77, 0x82, 0x5c, 0x9f, 0x19, 0xad,
+	0x15, 0xd4, 0x81, 0x87, 0x38, 0xc2, 0x1e, 0x0b, 0x49, 0xe2, 0xa4, 0x24, 0x0a, 0xbd, 0xb9, 0x56,
+	0xac, 0x29, 0x8d, 0xe3, 0x2b, 0xdd, 0xd8, 0xad, 0x91, 0xd1, 0x96, 0x58, 0x9f, 0x53, 0xf6, 0x31,
+	0xde, 0x89, 0xeb, 0xdf, 0xe7, 0x41, 0xed, 0x88, 0x22, 0x0f, 0x98, 0xcb, 0x30, 0xfa, 0x1c, 0x4a,
+	0x29, 0x77, 0xc9, 0x5d, 0x1d, 0x5d, 0x3d, 0xd9, 0xbf, 0x50, 0xd4, 0xc0, 0x2a, 0xbe, 0x5d, 0x56,
+	0x73, 0xb6, 0x64, 0x91, 0x01, 0x8f, 0x67, 0x84, 0x85, 0x49, 0xe0, 0x50, 0xe6, 0x66, 0xcc, 0x99,
+	0xe0, 0x30, 0x98, 0x6c, 0x0c, 0x3f, 0x12, 0xd2, 0x60, 0xad, 0xbc, 0xe0, 0x02, 0xfa, 0x04, 0x1e,
+	0x48, 0x3e, 0xc5, 0x59, 0x48, 0x7c, 0x69, 0x55, 0x15, 0xc9, 0x3e, 0xcf, 0x21, 0x0b, 0x90, 0x37,
+	0xcd, 0x32, 0x9c, 0x30, 0x67, 0x53, 0xee, 0xd0, 0xe7, 0x3e, 0x8b, 0xd6, 0xc9, 0x6a, 0x59, 0xad,
+	0x34, 0x85, 0xda, 0x14, 0x62, 0xb7, 0x65, 0x57, 0xbc, 0xdd, 0x8c, 0x8f, 0xbe, 0x80, 0x0f, 0xe4,
+	0x59, 0xaa, 0x1d, 0xd4, 0x0a, 0x8d, 0xa3, 0xab, 0x8f, 0xf6, 0x0d, 0x49, 0x58, 0x3a, 0x7a, 0x8f,
+	0x7f, 0x59, 0x7c, 0xf3, 0x43, 0x35, 0x57, 0xff, 0x39, 0x0f, 0x65, 0x49, 0xa0, 0x27, 0x90, 0x0f,
+	0x7d, 0xd1, 0x6d, 0xab, 0xb4, 0x5a, 0x56, 0xf3, 0xdd, 0x96, 0x9d, 0x0f, 0xfd, 0xff, 0xec, 0xfe,
+	0x0c, 0xd4, 0x1d, 0x50, 0x98, 0x3f, 0xa2, 0x5b, 0xc8, 0x33, 0x00, 0x9c, 0xf8, 0x1b, 0x80, 0x7b,
+	0xb6, 0x0f, 0x71, 0xe2, 0x4b, 0xf9, 0x02, 0x0e, 0xd6, 0x23, 0xb2, 0xf1, 0x74, 0xb2, 0xef, 0x69,
+	0x3d, 0x25, 0xd2, 0x90, 0x00, 0xd1, 0x18, 0xca, 0x31, 0x8e, 0xc7, 0x38, 0xa3, 0x5a, 0xa9, 0x56,
+	0x68, 0xa8, 0xd6, 0x8b, 0x3f, 0x97, 0xd5, 0xf3, 0x20, 0x64, 0x93, 0xe9, 0xd8, 0xf0, 0x48, 0x2c,
+	0xd7, 0x47, 0xfe, 0x9c, 0x53, 0xff, 0xb5, 0xc9, 0xe6, 0x29, 0xa6, 0xc6, 0xc8, 0x8d, 0xae, 0x7d,
+	0x3f, 0xc3, 0x94, 0xfe, 0xfa, 0xd3, 0xf9, 0x63, 0x21, 0x1b, 0x32, 0x63, 0xcd, 0x19, 0xa6, 0xf6,
+	0xe6, 0x62, 0xa4, 0x03, 0x64, 0x6e, 0xe2, 0x93, 0x38, 0xc1, 0x94, 0x6a, 0xe5, 0x9a, 0xd2, 0x50,
+	0xed, 0xad, 0x4c, 0xfd, 0x17, 0x05, 0x8a, 0xeb, 0x97, 0xa1, 0x4f, 0x01, 0xb6, 0x3a, 0x2a, 0x0a,
+	0xfa, 0x60, 0xb5, 0xac, 0x1e, 0xfe, 0xdd, 0xca, 0x43, 0xef, 0x7d, 0x0f, 0xbf, 0x11, 0x66, 0x33,
+	0x5e, 0xd0, 0xff, 0xf3, 0xe1, 0xe2, 0x5a, 0x74, 0x01, 0x65, 0xb9, 0x7b, 0x5a, 0xa1, 0x56, 0xf8,
+	0xa7, 0x99, 0x17, 0xeb, 0x67, 0x6f, 0x30, 0x39, 0x1a, 0x7d, 0x28, 0xc9, 0x0d, 0xfe, 0xb7, 0xc1,
+	0xd0, 0xa0, 0xec, 0x91, 0x84, 0xe1, 0x44, 0x0c, 0x83, 0x6a, 0x6f, 0x42, 0x74, 0x02, 0x07, 0x69,
+	0x46, 0xc8, 0x2b, 0xde, 0x7b, 0xd5, 0x16, 0xc1, 0xf3, 0x6f, 0xe1, 0x78, 0x77, 0x5f, 0x91, 0x0e,
+	0xa7, 0xed, 0x9b, 0x76, 0x73, 0xd8, 0xed, 0xdd, 0x3a, 0xfd, 0xde, 0x4d, 0xb7, 0xf9, 0xd2, 0x69,
+	0x5e, 0xdb, 0xf6, 0x4b, 0xa7, 0x37, 0x6a, 0xdb, 0x95, 0x1c, 0xaa, 0x83, 0xbe, 0xaf, 0x0f, 0x7b,
+	0x7d, 0x67, 0x74, 0x7d, 0xd3, 0x6d, 0x5d, 0x0f, 0x7b, 0xf6, 0xa0, 0xa2, 0xa0, 0x33, 0x78, 0xb6,
+	0xcf, 0xb4, 0xbf, 0x1a, 0xb6, 0x6f, 0x5b, 0xce, 0xa8, 0x37, 0xec, 0xde, 0x76, 0x2a, 0xf9, 0xd3,
+	0xe2, 0x9b, 0x1f, 0xf5, 0x9c, 0xd5, 0x79, 0xbb, 0xd2, 0x95, 0x77, 0x2b, 0x5d, 0xf9, 0x63, 0xa5,
+	0x2b, 0xdf, 0xdd, 0xeb, 0xb9, 0x77, 0xf7, 0x7a, 0xee, 0xf7, 0x7b, 0x3d, 0xf7, 0xf5, 0x76, 0xbd,
+	0x2f, 0x82, 0xc8, 0x1d, 0x53, 0xf3, 0x22, 0x38, 0xf7, 0x26, 0x6e, 0x98, 0x98, 0x77, 0xdb, 0x1f,
+	0x6c, 0x5e, 0xfa, 0x71, 0x89, 0x7f, 0x32, 0x3f, 0xfb, 0x6b, 0x00, 0x0a, 0xc5, 0xc1, 0x04, 0xcf,
+	0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -360,6 +450,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ElectionPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ElectionPolicy))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBallotsPerVoter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBallotsPerVoter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TokensPerBallot != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TokensPerBallot))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.CouncilSize != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CouncilSize))
 		i--
@@ -450,6 +555,13 @@ func (m *Council) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Randomness) > 0 {
+		i -= len(m.Randomness)
+		copy(dAtA[i:], m.Randomness)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Randomness)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Members) > 0 {
 		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Members[iNdEx])
@@ -565,6 +677,13 @@ func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Content) > 0 {
 		i -= len(m.Content)
 		copy(dAtA[i:], m.Content)
@@ -600,6 +719,15 @@ func (m *Params) Size() (n int) {
 	if m.CouncilSize != 0 {
 		n += 1 + sovGenesis(uint64(m.CouncilSize))
 	}
+	if m.TokensPerBallot != 0 {
+		n += 1 + sovGenesis(uint64(m.TokensPerBallot))
+	}
+	if m.MaxBallotsPerVoter != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxBallotsPerVoter))
+	}
+	if m.ElectionPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.ElectionPolicy))
+	}
 	return n
 }
 
@@ -659,6 +787,10 @@ func (m *Council) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.Randomness)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -697,6 +829,10 @@ func (m *Ballot) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -754,6 +890,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensPerBallot", wireType)
+			}
+			m.TokensPerBallot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokensPerBallot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBallotsPerVoter", wireType)
+			}
+			m.MaxBallotsPerVoter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBallotsPerVoter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionPolicy", wireType)
+			}
+			m.ElectionPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElectionPolicy |= ElectionPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1120,6 +1313,40 @@ func (m *Council) Unmarshal(dAtA []byte) error {
 			m.Members = append(m.Members, make([]byte, postIndex-iNdEx))
 			copy(m.Members[len(m.Members)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Randomness", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Randomness = append(m.Randomness[:0], dAtA[iNdEx:postIndex]...)
+			if m.Randomness == nil {
+				m.Randomness = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1360,6 +1587,40 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 				m.Content = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -20,4 +20,5 @@ type BankKeeper interface {
 type StakingKeeper interface {
 	BondDenom(ctx sdk.Context) (res string)
 	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetBondedValidatorsByPower(ctx sdk.Context) []stakingtypes.Validator
 }
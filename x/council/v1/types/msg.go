@@ -3,11 +3,12 @@ package types
 import (
 	"encoding/hex"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/coniks-sys/coniks-go/crypto/vrf"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _, _ sdk.Msg = &MsgRegister{}, &MsgVote{}
+var _, _, _, _ sdk.Msg = &MsgRegister{}, &MsgVote{}, &MsgDeregister{}, &MsgUpdateKey{}
 
 // GetSigners returns the expected signers for a MsgRegister message.
 func (msg *MsgRegister) GetSigners() []sdk.AccAddress {
@@ -56,6 +57,22 @@ func (msg *MsgVote) ValidateBasic() error {
 	if _, err := sdk.ValAddressFromBech32(msg.Voter); err != nil {
 		return ErrInvalidValidatorAddress
 	}
+	if len(msg.Ballots) == 0 {
+		return errorsmod.Wrap(ErrInvalidBallot, "no ballots")
+	}
+	seen := make(map[uint64]struct{})
+	for _, ballot := range msg.Ballots {
+		if ballot == nil {
+			return errorsmod.Wrap(ErrInvalidBallot, "empty ballot")
+		}
+		if _, ok := seen[ballot.ID]; ok {
+			return errorsmod.Wrapf(ErrInvalidBallot, "duplicated ballot %d", ballot.ID)
+		}
+		seen[ballot.ID] = struct{}{}
+		if len(ballot.Content) != vrf.Size || len(ballot.Proof) != vrf.ProofSize {
+			return errorsmod.Wrapf(ErrInvalidBallot, "invalid content or proof length of ballot %d", ballot.ID)
+		}
+	}
 	return nil
 }
 
@@ -63,3 +80,58 @@ func (msg *MsgVote) ValidateBasic() error {
 func (msg MsgVote) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
 }
+
+// GetSigners returns the expected signers for a MsgDeregister message.
+func (msg *MsgDeregister) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.Voter)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgDeregister) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.Voter); err != nil {
+		return ErrInvalidValidatorAddress
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgDeregister) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateKey message.
+func (msg *MsgUpdateKey) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.Voter)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgUpdateKey) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.Voter); err != nil {
+		return ErrInvalidValidatorAddress
+	}
+	if len(msg.Key) != vrf.PublicKeySize {
+		return ErrInvalidPublicKey
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
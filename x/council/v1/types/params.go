@@ -0,0 +1,22 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate checks the params are set.
+func (p Params) Validate() error {
+	if p.CouncilSize == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "council size must be positive")
+	}
+	if p.TokensPerBallot == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "tokens per ballot must be positive")
+	}
+	if p.MaxBallotsPerVoter == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "max ballots per voter must be positive")
+	}
+	if _, ok := ElectionPolicy_name[int32(p.ElectionPolicy)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "unknown election policy %d", p.ElectionPolicy)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/0glabs/0g-chain/chaincfg"
+)
+
+// BondedConversionRate converts the bonded tokens from the evm denom to the standard denom
+var BondedConversionRate = math.NewInt(chaincfg.EvmDenomConversionMultiplier)
+
+// CouncilVrfSeed returns the seed of the VRF of the ballots for a council,
+// which is mixed with the randomness of the block the voting started at.
+func CouncilVrfSeed(randomness []byte, councilID uint64, chainID string) []byte {
+	toHash := make([]byte, 0)
+	toHash = append(toHash, common.LeftPadBytes(randomness, 32)...)
+	toHash = append(toHash, Uint64ToBytes(councilID)...)
+	toHash = append(toHash, []byte(chainID)...)
+	toHash = append(toHash, []byte("0G_Council_Vrf_Seed")...)
+	return crypto.Keccak256(toHash)
+}
+
+// BallotVrfInput returns the input of the VRF of a ballot.
+func BallotVrfInput(seed []byte, ballotID uint64) []byte {
+	return append(append([]byte{}, seed...), Uint64ToBytes(ballotID)...)
+}
+
+// MaxBallots returns the number of ballots the bonded tokens are entitled to.
+func (p Params) MaxBallots(bonded math.Int) uint64 {
+	if p.TokensPerBallot == 0 || !bonded.IsPositive() {
+		return 0
+	}
+	num := bonded.Quo(BondedConversionRate).Quo(math.NewIntFromUint64(p.TokensPerBallot))
+	if !num.IsUint64() || num.Uint64() > p.MaxBallotsPerVoter {
+		return p.MaxBallotsPerVoter
+	}
+	return num.Uint64()
+}
@@ -2,7 +2,10 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -22,7 +25,20 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		GetParams(),
 		GetEpochNumber(),
+		GetQuorumCount(),
+		GetEpochQuorum(),
+		GetEpochQuorumRow(),
+		GetAggregatePubkeyG1(),
+		GetSigner(),
+		GetSigners(),
+		GetEpochRegistrations(),
+		GetSignerEpochs(),
+		GetEpochRandomness(),
+		GetVrfKey(),
+		GetVerifyQuorumSignature(),
+		GetSignerOperator(),
 	)
 
 	return cmd
@@ -55,3 +71,492 @@ func GetEpochNumber() *cobra.Command {
 
 	return cmd
 }
+
+func GetParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current dasigners parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetQuorumCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quorum-count [epoch]",
+		Short: "Query the number of quorums in an epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid epoch %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QuorumCount(context.Background(), &types.QueryQuorumCountRequest{
+				EpochNumber: epoch,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%v\n", res.QuorumCount))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetEpochQuorum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-quorum [epoch] [quorum-id]",
+		Short: "Query the signers of a quorum in an epoch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, quorumId, err := parseEpochAndQuorumId(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochQuorum(context.Background(), &types.QueryEpochQuorumRequest{
+				EpochNumber: epoch,
+				QuorumId:    quorumId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetEpochQuorumRow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-quorum-row [epoch] [quorum-id] [row-index]",
+		Short: "Query the signer of a row in a quorum of an epoch",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, quorumId, err := parseEpochAndQuorumId(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			rowIndex, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid row index %s: %w", args[2], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochQuorumRow(context.Background(), &types.QueryEpochQuorumRowRequest{
+				EpochNumber: epoch,
+				QuorumId:    quorumId,
+				RowIndex:    uint32(rowIndex),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetAggregatePubkeyG1() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-pubkey-g1 [epoch] [quorum-id] [quorum-bitmap]",
+		Short: "Query the aggregated G1 public key of the quorum members marked in the hex encoded bitmap",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, quorumId, err := parseEpochAndQuorumId(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			bitmap, err := hex.DecodeString(strings.TrimPrefix(args[2], "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid quorum bitmap %s: %w", args[2], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AggregatePubkeyG1(context.Background(), &types.QueryAggregatePubkeyG1Request{
+				EpochNumber:  epoch,
+				QuorumId:     quorumId,
+				QuorumBitmap: bitmap,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetVerifyQuorumSignature() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-quorum-signature [epoch] [quorum-id] [quorum-bitmap] [message-hash] [aggregate-signature] [aggregate-pubkey-g2]",
+		Short: "Verify the hex encoded aggregated signature of the quorum members marked in the hex encoded bitmap",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, quorumId, err := parseEpochAndQuorumId(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			names := []string{"quorum bitmap", "message hash", "aggregate signature", "aggregate pubkey g2"}
+			decoded := make([][]byte, len(names))
+			for i, name := range names {
+				decoded[i], err = hex.DecodeString(strings.TrimPrefix(args[i+2], "0x"))
+				if err != nil {
+					return fmt.Errorf("invalid %s %s: %w", name, args[i+2], err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VerifyQuorumSignature(context.Background(), &types.QueryVerifyQuorumSignatureRequest{
+				EpochNumber:        epoch,
+				QuorumId:           quorumId,
+				QuorumBitmap:       decoded[0],
+				MessageHash:        decoded[1],
+				AggregateSignature: decoded[2],
+				AggregatePubkeyG2:  decoded[3],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetSigner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer [account]...",
+		Short: "Query signers by hex account addresses",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			accounts := make([]string, len(args))
+			for i, arg := range args {
+				accounts[i] = strings.ToLower(strings.TrimPrefix(arg, "0x"))
+				if err := types.ValidateHexAddress(accounts[i]); err != nil {
+					return fmt.Errorf("invalid account %s: %w", arg, err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Signer(context.Background(), &types.QuerySignerRequest{
+				Accounts: accounts,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetSigners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signers",
+		Short: "Query all registered signers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Signers(context.Background(), &types.QuerySignersRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signers")
+
+	return cmd
+}
+
+func GetEpochRegistrations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-registrations [epoch]",
+		Short: "Query the signers registered for an epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid epoch %s: %w", args[0], err)
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochRegistrations(context.Background(), &types.QueryEpochRegistrationsRequest{
+				EpochNumber: epoch,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch registrations")
+
+	return cmd
+}
+
+func GetSignerEpochs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-epochs [account]",
+		Short: "Query the epochs a signer has registered for",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account := strings.ToLower(strings.TrimPrefix(args[0], "0x"))
+			if err := types.ValidateHexAddress(account); err != nil {
+				return fmt.Errorf("invalid account %s: %w", args[0], err)
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SignerEpochs(context.Background(), &types.QuerySignerEpochsRequest{
+				Account:    account,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signer epochs")
+
+	return cmd
+}
+
+func GetEpochRandomness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-randomness [epoch]",
+		Short: "Query the randomness aggregated from the VRF outputs of an epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid epoch %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochRandomness(context.Background(), &types.QueryEpochRandomnessRequest{
+				EpochNumber: epoch,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%x\n", res.Randomness))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetVrfKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vrf-key [account]",
+		Short: "Query the VRF public key of a signer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account := strings.ToLower(strings.TrimPrefix(args[0], "0x"))
+			if err := types.ValidateHexAddress(account); err != nil {
+				return fmt.Errorf("invalid account %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VrfKey(context.Background(), &types.QueryVrfKeyRequest{
+				Account: account,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseEpochAndQuorumId(epochArg string, quorumIdArg string) (uint64, uint64, error) {
+	epoch, err := strconv.ParseUint(epochArg, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid epoch %s: %w", epochArg, err)
+	}
+	quorumId, err := strconv.ParseUint(quorumIdArg, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid quorum id %s: %w", quorumIdArg, err)
+	}
+	return epoch, quorumId, nil
+}
+
+func GetSignerOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-operator [account]",
+		Short: "Query the operator authorized by a signer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account := strings.ToLower(strings.TrimPrefix(args[0], "0x"))
+			if err := types.ValidateHexAddress(account); err != nil {
+				return fmt.Errorf("invalid account %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SignerOperator(context.Background(), &types.QuerySignerOperatorRequest{
+				Account: account,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,13 +1,46 @@
 package cli
 
 import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"math/big"
+	"os"
+	"strings"
 
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	"github.com/0glabs/0g-chain/crypto/vrf"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+	vrfalgo "github.com/coniks-sys/coniks-go/crypto/vrf"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdkkr "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/common"
+	etherminttypes "github.com/evmos/ethermint/types"
 	"github.com/spf13/cobra"
 )
 
+const (
+	// FlagKeyFile defines the path of the file holding the hex encoded BN254 private key
+	FlagKeyFile = "key-file"
+	// FlagEpoch defines the epoch to register for
+	FlagEpoch = "epoch"
+	// FlagWithVrf defines whether to contribute a VRF output to the epoch randomness
+	FlagWithVrf = "with-vrf"
+	// FlagEndpoint defines an endpoint of the signer in the form of <protocol>://<host>:<port>?kind=<kind>[&tls_fingerprint=<hex>]
+	FlagEndpoint = "endpoint"
+	// FlagSigner defines the hex address of the signer the sender acts for as its operator
+	FlagSigner = "signer"
+)
+
+// vrfKeyNameSuffix is appended to the name of the sender key to name the VRF key in the keyring
+const vrfKeyNameSuffix = "-da-vrf"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -17,6 +50,367 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand()
+	cmd.AddCommand(
+		NewRegisterSignerCmd(),
+		NewUpdateSocketCmd(),
+		NewRegisterNextEpochCmd(),
+		NewSetVrfKeyCmd(),
+		NewSetOperatorCmd(),
+	)
+	return cmd
+}
+
+func NewRegisterSignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-signer [socket]",
+		Short: "Register the sender as a DA signer with the BN254 key in the key file",
+		Long: `Register the sender as a DA signer with the BN254 key in the key file.
+The endpoints of the signer are given by the endpoint flags, or derived from the socket if no endpoint flag is given.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			socket, endpoints, err := parseSocketAndEndpoints(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			sk, err := loadBN254PrivateKey(cmd)
+			if err != nil {
+				return err
+			}
+			chainID, err := etherminttypes.ParseChainID(clientCtx.ChainID)
+			if err != nil {
+				return err
+			}
+
+			account := hex.EncodeToString(clientCtx.GetFromAddress().Bytes())
+			hash := types.PubkeyRegistrationHash(common.HexToAddress(account), chainID)
+			msg := &types.MsgRegisterSigner{
+				Signer: &types.Signer{
+					Account:   account,
+					Socket:    socket,
+					PubkeyG1:  bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+					PubkeyG2:  bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+					Endpoints: endpoints,
+				},
+				Signature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, sk)),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagKeyFile, "", "path of the file holding the hex encoded BN254 private key")
+	_ = cmd.MarkFlagRequired(FlagKeyFile)
+	addEndpointFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewUpdateSocketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-socket [socket]",
+		Short: "Update the socket address and endpoints of the sender",
+		Long: `Update the socket address and endpoints of the sender.
+The endpoints of the signer are replaced by the endpoint flags, or derived from the socket if no endpoint flag is given.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			socket, endpoints, err := parseSocketAndEndpoints(cmd, args)
+			if err != nil {
+				return err
+			}
+			account, operator, err := parseSignerAndOperator(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgUpdateSocket{
+				Account:   account,
+				Socket:    socket,
+				Endpoints: endpoints,
+				Operator:  operator,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addEndpointFlag(cmd)
+	addSignerFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func addSignerFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagSigner, "", "hex address of the signer to act for as its operator, defaults to the sender")
+}
+
+// parseSignerAndOperator returns the signer account and the operator, the operator is empty if the sender is the signer
+func parseSignerAndOperator(cmd *cobra.Command, clientCtx client.Context) (string, string, error) {
+	sender := hex.EncodeToString(clientCtx.GetFromAddress().Bytes())
+	signer, err := cmd.Flags().GetString(FlagSigner)
+	if err != nil {
+		return "", "", err
+	}
+	signer = strings.ToLower(strings.TrimPrefix(signer, "0x"))
+	if signer == "" || signer == sender {
+		return sender, "", nil
+	}
+	if err := types.ValidateHexAddress(signer); err != nil {
+		return "", "", fmt.Errorf("invalid signer %s: %w", signer, err)
+	}
+	return signer, sender, nil
+}
+
+func addEndpointFlag(cmd *cobra.Command) {
+	cmd.Flags().StringArray(FlagEndpoint, nil, "endpoint of the signer in the form of <protocol>://<host>:<port>?kind=<dispersal|retrieval>[&tls_fingerprint=<hex>], can be repeated")
+}
+
+// parseSocketAndEndpoints reads the optional socket argument and the endpoint flags
+func parseSocketAndEndpoints(cmd *cobra.Command, args []string) (string, []*types.SignerEndpoint, error) {
+	socket := ""
+	if len(args) > 0 {
+		socket = args[0]
+	}
+	uris, err := cmd.Flags().GetStringArray(FlagEndpoint)
+	if err != nil {
+		return "", nil, err
+	}
+	endpoints := make([]*types.SignerEndpoint, len(uris))
+	for i, uri := range uris {
+		endpoints[i], err = types.ParseEndpoint(uri)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid endpoint %s: %w", uri, err)
+		}
+	}
+	return socket, endpoints, nil
+}
+
+func NewRegisterNextEpochCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-next-epoch",
+		Short: "Register the sender for the next epoch with the BN254 key in the key file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sk, err := loadBN254PrivateKey(cmd)
+			if err != nil {
+				return err
+			}
+			chainID, err := etherminttypes.ParseChainID(clientCtx.ChainID)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := cmd.Flags().GetUint64(FlagEpoch)
+			if err != nil {
+				return err
+			}
+			if epoch == 0 {
+				res, err := types.NewQueryClient(clientCtx).EpochNumber(context.Background(), &types.QueryEpochNumberRequest{})
+				if err != nil {
+					return err
+				}
+				epoch = res.EpochNumber + 1
+			}
+
+			account, operator, err := parseSignerAndOperator(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			hash := types.EpochRegistrationHash(common.HexToAddress(account), epoch, chainID)
+			msg := &types.MsgRegisterNextEpoch{
+				Account:   account,
+				Signature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, sk)),
+				Operator:  operator,
+			}
+
+			withVrf, err := cmd.Flags().GetBool(FlagWithVrf)
+			if err != nil {
+				return err
+			}
+			if withVrf {
+				vrfSk, err := loadVrfPrivateKey(clientCtx)
+				if err != nil {
+					return err
+				}
+				res, err := types.NewQueryClient(clientCtx).EpochRandomness(context.Background(), &types.QueryEpochRandomnessRequest{EpochNumber: epoch - 1})
+				if err != nil {
+					return err
+				}
+				msg.VrfOutput, msg.VrfProof = vrfSk.Prove(types.EpochVrfSeed(res.Randomness, epoch, chainID))
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagKeyFile, "", "path of the file holding the hex encoded BN254 private key")
+	cmd.Flags().Uint64(FlagEpoch, 0, "the epoch to register for, defaults to the next epoch queried from the node")
+	cmd.Flags().Bool(FlagWithVrf, false, "contribute a VRF output to the epoch randomness with the VRF key created by set-vrf-key")
+	_ = cmd.MarkFlagRequired(FlagKeyFile)
+	addSignerFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetVrfKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-vrf-key",
+		Short: "Create a VRF key in the keyring and set it as the VRF key of the sender",
+		Long: fmt.Sprintf(`Create a VRF key named after the sender key with the suffix %s in the keyring,
+and set it as the VRF key of the sender. The key is effective from the registrations of the epoch after next.`, vrfKeyNameSuffix),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// bypass the restriction of set keyring options
+			ctx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(vrf.VrfOption())
+			client.SetCmdClientContext(cmd, ctx)
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			kr := clientCtx.Keyring
+			accRecord, err := kr.KeyByAddress(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			vrfKeyName := accRecord.Name + vrfKeyNameSuffix
+			if _, err := kr.Key(vrfKeyName); err == nil {
+				response, err := input.GetConfirmation(fmt.Sprintf("override the existing name %s", vrfKeyName), bufio.NewReader(clientCtx.Input), cmd.ErrOrStderr())
+				if err != nil {
+					return err
+				}
+				if !response {
+					return errors.New("aborted")
+				}
+				if err := kr.Delete(vrfKeyName); err != nil {
+					return err
+				}
+			}
+
+			keyringAlgos, _ := kr.SupportedAlgorithms()
+			algo, err := sdkkr.NewSigningAlgoFromString(vrf.KeyType, keyringAlgos)
+			if err != nil {
+				return err
+			}
+			record, err := kr.NewAccount(vrfKeyName, "", "", "", algo)
+			if err != nil {
+				return err
+			}
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			account, operator, err := parseSignerAndOperator(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgSetVrfKey{
+				Account:  account,
+				Pubkey:   pubKey.Bytes(),
+				Operator: operator,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignerFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetOperatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-operator [operator]",
+		Short: "Authorize an operator to manage the signer of the sender, revoke the authorization if the operator is omitted",
+		Long: `Authorize an operator to update the endpoints, register for epochs and set the VRF key on behalf of the signer of the sender.
+The operator acts with the --signer flag, and the authorization is revoked if the operator is omitted.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operator := ""
+			if len(args) > 0 {
+				operator = strings.ToLower(strings.TrimPrefix(args[0], "0x"))
+			}
+			msg := &types.MsgSetOperator{
+				Account:  hex.EncodeToString(clientCtx.GetFromAddress().Bytes()),
+				Operator: operator,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// loadVrfPrivateKey reads the VRF key of the sender created by set-vrf-key from the keyring
+func loadVrfPrivateKey(clientCtx client.Context) (vrfalgo.PrivateKey, error) {
+	accRecord, err := clientCtx.Keyring.KeyByAddress(clientCtx.GetFromAddress())
+	if err != nil {
+		return nil, err
+	}
+	record, err := clientCtx.Keyring.Key(accRecord.Name + vrfKeyNameSuffix)
+	if err != nil {
+		return nil, err
+	}
+	local := record.GetLocal()
+	if local == nil || local.PrivKey == nil {
+		return nil, fmt.Errorf("VRF key %s is not a local key", record.Name)
+	}
+	var sk vrf.PrivKey
+	if err := sk.Unmarshal(local.PrivKey.Value); err != nil {
+		return nil, err
+	}
+	return vrfalgo.PrivateKey(sk.Key), nil
+}
+
+// loadBN254PrivateKey reads the hex encoded BN254 private key from the file given by the key file flag
+func loadBN254PrivateKey(cmd *cobra.Command) (*big.Int, error) {
+	path, err := cmd.Flags().GetString(FlagKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	sk, ok := new(big.Int).SetString(strings.TrimPrefix(strings.TrimSpace(string(bz)), "0x"), 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid BN254 private key in %s", path)
+	}
+	if sk.Sign() <= 0 || sk.Cmp(bn254util.FR_MODULUS) >= 0 {
+		return nil, fmt.Errorf("BN254 private key in %s out of range", path)
+	}
+	return sk, nil
+}
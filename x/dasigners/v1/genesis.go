@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	etherminttypes "github.com/evmos/ethermint/types"
 
 	"github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
@@ -14,14 +15,89 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, gs types.GenesisState) {
 	if err := gs.Validate(); err != nil {
 		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
 	}
+	if len(gs.Registrations) > 0 {
+		chainID, err := etherminttypes.ParseChainID(ctx.ChainID())
+		if err != nil {
+			panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+		}
+		if err := gs.ValidateRegistrationSignatures(chainID); err != nil {
+			panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+		}
+	}
 	keeper.SetEpochNumber(ctx, gs.EpochNumber)
 	for _, signer := range gs.Signers {
-		if err := keeper.SetSigner(ctx, *signer); err != nil {
+		s := *signer
+		if len(s.Endpoints) == 0 {
+			// signers exported before the introduction of endpoints only carry the socket,
+			// malformed legacy sockets are kept without endpoints as in the v4 migration
+			s.Endpoints, _ = types.EndpointsFromSocket(s.Socket)
+		} else if err := s.SetEndpoints(s.Socket, s.Endpoints); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+		if err := keeper.SetSigner(ctx, s); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	// the bonded index is rebuilt from the staking state, which is initialized before this module
+	if err := keeper.IndexSignersBonded(ctx); err != nil {
+		panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+	}
+	// epochs out of the retention window are dropped
+	earliestEpoch := gs.Params.EarliestRetainedEpoch(gs.EpochNumber)
+	if earliestEpoch < gs.EarliestEpoch {
+		earliestEpoch = gs.EarliestEpoch
+	}
+	for i, quorums := range gs.QuorumsByEpoch {
+		epoch := gs.EarliestEpoch + uint64(i)
+		if epoch < earliestEpoch {
+			continue
+		}
+		keeper.SetEpochQuorums(ctx, epoch, *quorums)
+	}
+	keeper.SetEarliestEpoch(ctx, earliestEpoch)
+	for _, registration := range gs.Registrations {
+		if registration.Epoch < earliestEpoch {
+			continue
+		}
+		if err := keeper.SetRegistration(ctx, registration.Epoch, registration.Account, registration.Signature); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, history := range gs.SignerKeyHistories {
+		if err := keeper.SetSignerKeyHistory(ctx, *history); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, deregistration := range gs.Deregistrations {
+		if err := keeper.SetDeregistration(ctx, deregistration.Account, deregistration.Epoch); err != nil {
 			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
 		}
 	}
-	for epoch, quorums := range gs.QuorumsByEpoch {
-		keeper.SetEpochQuorums(ctx, uint64(epoch), *quorums)
+	for _, jail := range gs.Jails {
+		if err := keeper.SetJail(ctx, *jail); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, liveness := range gs.Liveness {
+		if err := keeper.SetLiveness(ctx, *liveness); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, attestation := range gs.Attestations {
+		keeper.SetAttestation(ctx, *attestation)
+	}
+	for _, vrfKey := range gs.VrfKeys {
+		if err := keeper.SetSignerVrfKey(ctx, *vrfKey); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, operator := range gs.Operators {
+		if err := keeper.SetSignerOperator(ctx, *operator); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	if len(gs.EpochRandomness) > 0 {
+		keeper.SetEpochRandomness(ctx, gs.EpochNumber, gs.EpochRandomness)
 	}
 	keeper.SetParams(ctx, gs.Params)
 }
@@ -38,15 +114,19 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		signers = append(signers, &signer)
 		return false
 	})
+	earliestEpoch := params.EarliestRetainedEpoch(epochNumber)
+	if earliestEpoch < keeper.GetEarliestEpoch(ctx) {
+		earliestEpoch = keeper.GetEarliestEpoch(ctx)
+	}
 	epochQuorums := make([]*types.Quorums, 0)
-	for i := 0; i <= int(epochNumber); i += 1 {
-		quorumCnt, err := keeper.GetQuorumCount(ctx, uint64(i))
+	for i := earliestEpoch; i <= epochNumber; i += 1 {
+		quorumCnt, err := keeper.GetQuorumCount(ctx, i)
 		if err != nil {
 			panic("historical quorums not found")
 		}
 		quorums := make([]*types.Quorum, quorumCnt)
 		for quorumId := uint64(0); quorumId < quorumCnt; quorumId += 1 {
-			quorum, err := keeper.GetEpochQuorum(ctx, uint64(i), quorumId)
+			quorum, err := keeper.GetEpochQuorum(ctx, i, quorumId)
 			if err != nil {
 				panic("failed to load historical quorum")
 			}
@@ -54,5 +134,64 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		}
 		epochQuorums = append(epochQuorums, &types.Quorums{Quorums: quorums})
 	}
-	return types.NewGenesisState(params, epochNumber, signers, epochQuorums)
+	signerKeyHistories := make([]*types.SignerKeyHistory, 0)
+	keeper.IterateSignerKeyHistories(ctx, func(history types.SignerKeyHistory) (stop bool) {
+		signerKeyHistories = append(signerKeyHistories, &history)
+		return false
+	})
+	deregistrations := make([]*types.Deregistration, 0)
+	keeper.IterateDeregistrations(ctx, func(account string, epoch uint64) (stop bool) {
+		deregistrations = append(deregistrations, &types.Deregistration{
+			Account: account,
+			Epoch:   epoch,
+		})
+		return false
+	})
+	jails := make([]*types.SignerJail, 0)
+	keeper.IterateJails(ctx, func(jail types.SignerJail) (stop bool) {
+		jails = append(jails, &jail)
+		return false
+	})
+	// the liveness of the previous epoch is handled once current epoch closes
+	liveness := make([]*types.SignerLiveness, 0)
+	attestations := make([]*types.Attestation, 0)
+	epochs := []uint64{epochNumber}
+	if epochNumber > 0 {
+		epochs = []uint64{epochNumber - 1, epochNumber}
+	}
+	for _, epoch := range epochs {
+		keeper.IterateLiveness(ctx, epoch, func(record types.SignerLiveness) (stop bool) {
+			liveness = append(liveness, &record)
+			return false
+		})
+		keeper.IterateAttestations(ctx, epoch, func(attestation types.Attestation) (stop bool) {
+			attestations = append(attestations, &attestation)
+			return false
+		})
+	}
+	// registrations of the retained epochs, including the pending ones for the next epoch
+	registrations := make([]*types.Registration, 0)
+	for epoch := earliestEpoch; epoch <= epochNumber+1; epoch += 1 {
+		keeper.IterateRegistrations(ctx, epoch, func(account string, signature []byte) (stop bool) {
+			registrations = append(registrations, &types.Registration{
+				Account:   account,
+				Epoch:     epoch,
+				Signature: signature,
+			})
+			return false
+		})
+	}
+	vrfKeys := make([]*types.SignerVrfKey, 0)
+	keeper.IterateSignerVrfKeys(ctx, func(vrfKey types.SignerVrfKey) (stop bool) {
+		vrfKeys = append(vrfKeys, &vrfKey)
+		return false
+	})
+	operators := make([]*types.SignerOperator, 0)
+	keeper.IterateSignerOperators(ctx, func(operator types.SignerOperator) (stop bool) {
+		operators = append(operators, &operator)
+		return false
+	})
+	// the VRF outputs of pending registrations are not exported, the randomness of next epoch is chained from current epoch only
+	epochRandomness := keeper.GetEpochRandomness(ctx, epochNumber)
+	return types.NewGenesisState(params, epochNumber, earliestEpoch, signers, epochQuorums, signerKeyHistories, deregistrations, jails, liveness, registrations, vrfKeys, epochRandomness, operators, attestations)
 }
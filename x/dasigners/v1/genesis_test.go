@@ -1,14 +1,19 @@
 package dasigners_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/crypto/bn254util"
 	"github.com/0glabs/0g-chain/x/dasigners/v1"
+	v4dasigners "github.com/0glabs/0g-chain/x/dasigners/v1/migrations/v4"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/testutil"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
 )
@@ -38,14 +43,14 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				MaxQuorums:        10,
 				EpochBlocks:       5760,
 				EncodedSlices:     1,
-			}, 0, []*types.Signer{{
+			}, 0, 0, []*types.Signer{{
 				Account:  "0000000000000000000000000000000000000001",
 				Socket:   "0.0.0.0:1234",
 				PubkeyG1: make([]byte, 64),
 				PubkeyG2: make([]byte, 128),
 			}}, []*types.Quorums{{
 				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
-			}}),
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
 			expectPass: true,
 		},
 		{
@@ -56,14 +61,14 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				MaxQuorums:        10,
 				EpochBlocks:       5760,
 				EncodedSlices:     1,
-			}, 0, []*types.Signer{{
+			}, 0, 0, []*types.Signer{{
 				Account:  "0x0000000000000000000000000000000000000001",
 				Socket:   "0.0.0.0:1234",
 				PubkeyG1: make([]byte, 64),
 				PubkeyG2: make([]byte, 128),
 			}}, []*types.Quorums{{
 				Quorums: []*types.Quorum{{Signers: []string{"0x0000000000000000000000000000000000000001"}}},
-			}}),
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
 			expectPass: false,
 		},
 		{
@@ -74,14 +79,14 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				MaxQuorums:        10,
 				EpochBlocks:       5760,
 				EncodedSlices:     1,
-			}, 0, []*types.Signer{{
+			}, 0, 0, []*types.Signer{{
 				Account:  "0000000000000000000000000000000000000001",
 				Socket:   "0.0.0.0:1234",
 				PubkeyG1: make([]byte, 63),
 				PubkeyG2: make([]byte, 128),
 			}}, []*types.Quorums{{
 				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
-			}}),
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
 			expectPass: false,
 		},
 		{
@@ -92,14 +97,58 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				MaxQuorums:        10,
 				EpochBlocks:       5760,
 				EncodedSlices:     1,
-			}, 0, []*types.Signer{{
+			}, 0, 0, []*types.Signer{{
 				Account:  "0000000000000000000000000000000000000001",
 				Socket:   "0.0.0.0:1234",
 				PubkeyG1: make([]byte, 64),
 				PubkeyG2: make([]byte, 129),
 			}}, []*types.Quorums{{
 				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
-			}}),
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
+			expectPass: false,
+		},
+		{
+			name: "normal-rotated-and-deregistered",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+			}, 1, 0, []*types.Signer{{
+				Account:  "0000000000000000000000000000000000000001",
+				Socket:   "0.0.0.0:1234",
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Quorums{{
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}, {
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}}, []*types.SignerKeyHistory{{
+				Account:  "0000000000000000000000000000000000000001",
+				Epoch:    0,
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Deregistration{{
+				Account: "0000000000000000000000000000000000000001",
+				Epoch:   1,
+			}}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
+			expectPass: true,
+		},
+		{
+			name: "deregistered signer missing",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+			}, 0, 0, []*types.Signer{}, []*types.Quorums{{
+				Quorums: []*types.Quorum{},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{{
+				Account: "0000000000000000000000000000000000000001",
+				Epoch:   0,
+			}}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
 			expectPass: false,
 		},
 		{
@@ -110,12 +159,286 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				MaxQuorums:        10,
 				EpochBlocks:       5760,
 				EncodedSlices:     1,
-			}, 0, []*types.Signer{{
+			}, 0, 0, []*types.Signer{{
 				Account:  "0000000000000000000000000000000000000001",
 				Socket:   "0.0.0.0:1234",
 				PubkeyG1: make([]byte, 64),
 				PubkeyG2: make([]byte, 128),
-			}}, []*types.Quorums{}),
+			}}, []*types.Quorums{}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
+			expectPass: false,
+		},
+		{
+			name: "normal-jailed",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+			}, 0, 0, []*types.Signer{{
+				Account:  "0000000000000000000000000000000000000001",
+				Socket:   "0.0.0.0:1234",
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Quorums{{
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{{
+				Account:    "0000000000000000000000000000000000000001",
+				UntilEpoch: 3,
+			}}, []*types.SignerLiveness{{
+				Account:      "0000000000000000000000000000000000000001",
+				Epoch:        0,
+				Attestations: 2,
+				Signed:       1,
+			}}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
+			expectPass: true,
+		},
+		{
+			name: "jailed signer missing",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+			}, 0, 0, []*types.Signer{}, []*types.Quorums{{
+				Quorums: []*types.Quorum{},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{{
+				Account:    "0000000000000000000000000000000000000001",
+				Tombstoned: true,
+			}}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
+			expectPass: false,
+		},
+		{
+			name: "liveness of past epoch",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+			}, 2, 0, []*types.Signer{{
+				Account:  "0000000000000000000000000000000000000001",
+				Socket:   "0.0.0.0:1234",
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Quorums{{
+				Quorums: []*types.Quorum{},
+			}, {
+				Quorums: []*types.Quorum{},
+			}, {
+				Quorums: []*types.Quorum{},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{{
+				Account:      "0000000000000000000000000000000000000001",
+				Epoch:        0,
+				Attestations: 1,
+			}}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
+			expectPass: false,
+		},
+		{
+			name: "liveness and attestations of previous epoch",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+			}, 1, 0, []*types.Signer{{
+				Account:  "0000000000000000000000000000000000000001",
+				Socket:   "0.0.0.0:1234",
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Quorums{{
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}, {
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{{
+				Account:      "0000000000000000000000000000000000000001",
+				Epoch:        0,
+				Attestations: 1,
+				Signed:       1,
+			}, {
+				Account:      "0000000000000000000000000000000000000001",
+				Epoch:        1,
+				Attestations: 1,
+			}}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, []*types.Attestation{{
+				Epoch:        0,
+				QuorumId:     0,
+				BlobId:       make([]byte, 32),
+				Commitment:   []byte("commitment"),
+				QuorumBitmap: []byte{1},
+			}}),
+			expectPass: true,
+		},
+		{
+			name: "attestation bitmap length mismatch",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+			}, 0, 0, []*types.Signer{{
+				Account:  "0000000000000000000000000000000000000001",
+				Socket:   "0.0.0.0:1234",
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Quorums{{
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, []*types.Attestation{{
+				Epoch:        0,
+				QuorumId:     0,
+				BlobId:       make([]byte, 32),
+				Commitment:   []byte("commitment"),
+				QuorumBitmap: []byte{1, 0},
+			}}),
+			expectPass: false,
+		},
+		{
+			name: "invalid slash fraction",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:                10,
+				MaxVotesPerSigner:            1024,
+				MaxQuorums:                   10,
+				EpochBlocks:                  5760,
+				EncodedSlices:                1,
+				SlashFractionEquivocationBps: 10001,
+			}, 0, 0, []*types.Signer{}, []*types.Quorums{{
+				Quorums: []*types.Quorum{},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
+			expectPass: false,
+		},
+		{
+			name: "normal-pruned",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+				EpochRetention:    2,
+			}, 3, 2, []*types.Signer{{
+				Account:  "0000000000000000000000000000000000000001",
+				Socket:   "0.0.0.0:1234",
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Quorums{{
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}, {
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
+			expectPass: true,
+		},
+		{
+			name: "invalid earliest epoch",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+			}, 1, 2, []*types.Signer{}, []*types.Quorums{}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
+			expectPass: false,
+		},
+		{
+			name: "normal-vrf",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+				QuorumSelection:   types.QUORUM_SELECTION_VRF,
+			}, 0, 0, []*types.Signer{{
+				Account:  "0000000000000000000000000000000000000001",
+				Socket:   "0.0.0.0:1234",
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Quorums{{
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{{
+				Account:        "0000000000000000000000000000000000000001",
+				Pubkey:         make([]byte, 32),
+				EffectiveEpoch: 2,
+			}}, make([]byte, 32), nil, nil),
+			expectPass: true,
+		},
+		{
+			name: "invalid vrf pubkey",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+			}, 0, 0, []*types.Signer{{
+				Account:  "0000000000000000000000000000000000000001",
+				Socket:   "0.0.0.0:1234",
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Quorums{{
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{{
+				Account:        "0000000000000000000000000000000000000001",
+				Pubkey:         make([]byte, 31),
+				EffectiveEpoch: 2,
+			}}, nil, nil, nil),
+			expectPass: false,
+		},
+		{
+			name: "invalid quorum selection",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+				QuorumSelection:   2,
+			}, 0, 0, []*types.Signer{}, []*types.Quorums{{
+				Quorums: []*types.Quorum{},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, nil, nil),
+			expectPass: false,
+		},
+		{
+			name: "normal-operator",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+			}, 0, 0, []*types.Signer{{
+				Account:  "0000000000000000000000000000000000000001",
+				Socket:   "0.0.0.0:1234",
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Quorums{{
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, []*types.SignerOperator{{
+				Account:  "0000000000000000000000000000000000000001",
+				Operator: "00000000000000000000000000000000000000aa",
+			}}, nil),
+			expectPass: true,
+		},
+		{
+			name: "operator of unknown signer",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:     10,
+				MaxVotesPerSigner: 1024,
+				MaxQuorums:        10,
+				EpochBlocks:       5760,
+				EncodedSlices:     1,
+			}, 0, 0, []*types.Signer{{
+				Account:  "0000000000000000000000000000000000000001",
+				Socket:   "0.0.0.0:1234",
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Quorums{{
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, []*types.Registration{}, []*types.SignerVrfKey{}, nil, []*types.SignerOperator{{
+				Account:  "0000000000000000000000000000000000000002",
+				Operator: "00000000000000000000000000000000000000aa",
+			}}, nil),
 			expectPass: false,
 		},
 	}
@@ -140,6 +463,10 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 
 			// Check
 			if tc.expectPass {
+				// endpoints are derived from the socket of the signers
+				for _, signer := range tc.genState.Signers {
+					suite.Require().NoError(signer.SetEndpoints(signer.Socket, signer.Endpoints))
+				}
 				expectedJson, err := suite.App.AppCodec().MarshalJSON(tc.genState)
 				suite.Require().NoError(err)
 				actualJson, err := suite.App.AppCodec().MarshalJSON(exportedGenState)
@@ -150,6 +477,131 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestInitGenesis_Registrations() {
+	account := "0000000000000000000000000000000000000001"
+	sk := big.NewInt(7)
+	signer := &types.Signer{
+		Account:  account,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+		PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+	}
+	chainID := big.NewInt(9999)
+	sign := func(epoch uint64, sk *big.Int) []byte {
+		hash := types.EpochRegistrationHash(common.HexToAddress(account), epoch, chainID)
+		return bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, sk))
+	}
+	genState := func(registrations []*types.Registration) *types.GenesisState {
+		params := types.DefaultGenesisState().Params
+		return types.NewGenesisState(params, 1, 0, []*types.Signer{signer}, []*types.Quorums{{
+			Quorums: []*types.Quorum{{Signers: []string{account}}},
+		}, {
+			Quorums: []*types.Quorum{{Signers: []string{account}}},
+		}}, []*types.SignerKeyHistory{}, []*types.Deregistration{}, []*types.SignerJail{}, []*types.SignerLiveness{}, registrations, []*types.SignerVrfKey{}, nil, nil, nil)
+	}
+
+	testCases := []struct {
+		name       string
+		genState   *types.GenesisState
+		expectPass bool
+	}{
+		{
+			name: "normal",
+			genState: genState([]*types.Registration{
+				{Account: account, Epoch: 1, Signature: sign(1, sk)},
+				{Account: account, Epoch: 2, Signature: sign(2, sk)},
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid signature",
+			genState: genState([]*types.Registration{
+				{Account: account, Epoch: 2, Signature: sign(2, big.NewInt(8))},
+			}),
+			expectPass: false,
+		},
+		{
+			name: "signed for another epoch",
+			genState: genState([]*types.Registration{
+				{Account: account, Epoch: 2, Signature: sign(1, sk)},
+			}),
+			expectPass: false,
+		},
+		{
+			name: "epoch beyond next epoch",
+			genState: genState([]*types.Registration{
+				{Account: account, Epoch: 3, Signature: sign(3, sk)},
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate registration",
+			genState: genState([]*types.Registration{
+				{Account: account, Epoch: 2, Signature: sign(2, sk)},
+				{Account: account, Epoch: 2, Signature: sign(2, sk)},
+			}),
+			expectPass: false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.App = app.NewTestApp()
+			suite.Keeper = suite.App.GetDASignersKeeper()
+			suite.Ctx = suite.App.NewContext(true, tmproto.Header{ChainID: app.TestChainId})
+
+			// the validate-genesis command verifies the signatures against the chain ID of the genesis doc
+			cdc := suite.App.AppCodec()
+			bz, err := cdc.MarshalJSON(tc.genState)
+			suite.Require().NoError(err)
+			err = dasigners.AppModuleBasic{}.ValidateGenesisWithChainID(cdc, bz, app.TestChainId)
+
+			var exportedGenState *types.GenesisState
+			run := func() {
+				dasigners.InitGenesis(suite.Ctx, suite.Keeper, *tc.genState)
+				exportedGenState = dasigners.ExportGenesis(suite.Ctx, suite.Keeper)
+			}
+			if !tc.expectPass {
+				suite.Require().Error(err)
+				suite.Require().Panics(run)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Error(dasigners.AppModuleBasic{}.ValidateGenesisWithChainID(cdc, bz, "surge_8888-1"))
+			suite.Require().NotPanics(run)
+			suite.Equal(tc.genState.Registrations, exportedGenState.Registrations)
+		})
+	}
+}
+
+func (suite *GenesisTestSuite) TestInitGenesis_LegacySocket() {
+	suite.App = app.NewTestApp()
+	suite.Keeper = suite.App.GetDASignersKeeper()
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{})
+	dasigners.InitGenesis(suite.Ctx, suite.Keeper, *types.DefaultGenesisState())
+
+	// a signer registered before the introduction of endpoints with a malformed socket
+	signer := types.Signer{
+		Account:  "0000000000000000000000000000000000000001",
+		Socket:   "malformed",
+		PubkeyG1: make([]byte, 64),
+		PubkeyG2: make([]byte, 128),
+	}
+	suite.Require().NoError(suite.Keeper.SetSigner(suite.Ctx, signer))
+	storeKey := suite.App.GetKVStoreKey(types.StoreKey)
+	suite.Require().NoError(v4dasigners.MigrateStore(suite.Ctx, storeKey, suite.App.AppCodec()))
+	exportedGenState := dasigners.ExportGenesis(suite.Ctx, suite.Keeper)
+	suite.Require().Equal([]*types.Signer{&signer}, exportedGenState.Signers)
+
+	// the exported state is imported unchanged
+	suite.App = app.NewTestApp()
+	suite.Keeper = suite.App.GetDASignersKeeper()
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{})
+	suite.Require().NotPanics(func() {
+		dasigners.InitGenesis(suite.Ctx, suite.Keeper, *exportedGenState)
+	})
+	suite.Require().Equal(exportedGenState, dasigners.ExportGenesis(suite.Ctx, suite.Keeper))
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
@@ -17,8 +17,18 @@ type Ballot struct {
 	content []byte
 }
 
+func NewBallot(account string, content []byte) Ballot {
+	return Ballot{account: account, content: content}
+}
+
+// Account returns the account of the ballot owner
+func (b Ballot) Account() string {
+	return b.account
+}
+
 func (k Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	params := k.GetParams(ctx)
+	k.PruneEpochs(ctx, params)
 	if uint64(ctx.BlockHeight())%params.EpochBlocks != 0 {
 		return
 	}
@@ -28,6 +38,14 @@ func (k Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 		panic(err)
 	}
 	expectedEpoch := epochNumber + 1
+	// punish the signers offline in the epoch before the closing one before choosing quorums, the signatures of its
+	// attestations are accepted until the closing epoch ends
+	if epochNumber > 0 {
+		k.HandleEpochLiveness(ctx, epochNumber-1, params)
+	}
+	if params.QuorumSelection == types.QUORUM_SELECTION_VRF {
+		k.HandleVrfWithholding(ctx, epochNumber, params)
+	}
 	// new epoch
 	k.Logger(ctx).Info(fmt.Sprintf("[BeginBlock] generating epoch %v", expectedEpoch))
 	registrations := []Ballot{}
@@ -41,6 +59,14 @@ func (k Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	ballots := []Ballot{}
 	tokensPerVote := sdk.NewIntFromUint64(params.TokensPerVote)
 	for _, registration := range registrations {
+		// skip deregistered signers
+		if lastEpoch, deregistered, err := k.GetDeregistration(ctx, registration.account); err != nil || (deregistered && lastEpoch < expectedEpoch) {
+			continue
+		}
+		// skip jailed signers
+		if jailed, err := k.IsJailed(ctx, registration.account, expectedEpoch); err != nil || jailed {
+			continue
+		}
 		// get validator
 		accAddr, err := sdk.AccAddressFromHexUnsafe(registration.account)
 		if err != nil {
@@ -62,9 +88,15 @@ func (k Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 			content = crypto.Keccak256(content)
 		}
 	}
-	sort.Slice(ballots, func(i, j int) bool {
-		return bytes.Compare(ballots[i].content, ballots[j].content) < 0
-	})
+	randomness := k.GenerateEpochRandomness(ctx, expectedEpoch)
+	switch params.QuorumSelection {
+	case types.QUORUM_SELECTION_VRF:
+		ShuffleBallots(ballots, randomness)
+	default:
+		sort.Slice(ballots, func(i, j int) bool {
+			return bytes.Compare(ballots[i].content, ballots[j].content) < 0
+		})
+	}
 
 	quorums := types.Quorums{
 		Quorums: make([]*types.Quorum, 0),
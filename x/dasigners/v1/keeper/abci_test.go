@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
@@ -9,6 +10,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -141,6 +143,215 @@ func (suite *AbciTestSuite) TestBeginBlock_Success() {
 	suite.Assert().EqualValues(cnt, 10)
 }
 
+func (suite *AbciTestSuite) TestBeginBlock_Downtime() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		TokensPerVote:     10,
+		MaxVotesPerSigner: 200,
+		MaxQuorums:        10,
+		EpochBlocks:       5760,
+		EncodedSlices:     10,
+		JailEpochs:        1,
+		MinAttestations:   2,
+		MinSignedBps:      5000,
+	})
+	params := suite.Keeper.GetParams(suite.Ctx)
+	account := "0000000000000000000000000000000000000001"
+	suite.AddDelegation(account, account, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	suite.Keeper.SetSigner(suite.Ctx, types.Signer{
+		Account:  account,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: common.LeftPadBytes([]byte{1}, 32),
+		PubkeyG2: common.LeftPadBytes([]byte{2}, 64),
+	})
+	nextEpoch := func(liveness *types.SignerLiveness) uint64 {
+		epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+		suite.Require().NoError(err)
+		if liveness != nil {
+			liveness.Account = account
+			liveness.Epoch = epoch
+			suite.Require().NoError(suite.Keeper.SetLiveness(suite.Ctx, *liveness))
+		}
+		suite.Keeper.SetRegistration(suite.Ctx, epoch+1, account, common.LeftPadBytes([]byte{1}, 32))
+		suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks) * int64(epoch+1))
+		suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+		cnt, err := suite.Keeper.GetQuorumCount(suite.Ctx, epoch+1)
+		suite.Require().NoError(err)
+		return cnt
+	}
+	// the liveness of an epoch is handled once the next epoch closes
+	suite.Assert().EqualValues(1, nextEpoch(&types.SignerLiveness{Attestations: 1}))
+	// too few attestations to enforce liveness
+	suite.Assert().EqualValues(1, nextEpoch(&types.SignerLiveness{Attestations: 4, Signed: 2}))
+	// enough signed attestations
+	suite.Assert().EqualValues(1, nextEpoch(&types.SignerLiveness{Attestations: 4, Signed: 1}))
+	epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	liveness, err := suite.Keeper.GetLiveness(suite.Ctx, epoch-1, account)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(4, liveness.Attestations)
+	// offline, excluded from the next epoch
+	suite.Assert().EqualValues(0, nextEpoch(nil))
+	epoch, err = suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	jail, found, err := suite.Keeper.GetJail(suite.Ctx, account)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Assert().EqualValues(types.SignerJail{Account: account, UntilEpoch: epoch}, jail)
+	liveness, err = suite.Keeper.GetLiveness(suite.Ctx, epoch-2, account)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(0, liveness.Attestations)
+	// released after the jail epochs
+	suite.Assert().EqualValues(1, nextEpoch(nil))
+}
+
+func (suite *AbciTestSuite) TestBeginBlock_Prune() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		TokensPerVote:     10,
+		MaxVotesPerSigner: 200,
+		MaxQuorums:        10,
+		EpochBlocks:       5760,
+		EncodedSlices:     10,
+		EpochRetention:    2,
+	})
+	params := suite.Keeper.GetParams(suite.Ctx)
+	account := "0000000000000000000000000000000000000001"
+	suite.AddDelegation(account, account, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	suite.Keeper.SetSigner(suite.Ctx, types.Signer{
+		Account:  account,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: common.LeftPadBytes([]byte{1}, 32),
+		PubkeyG2: common.LeftPadBytes([]byte{2}, 64),
+	})
+	for i := 0; i < 4; i += 1 {
+		epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+		suite.Require().NoError(err)
+		suite.Keeper.SetRegistration(suite.Ctx, epoch+1, account, common.LeftPadBytes([]byte{1}, 32))
+		suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks) * int64(epoch+1))
+		suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+		suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+		suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+	}
+	// epoch 3 and 4 are kept
+	epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(4, epoch)
+	suite.Assert().EqualValues(3, suite.Keeper.GetEarliestEpoch(suite.Ctx))
+	for e := uint64(0); e <= epoch; e += 1 {
+		_, quorumErr := suite.Keeper.GetEpochQuorum(suite.Ctx, e, 0)
+		_, found, err := suite.Keeper.GetRegistration(suite.Ctx, e, account)
+		suite.Require().NoError(err)
+		if e < 3 {
+			suite.Assert().ErrorIs(quorumErr, types.ErrQuorumNotFound)
+			suite.Assert().False(found)
+		} else {
+			suite.Assert().NoError(quorumErr)
+			suite.Assert().True(found)
+		}
+	}
+}
+
+func (suite *AbciTestSuite) TestBeginBlock_Vrf() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		TokensPerVote:     10,
+		MaxVotesPerSigner: 200,
+		MaxQuorums:        10,
+		EpochBlocks:       5760,
+		EncodedSlices:     4,
+		QuorumSelection:   types.QUORUM_SELECTION_VRF,
+	})
+	params := suite.Keeper.GetParams(suite.Ctx)
+	epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	ballots := make([]keeper.Ballot, 0)
+	for i := 1; i <= 3; i += 1 {
+		account := fmt.Sprintf("%040x", i)
+		suite.AddDelegation(account, account, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote*uint64(i))))
+		suite.Keeper.SetSigner(suite.Ctx, types.Signer{
+			Account:  account,
+			Socket:   "0.0.0.0:1234",
+			PubkeyG1: common.LeftPadBytes([]byte{1}, 32),
+			PubkeyG2: common.LeftPadBytes([]byte{2}, 64),
+		})
+		suite.Keeper.SetRegistration(suite.Ctx, epoch+1, account, common.LeftPadBytes([]byte{byte(i)}, 32))
+		suite.Require().NoError(suite.Keeper.SetVrfOutput(suite.Ctx, epoch+1, account, common.LeftPadBytes([]byte{byte(i)}, 32)))
+		for j := 0; j < i; j += 1 {
+			ballots = append(ballots, keeper.NewBallot(account, nil))
+		}
+	}
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks) * int64(epoch+1))
+	suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+
+	// the randomness chains the previous randomness and the VRF outputs in the order of accounts
+	toHash := sdk.Uint64ToBigEndian(epoch + 1)
+	for i := 1; i <= 3; i += 1 {
+		toHash = append(toHash, common.LeftPadBytes([]byte{byte(i)}, 20)...)
+		toHash = append(toHash, common.LeftPadBytes([]byte{byte(i)}, 32)...)
+	}
+	randomness := crypto.Keccak256(suite.Keeper.GetEpochRandomness(suite.Ctx, epoch), toHash)
+	suite.Assert().Equal(randomness, suite.Keeper.GetEpochRandomness(suite.Ctx, epoch+1))
+
+	// quorums are filled with the shuffled ballots
+	keeper.ShuffleBallots(ballots, randomness)
+	cnt, err := suite.Keeper.GetQuorumCount(suite.Ctx, epoch+1)
+	suite.Require().NoError(err)
+	suite.Require().EqualValues(2, cnt)
+	quorum, err := suite.Keeper.GetEpochQuorum(suite.Ctx, epoch+1, 0)
+	suite.Require().NoError(err)
+	for i, signer := range quorum.Signers {
+		suite.Assert().Equal(ballots[i].Account(), signer)
+	}
+}
+
+func (suite *AbciTestSuite) TestBeginBlock_VrfWithholding() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		TokensPerVote:     10,
+		MaxVotesPerSigner: 200,
+		MaxQuorums:        10,
+		EpochBlocks:       5760,
+		EncodedSlices:     4,
+		JailEpochs:        2,
+		QuorumSelection:   types.QUORUM_SELECTION_VRF,
+	})
+	params := suite.Keeper.GetParams(suite.Ctx)
+	epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	accounts := make([]string, 0)
+	for i := 1; i <= 4; i += 1 {
+		account := fmt.Sprintf("%040x", i)
+		accounts = append(accounts, account)
+		suite.AddDelegation(account, account, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+		suite.Keeper.SetSigner(suite.Ctx, types.Signer{
+			Account:  account,
+			Socket:   "0.0.0.0:1234",
+			PubkeyG1: common.LeftPadBytes([]byte{1}, 32),
+			PubkeyG2: common.LeftPadBytes([]byte{2}, 64),
+		})
+		suite.Require().NoError(suite.Keeper.SetSignerVrfKey(suite.Ctx, types.SignerVrfKey{
+			Account: account,
+			Pubkey:  common.LeftPadBytes([]byte{byte(i)}, 32),
+		}))
+		suite.Require().NoError(suite.Keeper.SetVrfOutput(suite.Ctx, epoch, account, common.LeftPadBytes([]byte{byte(i)}, 32)))
+	}
+	// the first signer registers, the second withholds its output, the third deregistered and the fourth is jailed
+	suite.Keeper.SetRegistration(suite.Ctx, epoch+1, accounts[0], common.LeftPadBytes([]byte{1}, 32))
+	suite.Require().NoError(suite.Keeper.SetVrfOutput(suite.Ctx, epoch+1, accounts[0], common.LeftPadBytes([]byte{1}, 32)))
+	suite.Require().NoError(suite.Keeper.SetDeregistration(suite.Ctx, accounts[2], epoch))
+	suite.Require().NoError(suite.Keeper.JailSigner(suite.Ctx, accounts[3], epoch+1, false, types.AttributeValueDowntime))
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks) * int64(epoch+1))
+	suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+	expected := map[string]uint64{accounts[1]: epoch + 1 + params.JailEpochs, accounts[3]: epoch + 1}
+	for _, account := range accounts {
+		jail, found, err := suite.Keeper.GetJail(suite.Ctx, account)
+		suite.Require().NoError(err)
+		untilEpoch, jailed := expected[account]
+		suite.Require().Equal(jailed, found, account)
+		if jailed {
+			suite.Assert().EqualValues(untilEpoch, jail.UntilEpoch, account)
+		}
+	}
+}
+
 func TestAbciSuite(t *testing.T) {
 	suite.Run(t, new(AbciTestSuite))
 }
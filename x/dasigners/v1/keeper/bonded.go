@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// The bonded tokens of signers are indexed per delegator, so ballot counting does not iterate over delegations.
+// The index is built when a signer registers and kept in sync by the staking hooks:
+//   - DelegatorBonded: delegator -> total bonded tokens
+//   - DelegationBonded: delegator | validator -> bonded tokens of the delegation
+//   - ValidatorBonded: validator | delegator -> nil, used to update the indexed delegations on validator slashing
+
+// GetDelegatorBonded returns the bonded tokens of the delegator, read from the index if the delegator is indexed.
+func (k Keeper) GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) math.Int {
+	if bonded, found := k.getIndexedBonded(ctx, delegator); found {
+		return bonded.RoundInt()
+	}
+	return k.computeDelegatorBonded(ctx, delegator).RoundInt()
+}
+
+// IsBondedIndexed returns whether the bonded tokens of the delegator are indexed.
+func (k Keeper) IsBondedIndexed(ctx sdk.Context, delegator sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetDelegatorBondedKey(delegator))
+}
+
+// IndexDelegatorBonded builds the bonded index of the delegator from all its delegations.
+func (k Keeper) IndexDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) {
+	k.DeleteBondedIndex(ctx, delegator)
+	total := sdk.ZeroDec()
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, delegator, func(delegation stakingtypes.Delegation) bool {
+		validatorAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			panic(err) // shouldn't happen
+		}
+		validator, found := k.stakingKeeper.GetValidator(ctx, validatorAddr)
+		if found {
+			tokens := validator.TokensFromSharesTruncated(delegation.Shares)
+			k.setDelegationBonded(ctx, delegator, validatorAddr, tokens)
+			total = total.Add(tokens)
+		}
+		return false
+	})
+	k.setIndexedBonded(ctx, delegator, total)
+}
+
+// DeleteBondedIndex removes the bonded index of the delegator.
+func (k Keeper) DeleteBondedIndex(ctx sdk.Context, delegator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	delegationStore := prefix.NewStore(store, types.GetDelegationBondedKeyPrefix(delegator))
+	iterator := delegationStore.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		// the key is the length prefixed validator address
+		validator := sdk.ValAddress(key[1:])
+		delegationStore.Delete(key)
+		store.Delete(types.GetValidatorBondedKey(validator, delegator))
+	}
+	store.Delete(types.GetDelegatorBondedKey(delegator))
+}
+
+// IndexSignersBonded builds the bonded index of all signers.
+func (k Keeper) IndexSignersBonded(ctx sdk.Context) error {
+	var err error
+	k.IterateSigners(ctx, func(_ int64, signer types.Signer) (stop bool) {
+		var accAddr sdk.AccAddress
+		accAddr, err = sdk.AccAddressFromHexUnsafe(signer.Account)
+		if err != nil {
+			return true
+		}
+		k.IndexDelegatorBonded(ctx, accAddr)
+		return false
+	})
+	return err
+}
+
+// updateDelegationBonded syncs the indexed tokens of a delegation with the staking store.
+func (k Keeper) updateDelegationBonded(ctx sdk.Context, delegator sdk.AccAddress, validatorAddr sdk.ValAddress) {
+	total, found := k.getIndexedBonded(ctx, delegator)
+	if !found {
+		return
+	}
+	tokens := sdk.ZeroDec()
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, delegator, validatorAddr)
+	if found {
+		if validator, found := k.stakingKeeper.GetValidator(ctx, validatorAddr); found {
+			tokens = validator.TokensFromSharesTruncated(delegation.Shares)
+		}
+	}
+	total = total.Sub(k.getDelegationBonded(ctx, delegator, validatorAddr)).Add(tokens)
+	k.setDelegationBonded(ctx, delegator, validatorAddr, tokens)
+	k.setIndexedBonded(ctx, delegator, total)
+}
+
+// removeDelegationBonded removes a delegation from the index.
+func (k Keeper) removeDelegationBonded(ctx sdk.Context, delegator sdk.AccAddress, validatorAddr sdk.ValAddress) {
+	total, found := k.getIndexedBonded(ctx, delegator)
+	if !found {
+		return
+	}
+	total = total.Sub(k.getDelegationBonded(ctx, delegator, validatorAddr))
+	k.setDelegationBonded(ctx, delegator, validatorAddr, sdk.ZeroDec())
+	k.setIndexedBonded(ctx, delegator, total)
+}
+
+// slashValidatorBonded reduces the indexed tokens of the delegations to the validator by the slashed fraction.
+func (k Keeper) slashValidatorBonded(ctx sdk.Context, validatorAddr sdk.ValAddress, fraction sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetValidatorBondedKeyPrefix(validatorAddr))
+	iterator := store.Iterator(nil, nil)
+	delegators := make([]sdk.AccAddress, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		delegators = append(delegators, sdk.AccAddress(iterator.Key()[1:]))
+	}
+	iterator.Close()
+	for _, delegator := range delegators {
+		total, found := k.getIndexedBonded(ctx, delegator)
+		if !found {
+			continue
+		}
+		tokens := k.getDelegationBonded(ctx, delegator, validatorAddr)
+		slashed := tokens.Mul(fraction)
+		k.setDelegationBonded(ctx, delegator, validatorAddr, tokens.Sub(slashed))
+		k.setIndexedBonded(ctx, delegator, total.Sub(slashed))
+	}
+}
+
+func (k Keeper) computeDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) sdk.Dec {
+	bonded := sdk.ZeroDec()
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, delegator, func(delegation stakingtypes.Delegation) bool {
+		validatorAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			panic(err) // shouldn't happen
+		}
+		validator, found := k.stakingKeeper.GetValidator(ctx, validatorAddr)
+		if found {
+			bonded = bonded.Add(validator.TokensFromSharesTruncated(delegation.Shares))
+		}
+		return false
+	})
+	return bonded
+}
+
+func (k Keeper) getIndexedBonded(ctx sdk.Context, delegator sdk.AccAddress) (sdk.Dec, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDelegatorBondedKey(delegator))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+	var bonded sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &bonded)
+	return bonded.Dec, true
+}
+
+func (k Keeper) setIndexedBonded(ctx sdk.Context, delegator sdk.AccAddress, bonded sdk.Dec) {
+	if bonded.IsNegative() {
+		bonded = sdk.ZeroDec()
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetDelegatorBondedKey(delegator), k.cdc.MustMarshal(&sdk.DecProto{Dec: bonded}))
+}
+
+func (k Keeper) getDelegationBonded(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDelegationBondedKey(delegator, validator))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	var tokens sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &tokens)
+	return tokens.Dec
+}
+
+func (k Keeper) setDelegationBonded(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, tokens sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if !tokens.IsPositive() {
+		store.Delete(types.GetDelegationBondedKey(delegator, validator))
+		store.Delete(types.GetValidatorBondedKey(validator, delegator))
+		return
+	}
+	store.Set(types.GetDelegationBondedKey(delegator, validator), k.cdc.MustMarshal(&sdk.DecProto{Dec: tokens}))
+	store.Set(types.GetValidatorBondedKey(validator, delegator), []byte{})
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/testutil"
+)
+
+type BondedTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *BondedTestSuite) TestGetDelegatorBonded_ManyDelegations() {
+	account := "0000000000000000000000000000000000000001"
+	accAddr, err := sdk.AccAddressFromHexUnsafe(account)
+	suite.Require().NoError(err)
+	amount := keeper.BondedConversionRate
+	// delegations made before indexing are counted when the index is built
+	for i := 0; i < 5; i += 1 {
+		suite.AddDelegation(account, fmt.Sprintf("%040x", i+100), amount)
+	}
+	suite.Assert().False(suite.Keeper.IsBondedIndexed(suite.Ctx, accAddr))
+	suite.Keeper.IndexDelegatorBonded(suite.Ctx, accAddr)
+	suite.Assert().True(suite.Keeper.IsBondedIndexed(suite.Ctx, accAddr))
+	// delegations made after indexing are tracked by the hooks
+	for i := 5; i < 20; i += 1 {
+		suite.AddDelegation(account, fmt.Sprintf("%040x", i+100), amount)
+	}
+	suite.Assert().Equal(amount.MulRaw(20), suite.Keeper.GetDelegatorBonded(suite.Ctx, accAddr))
+
+	suite.Keeper.DeleteBondedIndex(suite.Ctx, accAddr)
+	suite.Assert().False(suite.Keeper.IsBondedIndexed(suite.Ctx, accAddr))
+	suite.Assert().Equal(amount.MulRaw(20), suite.Keeper.GetDelegatorBonded(suite.Ctx, accAddr))
+}
+
+func (suite *BondedTestSuite) TestHooks() {
+	account := "0000000000000000000000000000000000000001"
+	accAddr, err := sdk.AccAddressFromHexUnsafe(account)
+	suite.Require().NoError(err)
+	valA, err := sdk.ValAddressFromHex("0000000000000000000000000000000000000100")
+	suite.Require().NoError(err)
+	valB, err := sdk.ValAddressFromHex("0000000000000000000000000000000000000101")
+	suite.Require().NoError(err)
+	amount := keeper.BondedConversionRate
+	suite.Keeper.IndexDelegatorBonded(suite.Ctx, accAddr)
+	suite.AddDelegation(account, "0000000000000000000000000000000000000100", amount)
+	suite.AddDelegation(account, "0000000000000000000000000000000000000101", amount.MulRaw(3))
+	suite.Assert().Equal(amount.MulRaw(4), suite.Keeper.GetDelegatorBonded(suite.Ctx, accAddr))
+
+	// slashing a validator reduces the delegations to it
+	hooks := suite.Keeper.Hooks()
+	suite.Require().NoError(hooks.BeforeValidatorSlashed(suite.Ctx, valB, sdk.NewDecWithPrec(5, 1)))
+	suite.Assert().Equal(amount.MulRaw(4).Sub(amount.MulRaw(3).QuoRaw(2)), suite.Keeper.GetDelegatorBonded(suite.Ctx, accAddr))
+
+	// removing a delegation drops it from the total
+	suite.Require().NoError(hooks.BeforeDelegationRemoved(suite.Ctx, accAddr, valA))
+	suite.StakingKeeper.RemoveDelegation(suite.Ctx, suite.mustGetDelegation(accAddr, valA))
+	suite.Assert().Equal(amount.MulRaw(3).QuoRaw(2), suite.Keeper.GetDelegatorBonded(suite.Ctx, accAddr))
+
+	// a modified delegation is synced with the staking store
+	suite.Require().NoError(hooks.AfterDelegationModified(suite.Ctx, accAddr, valB))
+	suite.Assert().Equal(amount.MulRaw(3), suite.Keeper.GetDelegatorBonded(suite.Ctx, accAddr))
+
+	// delegators out of the index are ignored
+	other, err := sdk.AccAddressFromHexUnsafe("0000000000000000000000000000000000000002")
+	suite.Require().NoError(err)
+	suite.Require().NoError(hooks.AfterDelegationModified(suite.Ctx, other, valB))
+	suite.Assert().False(suite.Keeper.IsBondedIndexed(suite.Ctx, other))
+}
+
+func (suite *BondedTestSuite) mustGetDelegation(delegator sdk.AccAddress, validator sdk.ValAddress) stakingtypes.Delegation {
+	delegation, found := suite.StakingKeeper.GetDelegation(suite.Ctx, delegator, validator)
+	suite.Require().True(found)
+	return delegation
+}
+
+func TestBondedSuite(t *testing.T) {
+	suite.Run(t, new(BondedTestSuite))
+}
@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 
 	"github.com/0glabs/0g-chain/crypto/bn254util"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
-	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -94,31 +97,9 @@ func (k Keeper) AggregatePubkeyG1(c context.Context, request *types.QueryAggrega
 	if err != nil {
 		return nil, err
 	}
-	if (len(quorum.Signers)+7)/8 != len(request.QuorumBitmap) {
-		return nil, types.ErrQuorumBitmapLengthMismatch
-	}
-	aggPubkeyG1 := new(bn254.G1Affine)
-	hit := 0
-	added := make(map[string]struct{})
-	for i, signer := range quorum.Signers {
-		if _, ok := added[signer]; ok {
-			hit += 1
-			continue
-		}
-		b := request.QuorumBitmap[i/8] & (1 << (i % 8))
-		if b == 0 {
-			continue
-		}
-		hit += 1
-		added[signer] = struct{}{}
-		signer, found, err := k.GetSigner(ctx, signer)
-		if err != nil {
-			return nil, err
-		}
-		if !found {
-			return nil, types.ErrSignerNotFound
-		}
-		aggPubkeyG1.Add(aggPubkeyG1, bn254util.DeserializeG1(signer.PubkeyG1))
+	aggPubkeyG1, _, hit, err := k.AggregatePubkeyG1OfBitmap(ctx, request.EpochNumber, quorum, request.QuorumBitmap)
+	if err != nil {
+		return nil, err
 	}
 	return &types.QueryAggregatePubkeyG1Response{
 		AggregatePubkeyG1: bn254util.SerializeG1(aggPubkeyG1),
@@ -126,3 +107,140 @@ func (k Keeper) AggregatePubkeyG1(c context.Context, request *types.QueryAggrega
 		Hit:               uint64(hit),
 	}, nil
 }
+
+func (k Keeper) Signers(
+	c context.Context,
+	request *types.QuerySignersRequest,
+) (*types.QuerySignersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerKeyPrefix)
+	signers := make([]*types.Signer, 0)
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var signer types.Signer
+		if err := k.cdc.Unmarshal(value, &signer); err != nil {
+			return err
+		}
+		signers = append(signers, &signer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySignersResponse{Signers: signers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) EpochRegistrations(
+	c context.Context,
+	request *types.QueryEpochRegistrationsRequest,
+) (*types.QueryEpochRegistrationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochRegistrationKeyPrefix(request.EpochNumber))
+	registrations := make([]*types.Registration, 0)
+	pageRes, err := query.Paginate(store, request.Pagination, func(key []byte, value []byte) error {
+		registrations = append(registrations, &types.Registration{
+			Account:   hex.EncodeToString(key),
+			Epoch:     request.EpochNumber,
+			Signature: value,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEpochRegistrationsResponse{Registrations: registrations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SignerEpochs(
+	c context.Context,
+	request *types.QuerySignerEpochsRequest,
+) (*types.QuerySignerEpochsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := types.ValidateHexAddress(request.Account); err != nil {
+		return nil, err
+	}
+	account, err := types.GetRegistrationKey(request.Account)
+	if err != nil {
+		return nil, err
+	}
+	// registrations are keyed by epoch and account
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegistrationKeyPrefix)
+	epochs := make([]uint64, 0)
+	pageRes, err := query.FilteredPaginate(store, request.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if len(key) <= 8 || !bytes.Equal(key[8:], account) {
+			return false, nil
+		}
+		if accumulate {
+			epochs = append(epochs, sdk.BigEndianToUint64(key[:8]))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySignerEpochsResponse{Epochs: epochs, Pagination: pageRes}, nil
+}
+
+func (k Keeper) EpochRandomness(
+	c context.Context,
+	request *types.QueryEpochRandomnessRequest,
+) (*types.QueryEpochRandomnessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryEpochRandomnessResponse{Randomness: k.GetEpochRandomness(ctx, request.EpochNumber)}, nil
+}
+
+func (k Keeper) VrfKey(
+	c context.Context,
+	request *types.QueryVrfKeyRequest,
+) (*types.QueryVrfKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	vrfKey, found, err := k.GetSignerVrfKey(ctx, request.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrVrfKeyNotFound
+	}
+	return &types.QueryVrfKeyResponse{VrfKey: &vrfKey}, nil
+}
+
+func (k Keeper) VerifyQuorumSignature(
+	c context.Context,
+	request *types.QueryVerifyQuorumSignatureRequest,
+) (*types.QueryVerifyQuorumSignatureResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if len(request.MessageHash) != 32 {
+		return nil, types.ErrInvalidMessageHash
+	}
+	valid, total, hit, err := k.VerifyQuorumSignatureOfBitmap(
+		ctx,
+		request.EpochNumber,
+		request.QuorumId,
+		request.QuorumBitmap,
+		[32]byte(request.MessageHash),
+		request.AggregateSignature,
+		request.AggregatePubkeyG2,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryVerifyQuorumSignatureResponse{
+		Valid: valid,
+		Total: total,
+		Hit:   hit,
+	}, nil
+}
+
+func (k Keeper) SignerOperator(
+	c context.Context,
+	request *types.QuerySignerOperatorRequest,
+) (*types.QuerySignerOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := types.ValidateHexAddress(request.Account); err != nil {
+		return nil, err
+	}
+	operator, _, err := k.GetSignerOperator(ctx, request.Account)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySignerOperatorResponse{Operator: operator}, nil
+}
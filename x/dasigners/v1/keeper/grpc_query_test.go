@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/testutil"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+)
+
+type GrpcQueryTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *GrpcQueryTestSuite) setSigners(n int) []string {
+	accounts := make([]string, n)
+	for i := 0; i < n; i += 1 {
+		accounts[i] = fmt.Sprintf("%040x", i+1)
+		suite.Require().NoError(suite.Keeper.SetSigner(suite.Ctx, types.Signer{
+			Account:  accounts[i],
+			Socket:   "0.0.0.0:1234",
+			PubkeyG1: common.LeftPadBytes([]byte{1}, 64),
+			PubkeyG2: common.LeftPadBytes([]byte{2}, 128),
+		}))
+	}
+	return accounts
+}
+
+func (suite *GrpcQueryTestSuite) TestSigners() {
+	accounts := suite.setSigners(5)
+
+	res, err := suite.QueryClient.Signers(suite.Ctx, &types.QuerySignersRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Signers, 2)
+	suite.Assert().EqualValues(5, res.Pagination.Total)
+	suite.Assert().Equal(accounts[0], res.Signers[0].Account)
+	suite.Assert().Equal(accounts[1], res.Signers[1].Account)
+
+	res, err = suite.QueryClient.Signers(suite.Ctx, &types.QuerySignersRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Signers, 3)
+	suite.Assert().Equal(accounts[4], res.Signers[2].Account)
+	suite.Assert().Nil(res.Pagination.NextKey)
+}
+
+func (suite *GrpcQueryTestSuite) TestEpochRegistrations() {
+	accounts := suite.setSigners(3)
+	for i, account := range accounts {
+		suite.Require().NoError(suite.Keeper.SetRegistration(suite.Ctx, 2, account, []byte{byte(i)}))
+	}
+	suite.Require().NoError(suite.Keeper.SetRegistration(suite.Ctx, 3, accounts[0], []byte{9}))
+
+	res, err := suite.QueryClient.EpochRegistrations(suite.Ctx, &types.QueryEpochRegistrationsRequest{
+		EpochNumber: 2,
+		Pagination:  &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(3, res.Pagination.Total)
+	suite.Require().Len(res.Registrations, 3)
+	suite.Assert().Equal(types.Registration{Account: accounts[1], Epoch: 2, Signature: []byte{1}}, *res.Registrations[1])
+
+	res, err = suite.QueryClient.EpochRegistrations(suite.Ctx, &types.QueryEpochRegistrationsRequest{EpochNumber: 4})
+	suite.Require().NoError(err)
+	suite.Assert().Len(res.Registrations, 0)
+}
+
+func (suite *GrpcQueryTestSuite) TestSignerEpochs() {
+	accounts := suite.setSigners(2)
+	for _, epoch := range []uint64{1, 2, 5, 7} {
+		suite.Require().NoError(suite.Keeper.SetRegistration(suite.Ctx, epoch, accounts[0], []byte{1}))
+	}
+	suite.Require().NoError(suite.Keeper.SetRegistration(suite.Ctx, 3, accounts[1], []byte{1}))
+
+	res, err := suite.QueryClient.SignerEpochs(suite.Ctx, &types.QuerySignerEpochsRequest{
+		Account:    accounts[0],
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]uint64{1, 2, 5}, res.Epochs)
+	suite.Assert().EqualValues(4, res.Pagination.Total)
+
+	res, err = suite.QueryClient.SignerEpochs(suite.Ctx, &types.QuerySignerEpochsRequest{
+		Account:    accounts[0],
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]uint64{7}, res.Epochs)
+
+	_, err = suite.QueryClient.SignerEpochs(suite.Ctx, &types.QuerySignerEpochsRequest{Account: "0x01"})
+	suite.Require().Error(err)
+}
+
+func (suite *GrpcQueryTestSuite) TestVerifyQuorumSignature() {
+	accounts := []string{fmt.Sprintf("%040x", 1), fmt.Sprintf("%040x", 2)}
+	sks := []*big.Int{big.NewInt(3), big.NewInt(5)}
+	for i, account := range accounts {
+		suite.Require().NoError(suite.Keeper.SetSigner(suite.Ctx, types.Signer{
+			Account:  account,
+			Socket:   "0.0.0.0:1234",
+			PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sks[i])),
+			PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sks[i])),
+		}))
+	}
+	epoch := uint64(1)
+	suite.Keeper.SetEpochQuorums(suite.Ctx, epoch, types.Quorums{
+		Quorums: []*types.Quorum{{Signers: []string{accounts[0], accounts[1], accounts[0], accounts[1], accounts[1]}}},
+	})
+	messageHash := crypto.Keccak256Hash([]byte("blob"))
+	newRequest := func(bitmap byte, signHash common.Hash, sks ...*big.Int) *types.QueryVerifyQuorumSignatureRequest {
+		aggSig := new(bn254.G1Affine)
+		aggSk := big.NewInt(0)
+		for _, sk := range sks {
+			aggSig.Add(aggSig, new(bn254.G1Affine).ScalarMultiplication(bn254util.MapToCurve(signHash), sk))
+			aggSk.Add(aggSk, sk)
+		}
+		return &types.QueryVerifyQuorumSignatureRequest{
+			EpochNumber:        epoch,
+			QuorumId:           0,
+			QuorumBitmap:       []byte{bitmap},
+			MessageHash:        messageHash.Bytes(),
+			AggregateSignature: bn254util.SerializeG1(aggSig),
+			AggregatePubkeyG2:  bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), aggSk)),
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		req    *types.QueryVerifyQuorumSignatureRequest
+		expRes *types.QueryVerifyQuorumSignatureResponse
+		expErr error
+	}{
+		{
+			name:   "all signed",
+			req:    newRequest(0b11, messageHash, sks...),
+			expRes: &types.QueryVerifyQuorumSignatureResponse{Valid: true, Total: 5, Hit: 5},
+		},
+		{
+			name:   "partially signed",
+			req:    newRequest(0b01, messageHash, sks[0]),
+			expRes: &types.QueryVerifyQuorumSignatureResponse{Valid: true, Total: 5, Hit: 2},
+		},
+		{
+			name:   "later slot of a signer marked",
+			req:    newRequest(0b00100, messageHash, sks[0]),
+			expRes: &types.QueryVerifyQuorumSignatureResponse{Valid: true, Total: 5, Hit: 2},
+		},
+		{
+			name:   "last slot of a signer marked",
+			req:    newRequest(0b10000, messageHash, sks[1]),
+			expRes: &types.QueryVerifyQuorumSignatureResponse{Valid: true, Total: 5, Hit: 3},
+		},
+		{
+			name:   "signature over another message",
+			req:    newRequest(0b11, crypto.Keccak256Hash([]byte("other")), sks...),
+			expRes: &types.QueryVerifyQuorumSignatureResponse{Valid: false, Total: 5, Hit: 5},
+		},
+		{
+			name:   "pubkey g2 not matching bitmap",
+			req:    newRequest(0b01, messageHash, sks...),
+			expRes: &types.QueryVerifyQuorumSignatureResponse{Valid: false, Total: 5, Hit: 2},
+		},
+		{
+			name: "invalid message hash",
+			req: func() *types.QueryVerifyQuorumSignatureRequest {
+				req := newRequest(0b11, messageHash, sks...)
+				req.MessageHash = req.MessageHash[1:]
+				return req
+			}(),
+			expErr: types.ErrInvalidMessageHash,
+		},
+		{
+			name: "bitmap length mismatch",
+			req: func() *types.QueryVerifyQuorumSignatureRequest {
+				req := newRequest(0b11, messageHash, sks...)
+				req.QuorumBitmap = []byte{0b11, 0}
+				return req
+			}(),
+			expErr: types.ErrQuorumBitmapLengthMismatch,
+		},
+		{
+			name: "quorum not found",
+			req: func() *types.QueryVerifyQuorumSignatureRequest {
+				req := newRequest(0b11, messageHash, sks...)
+				req.QuorumId = 1
+				return req
+			}(),
+			expErr: types.ErrQuorumIdOutOfBound,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.QueryClient.VerifyQuorumSignature(suite.Ctx, tc.req)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Assert().Equal(tc.expRes, res)
+		})
+	}
+}
+
+func TestGrpcQuerySuite(t *testing.T) {
+	suite.Run(t, new(GrpcQueryTestSuite))
+}
@@ -16,7 +16,6 @@ import (
 
 	"github.com/0glabs/0g-chain/chaincfg"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var BondedConversionRate = math.NewIntFromBigInt(big.NewInt(0).Exp(big.NewInt(10), big.NewInt(chaincfg.GasDenomUnit), nil))
@@ -25,6 +24,7 @@ type Keeper struct {
 	storeKey      storetypes.StoreKey
 	cdc           codec.BinaryCodec
 	stakingKeeper types.StakingKeeper
+	bankKeeper    types.BankKeeper
 	authority     string // the address capable of changing signers params. Should be the gov module account
 }
 
@@ -33,12 +33,14 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	cdc codec.BinaryCodec,
 	stakingKeeper types.StakingKeeper,
+	bankKeeper types.BankKeeper,
 	authority string,
 ) Keeper {
 	return Keeper{
 		storeKey:      storeKey,
 		cdc:           cdc,
 		stakingKeeper: stakingKeeper,
+		bankKeeper:    bankKeeper,
 		authority:     authority,
 	}
 }
@@ -87,6 +89,21 @@ func (k Keeper) SetEpochNumber(ctx sdk.Context, epoch uint64) {
 	store.Set(types.EpochNumberKey, sdk.Uint64ToBigEndian(epoch))
 }
 
+// GetEarliestEpoch returns the first epoch whose quorums are still kept
+func (k Keeper) GetEarliestEpoch(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EarliestEpochKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetEarliestEpoch(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EarliestEpochKey, sdk.Uint64ToBigEndian(epoch))
+}
+
 func (k Keeper) GetQuorumCount(ctx sdk.Context, epoch uint64) (uint64, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QuorumCountKeyPrefix)
 	bz := store.Get(types.GetQuorumCountKey(epoch))
@@ -130,6 +147,7 @@ func (k Keeper) SetSigner(ctx sdk.Context, signer types.Signer) error {
 			types.EventTypeUpdateSigner,
 			sdk.NewAttribute(types.AttributeKeySigner, signer.Account),
 			sdk.NewAttribute(types.AttributeKeySocket, signer.Socket),
+			sdk.NewAttribute(types.AttributeKeyEndpoints, signer.EndpointURIs()),
 			sdk.NewAttribute(types.AttributeKeyPublicKeyG1, hex.EncodeToString(signer.PubkeyG1)),
 			sdk.NewAttribute(types.AttributeKeyPublicKeyG2, hex.EncodeToString(signer.PubkeyG2)),
 		),
@@ -159,6 +177,248 @@ func (k Keeper) IterateSigners(ctx sdk.Context, fn func(index int64, signer type
 	}
 }
 
+// GetSignerAtEpoch returns the signer with the keys that were effective in the given epoch
+func (k Keeper) GetSignerAtEpoch(ctx sdk.Context, account string, epoch uint64) (types.Signer, bool, error) {
+	signer, found, err := k.GetSigner(ctx, account)
+	if err != nil || !found {
+		return signer, found, err
+	}
+	// the first retired key whose last effective epoch is not before the given epoch
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerKeyHistoryKeyPrefix)
+	start, err := types.GetSignerKeyHistoryKey(account, epoch)
+	if err != nil {
+		return types.Signer{}, false, err
+	}
+	accountPrefix, err := types.GetSignerKeyHistoryKeyPrefix(account)
+	if err != nil {
+		return types.Signer{}, false, err
+	}
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(accountPrefix))
+	defer iterator.Close()
+	if iterator.Valid() {
+		var history types.SignerKeyHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		signer.PubkeyG1 = history.PubkeyG1
+		signer.PubkeyG2 = history.PubkeyG2
+	}
+	return signer, true, nil
+}
+
+func (k Keeper) GetSignerKeyHistory(ctx sdk.Context, account string, epoch uint64) (types.SignerKeyHistory, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerKeyHistoryKeyPrefix)
+	key, err := types.GetSignerKeyHistoryKey(account, epoch)
+	if err != nil {
+		return types.SignerKeyHistory{}, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return types.SignerKeyHistory{}, false, nil
+	}
+	var history types.SignerKeyHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	return history, true, nil
+}
+
+func (k Keeper) SetSignerKeyHistory(ctx sdk.Context, history types.SignerKeyHistory) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerKeyHistoryKeyPrefix)
+	key, err := types.GetSignerKeyHistoryKey(history.Account, history.Epoch)
+	if err != nil {
+		return err
+	}
+	store.Set(key, k.cdc.MustMarshal(&history))
+	return nil
+}
+
+// iterate through the signer key histories and perform the provided function
+func (k Keeper) IterateSignerKeyHistories(ctx sdk.Context, fn func(history types.SignerKeyHistory) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SignerKeyHistoryKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.SignerKeyHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		if fn(history) {
+			break
+		}
+	}
+}
+
+// GetDeregistration returns the last epoch in which a deregistered signer can be selected
+func (k Keeper) GetDeregistration(ctx sdk.Context, account string) (uint64, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeregistrationKeyPrefix)
+	key, err := types.GetDeregistrationKey(account)
+	if err != nil {
+		return 0, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return 0, false, nil
+	}
+	return sdk.BigEndianToUint64(bz), true, nil
+}
+
+func (k Keeper) SetDeregistration(ctx sdk.Context, account string, epoch uint64) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeregistrationKeyPrefix)
+	key, err := types.GetDeregistrationKey(account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(epoch))
+	return nil
+}
+
+// iterate through the deregistered signers and perform the provided function
+func (k Keeper) IterateDeregistrations(ctx sdk.Context, fn func(account string, epoch uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.DeregistrationKeyPrefix
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(hex.EncodeToString((iterator.Key())[len(prefix):]), sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetJail(ctx sdk.Context, account string) (types.SignerJail, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailKeyPrefix)
+	key, err := types.GetJailKey(account)
+	if err != nil {
+		return types.SignerJail{}, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return types.SignerJail{}, false, nil
+	}
+	var jail types.SignerJail
+	k.cdc.MustUnmarshal(bz, &jail)
+	return jail, true, nil
+}
+
+func (k Keeper) SetJail(ctx sdk.Context, jail types.SignerJail) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailKeyPrefix)
+	key, err := types.GetJailKey(jail.Account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, k.cdc.MustMarshal(&jail))
+	return nil
+}
+
+// IsJailed returns whether the signer is excluded from ballots of the given epoch
+func (k Keeper) IsJailed(ctx sdk.Context, account string, epoch uint64) (bool, error) {
+	jail, found, err := k.GetJail(ctx, account)
+	if err != nil || !found {
+		return false, err
+	}
+	return jail.Tombstoned || epoch <= jail.UntilEpoch, nil
+}
+
+// iterate through the jailed signers and perform the provided function
+func (k Keeper) IterateJails(ctx sdk.Context, fn func(jail types.SignerJail) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.JailKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var jail types.SignerJail
+		k.cdc.MustUnmarshal(iterator.Value(), &jail)
+		if fn(jail) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetLiveness(ctx sdk.Context, epoch uint64, account string) (types.SignerLiveness, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochLivenessKeyPrefix(epoch))
+	key, err := types.GetLivenessKey(account)
+	if err != nil {
+		return types.SignerLiveness{}, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return types.SignerLiveness{Account: account, Epoch: epoch}, nil
+	}
+	var liveness types.SignerLiveness
+	k.cdc.MustUnmarshal(bz, &liveness)
+	return liveness, nil
+}
+
+func (k Keeper) SetLiveness(ctx sdk.Context, liveness types.SignerLiveness) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochLivenessKeyPrefix(liveness.Epoch))
+	key, err := types.GetLivenessKey(liveness.Account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, k.cdc.MustMarshal(&liveness))
+	return nil
+}
+
+// iterate through the liveness records of the epoch and perform the provided function
+func (k Keeper) IterateLiveness(ctx sdk.Context, epoch uint64, fn func(liveness types.SignerLiveness) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetEpochLivenessKeyPrefix(epoch))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var liveness types.SignerLiveness
+		k.cdc.MustUnmarshal(iterator.Value(), &liveness)
+		if fn(liveness) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetAttestation(ctx sdk.Context, epoch uint64, quorumId uint64, blobId []byte) (types.Attestation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochAttestationKeyPrefix(epoch))
+	bz := store.Get(types.GetAttestationKey(quorumId, blobId))
+	if bz == nil {
+		return types.Attestation{}, false
+	}
+	var attestation types.Attestation
+	k.cdc.MustUnmarshal(bz, &attestation)
+	return attestation, true
+}
+
+func (k Keeper) SetAttestation(ctx sdk.Context, attestation types.Attestation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochAttestationKeyPrefix(attestation.Epoch))
+	store.Set(types.GetAttestationKey(attestation.QuorumId, attestation.BlobId), k.cdc.MustMarshal(&attestation))
+}
+
+// iterate through the attestations of the epoch and perform the provided function
+func (k Keeper) IterateAttestations(ctx sdk.Context, epoch uint64, fn func(attestation types.Attestation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetEpochAttestationKeyPrefix(epoch))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.Attestation
+		k.cdc.MustUnmarshal(iterator.Value(), &attestation)
+		if fn(attestation) {
+			break
+		}
+	}
+}
+
+// DeleteEpochLiveness removes the liveness records and attestations of the epoch
+func (k Keeper) DeleteEpochLiveness(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range [][]byte{types.GetEpochLivenessKeyPrefix(epoch), types.GetEpochAttestationKeyPrefix(epoch)} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		keys := make([][]byte, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
 func (k Keeper) GetEpochQuorum(ctx sdk.Context, epoch uint64, quorumId uint64) (types.Quorum, error) {
 	quorumCount, err := k.GetQuorumCount(ctx, epoch)
 	if err != nil {
@@ -186,6 +446,46 @@ func (k Keeper) SetEpochQuorums(ctx sdk.Context, epoch uint64, quorums types.Quo
 	k.SetQuorumCount(ctx, epoch, uint64(len(quorums.Quorums)))
 }
 
+// DeleteEpoch removes the quorums, quorum count and registrations of the epoch
+func (k Keeper) DeleteEpoch(ctx sdk.Context, epoch uint64) {
+	quorumCount, err := k.GetQuorumCount(ctx, epoch)
+	if err == nil {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochQuorumsKeyPrefix)
+		for quorumId := uint64(0); quorumId < quorumCount; quorumId += 1 {
+			store.Delete(types.GetEpochQuorumKey(epoch, quorumId))
+		}
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.QuorumCountKeyPrefix).Delete(types.GetQuorumCountKey(epoch))
+	}
+	for _, keyPrefix := range [][]byte{types.GetEpochRegistrationKeyPrefix(epoch), types.GetEpochVrfOutputKeyPrefix(epoch)} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+		iterator := store.Iterator(nil, nil)
+		keys := make([][]byte, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.RandomnessKeyPrefix).Delete(types.GetRandomnessKey(epoch))
+}
+
+// PruneEpochs deletes the earliest kept epoch if it is out of the retention window,
+// at most one epoch is pruned per call so that shrinking the window spreads the work over blocks.
+func (k Keeper) PruneEpochs(ctx sdk.Context, params types.Params) {
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return
+	}
+	earliest := k.GetEarliestEpoch(ctx)
+	if earliest >= params.EarliestRetainedEpoch(epochNumber) {
+		return
+	}
+	k.DeleteEpoch(ctx, earliest)
+	k.SetEarliestEpoch(ctx, earliest+1)
+}
+
 func (k Keeper) GetRegistration(ctx sdk.Context, epoch uint64, account string) ([]byte, bool, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochRegistrationKeyPrefix(epoch))
 	key, err := types.GetRegistrationKey(account)
@@ -229,25 +529,14 @@ func (k Keeper) SetRegistration(ctx sdk.Context, epoch uint64, account string, s
 	return nil
 }
 
-func (k Keeper) GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) math.Int {
-	bonded := sdk.ZeroDec()
-
-	cnt := 0
-	k.stakingKeeper.IterateDelegatorDelegations(ctx, delegator, func(delegation stakingtypes.Delegation) bool {
-		validatorAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
-		if err != nil {
-			panic(err) // shouldn't happen
-		}
-		validator, found := k.stakingKeeper.GetValidator(ctx, validatorAddr)
-		if found {
-			shares := delegation.Shares
-			tokens := validator.TokensFromSharesTruncated(shares)
-			bonded = bonded.Add(tokens)
-		}
-		cnt += 1
-		return cnt > 10
-	})
-	return bonded.RoundInt()
+func (k Keeper) DeleteRegistration(ctx sdk.Context, epoch uint64, account string) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochRegistrationKeyPrefix(epoch))
+	key, err := types.GetRegistrationKey(account)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+	return nil
 }
 
 func (k Keeper) CheckDelegations(ctx sdk.Context, account string) error {
@@ -64,12 +64,14 @@ func (suite *KeeperTestSuite) testRegisterSignerSuccess() *types.Signer { // res
 	oldEventNum := len(suite.Ctx.EventManager().Events())
 	_, err := suite.Keeper.RegisterSigner(sdk.WrapSDKContext(suite.Ctx), msg)
 	suite.Assert().NoError(err)
+	suite.Require().NoError(signer.SetEndpoints(signer.Socket, nil))
 	events := suite.Ctx.EventManager().Events()
 	suite.Assert().EqualValues(len(events), oldEventNum+1)
 	suite.Assert().EqualValues(events[len(events)-1], sdk.NewEvent(
 		types.EventTypeUpdateSigner,
 		sdk.NewAttribute(types.AttributeKeySigner, signer.Account),
 		sdk.NewAttribute(types.AttributeKeySocket, signer.Socket),
+		sdk.NewAttribute(types.AttributeKeyEndpoints, signer.EndpointURIs()),
 		sdk.NewAttribute(types.AttributeKeyPublicKeyG1, hex.EncodeToString(signer.PubkeyG1)),
 		sdk.NewAttribute(types.AttributeKeyPublicKeyG2, hex.EncodeToString(signer.PubkeyG2)),
 	))
@@ -90,7 +92,7 @@ func (suite *KeeperTestSuite) testQuerySigner(signer *types.Signer) {
 }
 
 func (suite *KeeperTestSuite) testUpdateSocket(signer *types.Signer) {
-	signer.Socket = "0.0.0.0:2345"
+	suite.Require().NoError(signer.SetEndpoints("0.0.0.0:2345", nil))
 	msg := &types.MsgUpdateSocket{
 		Account: signer2,
 		Socket:  signer.Socket,
@@ -107,6 +109,7 @@ func (suite *KeeperTestSuite) testUpdateSocket(signer *types.Signer) {
 		types.EventTypeUpdateSigner,
 		sdk.NewAttribute(types.AttributeKeySigner, signer.Account),
 		sdk.NewAttribute(types.AttributeKeySocket, signer.Socket),
+		sdk.NewAttribute(types.AttributeKeyEndpoints, signer.EndpointURIs()),
 		sdk.NewAttribute(types.AttributeKeyPublicKeyG1, hex.EncodeToString(signer.PubkeyG1)),
 		sdk.NewAttribute(types.AttributeKeyPublicKeyG2, hex.EncodeToString(signer.PubkeyG2)),
 	))
@@ -143,12 +146,14 @@ func (suite *KeeperTestSuite) secondSigner() *types.Signer {
 	oldEventNum := len(suite.Ctx.EventManager().Events())
 	_, err := suite.Keeper.RegisterSigner(sdk.WrapSDKContext(suite.Ctx), msg)
 	suite.Assert().NoError(err)
+	suite.Require().NoError(signer.SetEndpoints(signer.Socket, nil))
 	events := suite.Ctx.EventManager().Events()
 	suite.Assert().EqualValues(len(events), oldEventNum+1)
 	suite.Assert().EqualValues(events[len(events)-1], sdk.NewEvent(
 		types.EventTypeUpdateSigner,
 		sdk.NewAttribute(types.AttributeKeySigner, signer.Account),
 		sdk.NewAttribute(types.AttributeKeySocket, signer.Socket),
+		sdk.NewAttribute(types.AttributeKeyEndpoints, signer.EndpointURIs()),
 		sdk.NewAttribute(types.AttributeKeyPublicKeyG1, hex.EncodeToString(signer.PubkeyG1)),
 		sdk.NewAttribute(types.AttributeKeyPublicKeyG2, hex.EncodeToString(signer.PubkeyG2)),
 	))
@@ -332,6 +337,31 @@ func (suite *KeeperTestSuite) Test_Keeper() {
 	suite.queryAggregatePubkeyG1(params)
 }
 
+func (suite *KeeperTestSuite) Test_Migrate5to6() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.JailEpochs = 0
+	params.MinAttestations = 0
+	params.MinSignedBps = 0
+	params.AttestationThresholdBps = 0
+	params.SlashFractionEquivocationBps = 0
+	params.EpochRetention = 10
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	suite.Require().NoError(keeper.NewMigrator(suite.Keeper).Migrate5to6(suite.Ctx))
+
+	// the liveness and slashing params are set to the defaults and the other params are kept
+	defaults := types.DefaultGenesisState().Params
+	defaults.EpochRetention = 10
+	defaults.GasParams = params.GasParams
+	defaults.QuorumSelection = params.QuorumSelection
+	defaults.TokensPerVote = params.TokensPerVote
+	defaults.MaxVotesPerSigner = params.MaxVotesPerSigner
+	defaults.MaxQuorums = params.MaxQuorums
+	defaults.EpochBlocks = params.EpochBlocks
+	defaults.EncodedSlices = params.EncodedSlices
+	suite.Require().Equal(defaults, suite.Keeper.GetParams(suite.Ctx))
+}
+
 func TestKeeperSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	v2 "github.com/0glabs/0g-chain/x/dasigners/v1/migrations/v2"
+	v4 "github.com/0glabs/0g-chain/x/dasigners/v1/migrations/v4"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// V3 indexes the bonded tokens of the existing signers.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.IndexSignersBonded(ctx)
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+// V5 sets the default gas params of the precompile.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.GasParams = types.DefaultGasParams()
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+// V6 sets the default liveness and slashing params, which are zero on chains upgraded from version 1.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaults := types.DefaultGenesisState().Params
+	params.JailEpochs = defaults.JailEpochs
+	params.MinAttestations = defaults.MinAttestations
+	params.MinSignedBps = defaults.MinSignedBps
+	params.AttestationThresholdBps = defaults.AttestationThresholdBps
+	params.SlashFractionDowntimeBps = defaults.SlashFractionDowntimeBps
+	params.SlashFractionEquivocationBps = defaults.SlashFractionEquivocationBps
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
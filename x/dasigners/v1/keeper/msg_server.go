@@ -1,11 +1,15 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/0glabs/0g-chain/crypto/bn254util"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+	"github.com/coniks-sys/coniks-go/crypto/vrf"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -49,14 +53,26 @@ func (k Keeper) RegisterSigner(goCtx context.Context, msg *types.MsgRegisterSign
 		return nil, types.ErrInvalidSignature
 	}
 	// save signer
-	if err := k.SetSigner(ctx, *msg.Signer); err != nil {
+	signer := *msg.Signer
+	if err := signer.SetEndpoints(signer.Socket, signer.Endpoints); err != nil {
 		return nil, err
 	}
+	if err := k.SetSigner(ctx, signer); err != nil {
+		return nil, err
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(msg.Signer.Account)
+	if err != nil {
+		return nil, err
+	}
+	k.IndexDelegatorBonded(ctx, accAddr)
 	return &types.MsgRegisterSignerResponse{}, nil
 }
 
 func (k Keeper) UpdateSocket(goCtx context.Context, msg *types.MsgUpdateSocket) (*types.MsgUpdateSocketResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.CheckSignerAuthority(ctx, msg.Account, msg.Operator); err != nil {
+		return nil, err
+	}
 	signer, found, err := k.GetSigner(ctx, msg.Account)
 	if err != nil {
 		return nil, err
@@ -64,7 +80,9 @@ func (k Keeper) UpdateSocket(goCtx context.Context, msg *types.MsgUpdateSocket)
 	if !found {
 		return nil, types.ErrSignerNotFound
 	}
-	signer.Socket = msg.Socket
+	if err := signer.SetEndpoints(msg.Socket, msg.Endpoints); err != nil {
+		return nil, err
+	}
 	if err := k.SetSigner(ctx, signer); err != nil {
 		return nil, err
 	}
@@ -73,6 +91,9 @@ func (k Keeper) UpdateSocket(goCtx context.Context, msg *types.MsgUpdateSocket)
 
 func (k Keeper) RegisterNextEpoch(goCtx context.Context, msg *types.MsgRegisterNextEpoch) (*types.MsgRegisterNextEpochResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.CheckSignerAuthority(ctx, msg.Account, msg.Operator); err != nil {
+		return nil, err
+	}
 	// get signer
 	err := k.CheckDelegations(ctx, msg.Account)
 	if err != nil {
@@ -85,6 +106,13 @@ func (k Keeper) RegisterNextEpoch(goCtx context.Context, msg *types.MsgRegisterN
 	if !found {
 		return nil, types.ErrSignerNotFound
 	}
+	_, deregistered, err := k.GetDeregistration(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if deregistered {
+		return nil, types.ErrSignerDeregistered
+	}
 	// validate signature
 	epochNumber, err := k.GetEpochNumber(ctx)
 	if err != nil {
@@ -94,11 +122,380 @@ func (k Keeper) RegisterNextEpoch(goCtx context.Context, msg *types.MsgRegisterN
 	if err != nil {
 		return nil, err
 	}
+	jailed, err := k.IsJailed(ctx, msg.Account, epochNumber+1)
+	if err != nil {
+		return nil, err
+	}
+	if jailed {
+		return nil, types.ErrSignerJailed
+	}
 	hash := types.EpochRegistrationHash(common.HexToAddress(msg.Account), epochNumber+1, chainID)
 	if !signer.ValidateSignature(hash, bn254util.DeserializeG1(msg.Signature)) {
 		return nil, types.ErrInvalidSignature
 	}
+	// validate the VRF contribution to the randomness of next epoch
+	vrfKey, found, err := k.GetSignerVrfKey(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	hasVrfKey := found && len(vrfKey.PubkeyAt(epochNumber+1)) > 0
+	if len(msg.VrfOutput) > 0 {
+		if !hasVrfKey {
+			return nil, types.ErrVrfKeyNotFound
+		}
+		seed := k.GetVrfSeed(ctx, epochNumber+1, chainID)
+		if !vrf.PublicKey(vrfKey.PubkeyAt(epochNumber+1)).Verify(seed, msg.VrfOutput, msg.VrfProof) {
+			return nil, types.ErrInvalidVrfProof
+		}
+		if err := k.SetVrfOutput(ctx, epochNumber+1, msg.Account, msg.VrfOutput); err != nil {
+			return nil, err
+		}
+	} else if hasVrfKey && k.GetParams(ctx).QuorumSelection == types.QUORUM_SELECTION_VRF {
+		// a signer withholding its output after seeing the others would choose between two randomness values
+		return nil, types.ErrVrfOutputRequired
+	}
 	// save registration
 	k.SetRegistration(ctx, epochNumber+1, msg.Account, msg.Signature)
 	return &types.MsgRegisterNextEpochResponse{}, nil
 }
+
+func (k Keeper) SetVrfKey(goCtx context.Context, msg *types.MsgSetVrfKey) (*types.MsgSetVrfKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.CheckSignerAuthority(ctx, msg.Account, msg.Operator); err != nil {
+		return nil, err
+	}
+	_, found, err := k.GetSigner(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSignerNotFound
+	}
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vrfKey, found, err := k.GetSignerVrfKey(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	// the seed of the registrations for next epoch is already known, so the new key is effective from the epoch after
+	effectiveEpoch := epochNumber + 2
+	if found {
+		vrfKey.PreviousPubkey = vrfKey.PubkeyAt(epochNumber + 1)
+	} else {
+		vrfKey.Account = msg.Account
+	}
+	vrfKey.Pubkey = msg.Pubkey
+	vrfKey.EffectiveEpoch = effectiveEpoch
+	if err := k.SetSignerVrfKey(ctx, vrfKey); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetVrfKeyResponse{}, nil
+}
+
+func (k Keeper) RotateSignerKey(goCtx context.Context, msg *types.MsgRotateSignerKey) (*types.MsgRotateSignerKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, found, err := k.GetSigner(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSignerNotFound
+	}
+	_, deregistered, err := k.GetDeregistration(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if deregistered {
+		return nil, types.ErrSignerDeregistered
+	}
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := etherminttypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	// validate proof of possession of the new key
+	newSigner := types.Signer{
+		Account:   signer.Account,
+		Socket:    signer.Socket,
+		PubkeyG1:  msg.PubkeyG1,
+		PubkeyG2:  msg.PubkeyG2,
+		Endpoints: signer.Endpoints,
+	}
+	hash := types.PubkeyRegistrationHash(common.HexToAddress(msg.Account), chainID)
+	if !newSigner.ValidateSignature(hash, bn254util.DeserializeG1(msg.NewKeySignature)) {
+		return nil, types.ErrInvalidSignature
+	}
+	// validate authorization of the old key
+	rotationHash := types.KeyRotationHash(common.HexToAddress(msg.Account), msg.PubkeyG1, msg.PubkeyG2, epochNumber, chainID)
+	ok, err := bn254util.VerifySig(bn254util.DeserializeG1(msg.OldKeySignature), bn254util.DeserializeG2(signer.PubkeyG2), rotationHash)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, types.ErrInvalidSignature
+	}
+	// the old key stays effective until the end of current epoch,
+	// only the first rotation in an epoch needs to be recorded.
+	_, found, err = k.GetSignerKeyHistory(ctx, msg.Account, epochNumber)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		if err := k.SetSignerKeyHistory(ctx, types.SignerKeyHistory{
+			Account:  signer.Account,
+			Epoch:    epochNumber,
+			PubkeyG1: signer.PubkeyG1,
+			PubkeyG2: signer.PubkeyG2,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	// save signer
+	if err := k.SetSigner(ctx, newSigner); err != nil {
+		return nil, err
+	}
+	return &types.MsgRotateSignerKeyResponse{}, nil
+}
+
+func (k Keeper) DeregisterSigner(goCtx context.Context, msg *types.MsgDeregisterSigner) (*types.MsgDeregisterSignerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, found, err := k.GetSigner(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSignerNotFound
+	}
+	_, deregistered, err := k.GetDeregistration(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if deregistered {
+		return nil, types.ErrSignerDeregistered
+	}
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the signer keeps serving the current epoch and is excluded from the next one
+	if err := k.DeleteRegistration(ctx, epochNumber+1, msg.Account); err != nil {
+		return nil, err
+	}
+	if err := k.SetDeregistration(ctx, msg.Account, epochNumber); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterSigner,
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Account),
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprint(epochNumber)),
+		),
+	)
+	return &types.MsgDeregisterSignerResponse{}, nil
+}
+
+func (k Keeper) SubmitAttestation(goCtx context.Context, msg *types.MsgSubmitAttestation) (*types.MsgSubmitAttestationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// attestations are submitted in current epoch, the members missing from an attestation can add their signatures
+	// until the liveness of its epoch is handled once the next epoch closes
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attestation, found := k.GetAttestation(ctx, msg.Epoch, msg.QuorumId, msg.BlobId)
+	if msg.Epoch != epochNumber && !(found && msg.Epoch+1 == epochNumber) {
+		return nil, types.ErrInvalidAttestationEpoch
+	}
+	if found && !bytes.Equal(attestation.Commitment, msg.Commitment) {
+		return nil, errorsmod.Wrap(types.ErrAttestationExists, "commitment mismatch")
+	}
+	quorum, err := k.GetEpochQuorum(ctx, msg.Epoch, msg.QuorumId)
+	if err != nil {
+		return nil, err
+	}
+	aggPubkeyG1, signed, hit, err := k.AggregatePubkeyG1OfBitmap(ctx, msg.Epoch, quorum, msg.QuorumBitmap)
+	if err != nil {
+		return nil, err
+	}
+	// members which signed the earlier submissions are counted once
+	counted := make(map[string]struct{})
+	if found {
+		counted = markedSigners(quorum, attestation.QuorumBitmap)
+		added := false
+		for member := range signed {
+			if _, ok := counted[member]; !ok {
+				added = true
+				break
+			}
+		}
+		if !added {
+			return nil, types.ErrAttestationExists
+		}
+		for i := range attestation.QuorumBitmap {
+			attestation.QuorumBitmap[i] |= msg.QuorumBitmap[i]
+		}
+	} else {
+		params := k.GetParams(ctx)
+		if hit == 0 || uint64(hit)*types.BasisPoints < uint64(len(quorum.Signers))*params.AttestationThresholdBps {
+			return nil, types.ErrInsufficientAttestation
+		}
+		attestation = types.Attestation{
+			Epoch:        msg.Epoch,
+			QuorumId:     msg.QuorumId,
+			BlobId:       msg.BlobId,
+			Commitment:   msg.Commitment,
+			QuorumBitmap: msg.QuorumBitmap,
+		}
+	}
+	// validate aggregated signature
+	chainID, err := etherminttypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	hash := types.BlobAttestationHash(msg.BlobId, msg.Commitment, msg.Epoch, msg.QuorumId, chainID)
+	ok, err := verifyAggregateSignature(aggPubkeyG1, msg.AggregatePubkeyG2, hash, msg.AggregateSignature)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, types.ErrInvalidSignature
+	}
+	k.SetAttestation(ctx, attestation)
+	// record liveness of quorum members, the attestation is counted on its first submission only
+	visited := make(map[string]struct{})
+	for _, member := range quorum.Signers {
+		if _, ok := visited[member]; ok {
+			continue
+		}
+		visited[member] = struct{}{}
+		_, isSigned := signed[member]
+		_, isCounted := counted[member]
+		if found && (!isSigned || isCounted) {
+			continue
+		}
+		liveness, err := k.GetLiveness(ctx, msg.Epoch, member)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			liveness.Attestations += 1
+		}
+		if isSigned && !isCounted {
+			liveness.Signed += 1
+		}
+		if err := k.SetLiveness(ctx, liveness); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestation,
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprint(msg.Epoch)),
+			sdk.NewAttribute(types.AttributeKeyQuorumId, fmt.Sprint(msg.QuorumId)),
+			sdk.NewAttribute(types.AttributeKeyBlobId, hex.EncodeToString(msg.BlobId)),
+			sdk.NewAttribute(types.AttributeKeySigned, fmt.Sprint(hit)),
+		),
+	)
+	return &types.MsgSubmitAttestationResponse{}, nil
+}
+
+func (k Keeper) SubmitEquivocation(goCtx context.Context, msg *types.MsgSubmitEquivocation) (*types.MsgSubmitEquivocationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if bytes.Equal(msg.CommitmentA, msg.CommitmentB) {
+		return nil, types.ErrInvalidEquivocation
+	}
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Epoch > epochNumber {
+		return nil, types.ErrInvalidEquivocation
+	}
+	jail, found, err := k.GetJail(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if found && jail.Tombstoned {
+		return nil, types.ErrSignerJailed
+	}
+	// the signer must be chosen into the quorum
+	quorum, err := k.GetEpochQuorum(ctx, msg.Epoch, msg.QuorumId)
+	if err != nil {
+		return nil, err
+	}
+	member := false
+	for _, account := range quorum.Signers {
+		if account == msg.Account {
+			member = true
+			break
+		}
+	}
+	if !member {
+		return nil, types.ErrSignerNotInQuorum
+	}
+	signer, found, err := k.GetSignerAtEpoch(ctx, msg.Account, msg.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSignerNotFound
+	}
+	// validate both signatures with the key effective in the epoch
+	chainID, err := etherminttypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	pubkeyG2 := bn254util.DeserializeG2(signer.PubkeyG2)
+	for _, evidence := range []struct {
+		commitment []byte
+		signature  []byte
+	}{{msg.CommitmentA, msg.SignatureA}, {msg.CommitmentB, msg.SignatureB}} {
+		hash := types.BlobAttestationHash(msg.BlobId, evidence.commitment, msg.Epoch, msg.QuorumId, chainID)
+		ok, err := bn254util.VerifySig(bn254util.DeserializeG1(evidence.signature), pubkeyG2, hash)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, types.ErrInvalidEquivocation
+		}
+	}
+	// equivocating signers are excluded permanently
+	params := k.GetParams(ctx)
+	if err := k.JailSigner(ctx, msg.Account, epochNumber+params.JailEpochs, true, types.AttributeValueEquivocation); err != nil {
+		return nil, err
+	}
+	if _, err := k.SlashDelegations(ctx, msg.Account, params.SlashFractionEquivocationBps, types.AttributeValueEquivocation); err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitEquivocationResponse{}, nil
+}
+
+func (k Keeper) SetOperator(goCtx context.Context, msg *types.MsgSetOperator) (*types.MsgSetOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, found, err := k.GetSigner(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSignerNotFound
+	}
+	if err := k.SetSignerOperator(ctx, types.SignerOperator{Account: msg.Account, Operator: msg.Operator}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetOperator,
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Account),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+	)
+	return &types.MsgSetOperatorResponse{}, nil
+}
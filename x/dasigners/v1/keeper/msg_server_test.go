@@ -1,12 +1,21 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"fmt"
+	"math/big"
 	"testing"
 
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/testutil"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+	"github.com/coniks-sys/coniks-go/crypto/vrf"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	etherminttypes "github.com/evmos/ethermint/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -82,6 +91,572 @@ func (suite *MsgServerTestSuite) TestChangeParams() {
 	}
 }
 
+func (suite *MsgServerTestSuite) setSigner(account string, sk *big.Int) types.Signer {
+	signer := types.Signer{
+		Account:  account,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+		PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+	}
+	suite.Require().NoError(suite.Keeper.SetSigner(suite.Ctx, signer))
+	return signer
+}
+
+func (suite *MsgServerTestSuite) newMsgRotateSignerKey(account string, oldSk *big.Int, newSk *big.Int, epoch uint64) *types.MsgRotateSignerKey {
+	chainID, err := etherminttypes.ParseChainID(suite.Ctx.ChainID())
+	suite.Require().NoError(err)
+	pkG1 := bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), newSk))
+	pkG2 := bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), newSk))
+	hash := types.PubkeyRegistrationHash(common.HexToAddress(account), chainID)
+	rotationHash := bn254util.MapToCurve(types.KeyRotationHash(common.HexToAddress(account), pkG1, pkG2, epoch, chainID))
+	return &types.MsgRotateSignerKey{
+		Account:         account,
+		PubkeyG1:        pkG1,
+		PubkeyG2:        pkG2,
+		NewKeySignature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, newSk)),
+		OldKeySignature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(rotationHash, oldSk)),
+	}
+}
+
+func (suite *MsgServerTestSuite) TestRotateSignerKey() {
+	account := "9685c4eb29309820cdc62663cc6cc82f3d42e964"
+	epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *types.MsgRotateSignerKey
+		setup  bool
+		expErr error
+	}{
+		{
+			name:   "signer not found",
+			req:    suite.newMsgRotateSignerKey(account, big.NewInt(1), big.NewInt(2), epoch),
+			setup:  false,
+			expErr: types.ErrSignerNotFound,
+		},
+		{
+			name: "invalid proof of possession",
+			req: func() *types.MsgRotateSignerKey {
+				msg := suite.newMsgRotateSignerKey(account, big.NewInt(1), big.NewInt(2), epoch)
+				msg.NewKeySignature = suite.newMsgRotateSignerKey(account, big.NewInt(1), big.NewInt(3), epoch).NewKeySignature
+				return msg
+			}(),
+			setup:  true,
+			expErr: types.ErrInvalidSignature,
+		},
+		{
+			name:   "invalid old key signature",
+			req:    suite.newMsgRotateSignerKey(account, big.NewInt(3), big.NewInt(2), epoch),
+			setup:  true,
+			expErr: types.ErrInvalidSignature,
+		},
+		{
+			name:   "stale epoch",
+			req:    suite.newMsgRotateSignerKey(account, big.NewInt(1), big.NewInt(2), epoch+1),
+			setup:  true,
+			expErr: types.ErrInvalidSignature,
+		},
+		{
+			name:   "success",
+			req:    suite.newMsgRotateSignerKey(account, big.NewInt(1), big.NewInt(2), epoch),
+			setup:  true,
+			expErr: nil,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			var oldSigner types.Signer
+			if tc.setup {
+				oldSigner = suite.setSigner(account, big.NewInt(1))
+			}
+			_, err := suite.Keeper.RotateSignerKey(sdk.WrapSDKContext(suite.Ctx), tc.req)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			signer, found, err := suite.Keeper.GetSigner(suite.Ctx, account)
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Assert().EqualValues(tc.req.PubkeyG1, signer.PubkeyG1)
+			suite.Assert().EqualValues(tc.req.PubkeyG2, signer.PubkeyG2)
+			// the old keys are still effective in current epoch
+			signer, found, err = suite.Keeper.GetSignerAtEpoch(suite.Ctx, account, epoch)
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Assert().EqualValues(oldSigner, signer)
+			signer, found, err = suite.Keeper.GetSignerAtEpoch(suite.Ctx, account, epoch+1)
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Assert().EqualValues(tc.req.PubkeyG1, signer.PubkeyG1)
+			// rotate again in the same epoch, the first retired keys are kept
+			_, err = suite.Keeper.RotateSignerKey(sdk.WrapSDKContext(suite.Ctx), suite.newMsgRotateSignerKey(account, big.NewInt(2), big.NewInt(3), epoch))
+			suite.Require().NoError(err)
+			signer, _, err = suite.Keeper.GetSignerAtEpoch(suite.Ctx, account, epoch)
+			suite.Require().NoError(err)
+			suite.Assert().EqualValues(oldSigner, signer)
+		})
+	}
+}
+
+func (suite *MsgServerTestSuite) TestDeregisterSigner() {
+	account := "9685c4eb29309820cdc62663cc6cc82f3d42e964"
+	msg := &types.MsgDeregisterSigner{Account: account}
+
+	_, err := suite.Keeper.DeregisterSigner(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrSignerNotFound)
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	suite.AddDelegation(account, account, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	suite.setSigner(account, big.NewInt(1))
+	epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.Keeper.SetRegistration(suite.Ctx, epoch+1, account, make([]byte, bn254util.G1PointSize)))
+
+	oldEventNum := len(suite.Ctx.EventManager().Events())
+	_, err = suite.Keeper.DeregisterSigner(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+	events := suite.Ctx.EventManager().Events()
+	suite.Assert().EqualValues(len(events), oldEventNum+1)
+	suite.Assert().EqualValues(events[len(events)-1], sdk.NewEvent(
+		types.EventTypeDeregisterSigner,
+		sdk.NewAttribute(types.AttributeKeySigner, account),
+		sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprint(epoch)),
+	))
+
+	// pending registration is removed and signer is kept for historical quorums
+	_, found, err := suite.Keeper.GetRegistration(suite.Ctx, epoch+1, account)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+	_, found, err = suite.Keeper.GetSigner(suite.Ctx, account)
+	suite.Require().NoError(err)
+	suite.Assert().True(found)
+	lastEpoch, found, err := suite.Keeper.GetDeregistration(suite.Ctx, account)
+	suite.Require().NoError(err)
+	suite.Assert().True(found)
+	suite.Assert().EqualValues(epoch, lastEpoch)
+
+	_, err = suite.Keeper.DeregisterSigner(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrSignerDeregistered)
+	_, err = suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterNextEpoch{
+		Account:   account,
+		Signature: make([]byte, bn254util.G1PointSize),
+	})
+	suite.Require().ErrorIs(err, types.ErrSignerDeregistered)
+	_, err = suite.Keeper.RotateSignerKey(sdk.WrapSDKContext(suite.Ctx), suite.newMsgRotateSignerKey(account, big.NewInt(1), big.NewInt(2), epoch))
+	suite.Require().ErrorIs(err, types.ErrSignerDeregistered)
+}
+
+func (suite *MsgServerTestSuite) TestRegisterNextEpoch_Vrf() {
+	account := "9685c4eb29309820cdc62663cc6cc82f3d42e964"
+	sk := big.NewInt(1)
+	params := suite.Keeper.GetParams(suite.Ctx)
+	suite.AddDelegation(account, account, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	chainID, err := etherminttypes.ParseChainID(suite.Ctx.ChainID())
+	suite.Require().NoError(err)
+	vrfSk, err := vrf.GenerateKey(nil)
+	suite.Require().NoError(err)
+	vrfPk, _ := vrfSk.Public()
+
+	_, err = suite.Keeper.SetVrfKey(sdk.WrapSDKContext(suite.Ctx), &types.MsgSetVrfKey{Account: account, Pubkey: vrfPk})
+	suite.Require().ErrorIs(err, types.ErrSignerNotFound)
+	suite.setSigner(account, sk)
+	_, err = suite.Keeper.SetVrfKey(sdk.WrapSDKContext(suite.Ctx), &types.MsgSetVrfKey{Account: account, Pubkey: vrfPk})
+	suite.Require().NoError(err)
+	epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	vrfKey, found, err := suite.Keeper.GetSignerVrfKey(suite.Ctx, account)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Assert().EqualValues(epoch+2, vrfKey.EffectiveEpoch)
+
+	newMsg := func(vrfSk vrf.PrivateKey) *types.MsgRegisterNextEpoch {
+		epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+		suite.Require().NoError(err)
+		hash := types.EpochRegistrationHash(common.HexToAddress(account), epoch+1, chainID)
+		output, proof := vrfSk.Prove(suite.Keeper.GetVrfSeed(suite.Ctx, epoch+1, chainID))
+		return &types.MsgRegisterNextEpoch{
+			Account:   account,
+			Signature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, sk)),
+			VrfOutput: output,
+			VrfProof:  proof,
+		}
+	}
+	// the key is not effective for the registrations of next epoch
+	_, err = suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), newMsg(vrfSk))
+	suite.Require().ErrorIs(err, types.ErrVrfKeyNotFound)
+
+	suite.Keeper.SetEpochNumber(suite.Ctx, epoch+1)
+	suite.Keeper.SetEpochRandomness(suite.Ctx, epoch+1, common.LeftPadBytes([]byte{1}, 32))
+	otherSk, err := vrf.GenerateKey(nil)
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), newMsg(otherSk))
+	suite.Require().ErrorIs(err, types.ErrInvalidVrfProof)
+	// the output of an effective key cannot be withheld in VRF mode
+	params.QuorumSelection = types.QUORUM_SELECTION_VRF
+	suite.Keeper.SetParams(suite.Ctx, params)
+	withheld := newMsg(vrfSk)
+	withheld.VrfOutput, withheld.VrfProof = nil, nil
+	_, err = suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), withheld)
+	suite.Require().ErrorIs(err, types.ErrVrfOutputRequired)
+	msg := newMsg(vrfSk)
+	_, err = suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+	outputs := make(map[string][]byte)
+	suite.Keeper.IterateVrfOutputs(suite.Ctx, epoch+2, func(account []byte, output []byte) (stop bool) {
+		outputs[hex.EncodeToString(account)] = output
+		return false
+	})
+	suite.Assert().Equal(map[string][]byte{account: msg.VrfOutput}, outputs)
+
+	// the previous key stays effective for the registrations of next epoch after another update
+	_, err = suite.Keeper.SetVrfKey(sdk.WrapSDKContext(suite.Ctx), &types.MsgSetVrfKey{Account: account, Pubkey: make([]byte, vrf.PublicKeySize)})
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), newMsg(vrfSk))
+	suite.Require().NoError(err)
+}
+
+func (suite *MsgServerTestSuite) signAttestation(sk *big.Int, blobId []byte, commitment []byte, epoch uint64, quorumId uint64) *bn254.G1Affine {
+	chainID, err := etherminttypes.ParseChainID(suite.Ctx.ChainID())
+	suite.Require().NoError(err)
+	hash := bn254util.MapToCurve(types.BlobAttestationHash(blobId, commitment, epoch, quorumId, chainID))
+	return new(bn254.G1Affine).ScalarMultiplication(hash, sk)
+}
+
+func (suite *MsgServerTestSuite) TestSubmitAttestation() {
+	accountA := "9685c4eb29309820cdc62663cc6cc82f3d42e964"
+	accountB := "d4a0ff7b8bb0f2a4e5c5f3b17cbbfb0c32e1f1a7"
+	skA, skB := big.NewInt(1), big.NewInt(2)
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AttestationThresholdBps = 5000
+	suite.Keeper.SetParams(suite.Ctx, params)
+	suite.setSigner(accountA, skA)
+	suite.setSigner(accountB, skB)
+	epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Keeper.SetEpochQuorums(suite.Ctx, epoch, types.Quorums{
+		Quorums: []*types.Quorum{{Signers: []string{accountA, accountB, accountA}}},
+	})
+
+	blobId := common.LeftPadBytes([]byte{1}, 32)
+	commitment := []byte("commitment")
+	newMsg := func(bitmap byte, sks ...*big.Int) *types.MsgSubmitAttestation {
+		aggSig := new(bn254.G1Affine)
+		aggSk := big.NewInt(0)
+		for _, sk := range sks {
+			aggSig.Add(aggSig, suite.signAttestation(sk, blobId, commitment, epoch, 0))
+			aggSk.Add(aggSk, sk)
+		}
+		return &types.MsgSubmitAttestation{
+			Submitter:          accountB,
+			Epoch:              epoch,
+			QuorumId:           0,
+			BlobId:             blobId,
+			Commitment:         commitment,
+			QuorumBitmap:       []byte{bitmap},
+			AggregatePubkeyG2:  bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), aggSk)),
+			AggregateSignature: bn254util.SerializeG1(aggSig),
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		req    *types.MsgSubmitAttestation
+		expErr error
+	}{
+		{
+			name: "past epoch",
+			req: func() *types.MsgSubmitAttestation {
+				msg := newMsg(0b001, skA)
+				msg.Epoch = epoch + 1
+				return msg
+			}(),
+			expErr: types.ErrInvalidAttestationEpoch,
+		},
+		{
+			name: "bitmap length mismatch",
+			req: func() *types.MsgSubmitAttestation {
+				msg := newMsg(0b001, skA)
+				msg.QuorumBitmap = []byte{1, 0}
+				return msg
+			}(),
+			expErr: types.ErrQuorumBitmapLengthMismatch,
+		},
+		{
+			name:   "insufficient signed slots",
+			req:    newMsg(0b010, skB),
+			expErr: types.ErrInsufficientAttestation,
+		},
+		{
+			name: "aggregate pubkey mismatch",
+			req: func() *types.MsgSubmitAttestation {
+				msg := newMsg(0b001, skA)
+				msg.AggregatePubkeyG2 = newMsg(0b001, skB).AggregatePubkeyG2
+				return msg
+			}(),
+			expErr: types.ErrInvalidSignature,
+		},
+		{
+			name: "invalid signature",
+			req: func() *types.MsgSubmitAttestation {
+				msg := newMsg(0b001, skA)
+				msg.Commitment = []byte("another commitment")
+				return msg
+			}(),
+			expErr: types.ErrInvalidSignature,
+		},
+		{
+			name:   "success",
+			req:    newMsg(0b001, skA),
+			expErr: nil,
+		},
+		{
+			name:   "duplicate",
+			req:    newMsg(0b001, skA),
+			expErr: types.ErrAttestationExists,
+		},
+		{
+			name: "conflicting commitment",
+			req: func() *types.MsgSubmitAttestation {
+				msg := newMsg(0b010, skB)
+				msg.Commitment = []byte("another commitment")
+				return msg
+			}(),
+			expErr: types.ErrAttestationExists,
+		},
+		{
+			name:   "later signatures",
+			req:    newMsg(0b010, skB),
+			expErr: nil,
+		},
+		{
+			name:   "signatures already counted",
+			req:    newMsg(0b011, skA, skB),
+			expErr: types.ErrAttestationExists,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.Keeper.SubmitAttestation(sdk.WrapSDKContext(suite.Ctx), tc.req)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+
+	liveness, err := suite.Keeper.GetLiveness(suite.Ctx, epoch, accountA)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(types.SignerLiveness{Account: accountA, Epoch: epoch, Attestations: 1, Signed: 1}, liveness)
+	liveness, err = suite.Keeper.GetLiveness(suite.Ctx, epoch, accountB)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(types.SignerLiveness{Account: accountB, Epoch: epoch, Attestations: 1, Signed: 1}, liveness)
+	attestation, found := suite.Keeper.GetAttestation(suite.Ctx, epoch, 0, blobId)
+	suite.Require().True(found)
+	suite.Assert().Equal([]byte{0b011}, attestation.QuorumBitmap)
+}
+
+func (suite *MsgServerTestSuite) TestSubmitAttestation_Liveness() {
+	accounts := []string{
+		"9685c4eb29309820cdc62663cc6cc82f3d42e964",
+		"d4a0ff7b8bb0f2a4e5c5f3b17cbbfb0c32e1f1a7",
+		"0000000000000000000000000000000000000003",
+	}
+	sks := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AttestationThresholdBps = 6000
+	params.MinAttestations = 2
+	params.MinSignedBps = 5000
+	suite.Keeper.SetParams(suite.Ctx, params)
+	for i, account := range accounts {
+		suite.setSigner(account, sks[i])
+	}
+	epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Keeper.SetEpochQuorums(suite.Ctx, epoch, types.Quorums{
+		Quorums: []*types.Quorum{{Signers: accounts}},
+	})
+	newMsg := func(blobId []byte, bitmap byte) *types.MsgSubmitAttestation {
+		aggSig := new(bn254.G1Affine)
+		aggSk := big.NewInt(0)
+		for i, sk := range sks {
+			if bitmap&(1<<i) == 0 {
+				continue
+			}
+			aggSig.Add(aggSig, suite.signAttestation(sk, blobId, []byte("commitment"), epoch, 0))
+			aggSk.Add(aggSk, sk)
+		}
+		return &types.MsgSubmitAttestation{
+			Submitter:          accounts[0],
+			Epoch:              epoch,
+			QuorumId:           0,
+			BlobId:             blobId,
+			Commitment:         []byte("commitment"),
+			QuorumBitmap:       []byte{bitmap},
+			AggregatePubkeyG2:  bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), aggSk)),
+			AggregateSignature: bn254util.SerializeG1(aggSig),
+		}
+	}
+
+	// the first two signers attest blobs without the third one
+	blobIds := make([][]byte, 4)
+	for i := range blobIds {
+		blobIds[i] = common.LeftPadBytes([]byte{byte(i + 1)}, 32)
+		_, err := suite.Keeper.SubmitAttestation(sdk.WrapSDKContext(suite.Ctx), newMsg(blobIds[i], 0b011))
+		suite.Require().NoError(err)
+	}
+	liveness, err := suite.Keeper.GetLiveness(suite.Ctx, epoch, accounts[2])
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(types.SignerLiveness{Account: accounts[2], Epoch: epoch, Attestations: 4, Signed: 0}, liveness)
+	// the third signer would be jailed without its signatures
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	suite.Keeper.HandleEpochLiveness(cacheCtx, epoch, params)
+	_, found, err := suite.Keeper.GetJail(cacheCtx, accounts[2])
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+
+	// the third signer adds its signatures in the next epoch, before the liveness is handled
+	suite.Keeper.SetEpochNumber(suite.Ctx, epoch+1)
+	for _, blobId := range blobIds {
+		_, err := suite.Keeper.SubmitAttestation(sdk.WrapSDKContext(suite.Ctx), newMsg(blobId, 0b100))
+		suite.Require().NoError(err)
+	}
+	// no attestation is started in the previous epoch
+	_, err = suite.Keeper.SubmitAttestation(sdk.WrapSDKContext(suite.Ctx), newMsg(common.LeftPadBytes([]byte{9}, 32), 0b111))
+	suite.Require().ErrorIs(err, types.ErrInvalidAttestationEpoch)
+
+	suite.Keeper.HandleEpochLiveness(suite.Ctx, epoch, params)
+	for _, account := range accounts {
+		_, found, err := suite.Keeper.GetJail(suite.Ctx, account)
+		suite.Require().NoError(err)
+		suite.Assert().False(found)
+	}
+	_, found = suite.Keeper.GetAttestation(suite.Ctx, epoch, 0, blobIds[0])
+	suite.Assert().False(found)
+}
+
+func (suite *MsgServerTestSuite) TestSubmitEquivocation() {
+	account := "9685c4eb29309820cdc62663cc6cc82f3d42e964"
+	sk := big.NewInt(1)
+	params := suite.Keeper.GetParams(suite.Ctx)
+	amount := keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote))
+	suite.AddDelegation(account, account, amount)
+	// initialize the distribution records skipped by the test delegation
+	accAddr, err := sdk.AccAddressFromHexUnsafe(account)
+	suite.Require().NoError(err)
+	valAddr, err := sdk.ValAddressFromHex(account)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.StakingKeeper.Hooks().AfterValidatorCreated(suite.Ctx, valAddr))
+	suite.Require().NoError(suite.StakingKeeper.Hooks().AfterDelegationModified(suite.Ctx, accAddr, valAddr))
+	suite.Require().NoError(suite.App.FundModuleAccount(suite.Ctx, stakingtypes.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(suite.StakingKeeper.BondDenom(suite.Ctx), amount))))
+	suite.setSigner(account, sk)
+	epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Keeper.SetEpochQuorums(suite.Ctx, epoch, types.Quorums{
+		Quorums: []*types.Quorum{{Signers: []string{account}}},
+	})
+	suite.Require().NoError(suite.Keeper.SetRegistration(suite.Ctx, epoch+1, account, make([]byte, bn254util.G1PointSize)))
+
+	blobId := common.LeftPadBytes([]byte{1}, 32)
+	newMsg := func(quorumId uint64, skB *big.Int) *types.MsgSubmitEquivocation {
+		return &types.MsgSubmitEquivocation{
+			Submitter:   account,
+			Account:     account,
+			Epoch:       epoch,
+			QuorumId:    quorumId,
+			BlobId:      blobId,
+			CommitmentA: []byte("commitment a"),
+			SignatureA:  bn254util.SerializeG1(suite.signAttestation(sk, blobId, []byte("commitment a"), epoch, quorumId)),
+			CommitmentB: []byte("commitment b"),
+			SignatureB:  bn254util.SerializeG1(suite.signAttestation(skB, blobId, []byte("commitment b"), epoch, quorumId)),
+		}
+	}
+
+	_, err = suite.Keeper.SubmitEquivocation(sdk.WrapSDKContext(suite.Ctx), newMsg(1, sk))
+	suite.Require().ErrorIs(err, types.ErrQuorumIdOutOfBound)
+	_, err = suite.Keeper.SubmitEquivocation(sdk.WrapSDKContext(suite.Ctx), newMsg(0, big.NewInt(2)))
+	suite.Require().ErrorIs(err, types.ErrInvalidEquivocation)
+	_, err = suite.Keeper.SubmitEquivocation(sdk.WrapSDKContext(suite.Ctx), newMsg(0, sk))
+	suite.Require().NoError(err)
+
+	// tombstoned and slashed
+	jail, found, err := suite.Keeper.GetJail(suite.Ctx, account)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Assert().True(jail.Tombstoned)
+	_, found, err = suite.Keeper.GetRegistration(suite.Ctx, epoch+1, account)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+	expected := amount.Sub(amount.MulRaw(int64(params.SlashFractionEquivocationBps)).QuoRaw(types.BasisPoints))
+	suite.Assert().EqualValues(expected, suite.Keeper.GetDelegatorBonded(suite.Ctx, accAddr))
+
+	_, err = suite.Keeper.SubmitEquivocation(sdk.WrapSDKContext(suite.Ctx), newMsg(0, sk))
+	suite.Require().ErrorIs(err, types.ErrSignerJailed)
+	suite.AddDelegation(account, account, amount)
+	_, err = suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterNextEpoch{
+		Account:   account,
+		Signature: make([]byte, bn254util.G1PointSize),
+	})
+	suite.Require().ErrorIs(err, types.ErrSignerJailed)
+}
+
+func (suite *MsgServerTestSuite) TestSetOperator() {
+	account := "9685c4eb29309820cdc62663cc6cc82f3d42e964"
+	operator := "00000000000000000000000000000000000000aa"
+	msg := &types.MsgSetOperator{Account: account, Operator: operator}
+
+	_, err := suite.Keeper.SetOperator(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrSignerNotFound)
+
+	suite.setSigner(account, big.NewInt(1))
+	oldEventNum := len(suite.Ctx.EventManager().Events())
+	_, err = suite.Keeper.SetOperator(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+	events := suite.Ctx.EventManager().Events()
+	suite.Assert().EqualValues(len(events), oldEventNum+1)
+	suite.Assert().EqualValues(events[len(events)-1], sdk.NewEvent(
+		types.EventTypeSetOperator,
+		sdk.NewAttribute(types.AttributeKeySigner, account),
+		sdk.NewAttribute(types.AttributeKeyOperator, operator),
+	))
+	stored, found, err := suite.Keeper.GetSignerOperator(suite.Ctx, account)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Assert().Equal(operator, stored)
+
+	// the operator acts on behalf of the signer, other accounts are rejected
+	_, err = suite.Keeper.UpdateSocket(sdk.WrapSDKContext(suite.Ctx), &types.MsgUpdateSocket{Account: account, Socket: "0.0.0.0:2345", Operator: operator})
+	suite.Require().NoError(err)
+	signer, _, err := suite.Keeper.GetSigner(suite.Ctx, account)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("0.0.0.0:2345", signer.Socket)
+	other := "00000000000000000000000000000000000000bb"
+	_, err = suite.Keeper.UpdateSocket(sdk.WrapSDKContext(suite.Ctx), &types.MsgUpdateSocket{Account: account, Socket: "0.0.0.0:3456", Operator: other})
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedOperator)
+	_, err = suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterNextEpoch{
+		Account:   account,
+		Signature: make([]byte, bn254util.G1PointSize),
+		Operator:  other,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedOperator)
+	_, err = suite.Keeper.SetVrfKey(sdk.WrapSDKContext(suite.Ctx), &types.MsgSetVrfKey{Account: account, Pubkey: make([]byte, vrf.PublicKeySize), Operator: other})
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedOperator)
+	_, err = suite.Keeper.SetVrfKey(sdk.WrapSDKContext(suite.Ctx), &types.MsgSetVrfKey{Account: account, Pubkey: make([]byte, vrf.PublicKeySize), Operator: operator})
+	suite.Require().NoError(err)
+
+	// an empty operator revokes the authorization
+	_, err = suite.Keeper.SetOperator(sdk.WrapSDKContext(suite.Ctx), &types.MsgSetOperator{Account: account})
+	suite.Require().NoError(err)
+	_, found, err = suite.Keeper.GetSignerOperator(suite.Ctx, account)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+	_, err = suite.Keeper.UpdateSocket(sdk.WrapSDKContext(suite.Ctx), &types.MsgUpdateSocket{Account: account, Socket: "0.0.0.0:3456", Operator: operator})
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedOperator)
+}
+
 func TestMsgServerSuite(t *testing.T) {
 	suite.Run(t, new(MsgServerTestSuite))
 }
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// GetSignerOperator returns the operator authorized by the signer account
+func (k Keeper) GetSignerOperator(ctx sdk.Context, account string) (string, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerOperatorKeyPrefix)
+	key, err := types.GetSignerOperatorKey(account)
+	if err != nil {
+		return "", false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return "", false, nil
+	}
+	return hex.EncodeToString(bz), true, nil
+}
+
+// SetSignerOperator stores the operator of the signer, an empty operator removes the authorization
+func (k Keeper) SetSignerOperator(ctx sdk.Context, operator types.SignerOperator) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerOperatorKeyPrefix)
+	key, err := types.GetSignerOperatorKey(operator.Account)
+	if err != nil {
+		return err
+	}
+	if operator.Operator == "" {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := hex.DecodeString(operator.Operator)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// iterate through the signer operators and perform the provided function
+func (k Keeper) IterateSignerOperators(ctx sdk.Context, fn func(operator types.SignerOperator) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerOperatorKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(types.SignerOperator{
+			Account:  hex.EncodeToString(iterator.Key()),
+			Operator: hex.EncodeToString(iterator.Value()),
+		}) {
+			break
+		}
+	}
+}
+
+// CheckSignerAuthority checks the sender is the signer account itself or its operator, an empty sender stands for the account
+func (k Keeper) CheckSignerAuthority(ctx sdk.Context, account string, sender string) error {
+	if sender == "" || sender == account {
+		return nil
+	}
+	operator, found, err := k.GetSignerOperator(ctx, account)
+	if err != nil {
+		return err
+	}
+	if !found || operator != sender {
+		return errorsmod.Wrapf(types.ErrUnauthorizedOperator, "sender %s, signer %s", sender, account)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// AggregatePubkeyG1OfBitmap aggregates the G1 public keys of the quorum members marked in the bitmap,
+// a member holding several slots is counted for every slot once any of its slots is marked.
+func (k Keeper) AggregatePubkeyG1OfBitmap(ctx sdk.Context, epoch uint64, quorum types.Quorum, bitmap []byte) (*bn254.G1Affine, map[string]struct{}, int, error) {
+	if (len(quorum.Signers)+7)/8 != len(bitmap) {
+		return nil, nil, 0, types.ErrQuorumBitmapLengthMismatch
+	}
+	// the slots of a member are not contiguous, so the marked members are collected before counting their slots
+	signed := markedSigners(quorum, bitmap)
+	aggPubkeyG1 := new(bn254.G1Affine)
+	hit := 0
+	added := make(map[string]struct{})
+	for _, account := range quorum.Signers {
+		if _, ok := signed[account]; !ok {
+			continue
+		}
+		hit += 1
+		if _, ok := added[account]; ok {
+			continue
+		}
+		added[account] = struct{}{}
+		signer, found, err := k.GetSignerAtEpoch(ctx, account, epoch)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		if !found {
+			return nil, nil, 0, types.ErrSignerNotFound
+		}
+		aggPubkeyG1.Add(aggPubkeyG1, bn254util.DeserializeG1(signer.PubkeyG1))
+	}
+	return aggPubkeyG1, signed, hit, nil
+}
+
+// markedSigners returns the quorum members with any of their slots marked in the bitmap.
+func markedSigners(quorum types.Quorum, bitmap []byte) map[string]struct{} {
+	marked := make(map[string]struct{})
+	for i, signer := range quorum.Signers {
+		if bitmap[i/8]&(1<<(i%8)) != 0 {
+			marked[signer] = struct{}{}
+		}
+	}
+	return marked
+}
+
+// VerifyQuorumSignatureOfBitmap verifies the aggregated signature over the message hash of the quorum members marked in the bitmap,
+// it returns whether the signature is valid, the total number of quorum slots and the number of slots signed.
+// Slots are allocated by bonded stake, so hit / total is the fraction of the quorum stake which signed the message.
+func (k Keeper) VerifyQuorumSignatureOfBitmap(ctx sdk.Context, epoch uint64, quorumId uint64, bitmap []byte, messageHash [32]byte, aggSigG1 []byte, aggPkG2 []byte) (bool, uint64, uint64, error) {
+	if len(aggSigG1) != bn254util.G1PointSize || len(aggPkG2) != bn254util.G2PointSize {
+		return false, 0, 0, types.ErrInvalidSignature
+	}
+	quorum, err := k.GetEpochQuorum(ctx, epoch, quorumId)
+	if err != nil {
+		return false, 0, 0, err
+	}
+	aggPubkeyG1, _, hit, err := k.AggregatePubkeyG1OfBitmap(ctx, epoch, quorum, bitmap)
+	if err != nil {
+		return false, 0, 0, err
+	}
+	total := uint64(len(quorum.Signers))
+	if hit == 0 {
+		return false, total, 0, nil
+	}
+	ok, err := verifyAggregateSignature(aggPubkeyG1, aggPkG2, messageHash, aggSigG1)
+	if err != nil {
+		return false, 0, 0, err
+	}
+	return ok, total, uint64(hit), nil
+}
+
+// verifyAggregateSignature checks the G2 public key matches the aggregated G1 public key,
+// and verifies the aggregated signature with it.
+func verifyAggregateSignature(aggPubkeyG1 *bn254.G1Affine, aggPkG2 []byte, hash [32]byte, aggSigG1 []byte) (bool, error) {
+	aggPubkeyG2 := bn254util.DeserializeG2(aggPkG2)
+	ok, err := bn254util.CheckG1AndG2DiscreteLogEquality(aggPubkeyG1, aggPubkeyG2)
+	if err != nil || !ok {
+		return false, nil
+	}
+	return bn254util.VerifySig(bn254util.DeserializeG1(aggSigG1), aggPubkeyG2, hash)
+}
+
+// JailSigner excludes the signer from ballots until the given epoch, or permanently if tombstoned.
+// The registration for the next epoch is dropped as well.
+func (k Keeper) JailSigner(ctx sdk.Context, account string, untilEpoch uint64, tombstoned bool, reason string) error {
+	jail, found, err := k.GetJail(ctx, account)
+	if err != nil {
+		return err
+	}
+	if !found {
+		jail = types.SignerJail{Account: account}
+	}
+	if untilEpoch > jail.UntilEpoch {
+		jail.UntilEpoch = untilEpoch
+	}
+	jail.Tombstoned = jail.Tombstoned || tombstoned
+	if err := k.SetJail(ctx, jail); err != nil {
+		return err
+	}
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return err
+	}
+	jailed, err := k.IsJailed(ctx, account, epochNumber+1)
+	if err != nil {
+		return err
+	}
+	if jailed {
+		if err := k.DeleteRegistration(ctx, epochNumber+1, account); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJailSigner,
+			sdk.NewAttribute(types.AttributeKeySigner, account),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, fmt.Sprint(jail.UntilEpoch)),
+			sdk.NewAttribute(types.AttributeKeyTombstoned, fmt.Sprint(jail.Tombstoned)),
+		),
+	)
+	return nil
+}
+
+// SlashDelegations burns the given fraction in basis points of all delegations of the signer.
+func (k Keeper) SlashDelegations(ctx sdk.Context, account string, fractionBps uint64, reason string) (math.Int, error) {
+	slashed := math.ZeroInt()
+	if fractionBps == 0 {
+		return slashed, nil
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(account)
+	if err != nil {
+		return slashed, err
+	}
+	// collect delegations first, the store cannot be modified during iteration
+	delegations := make([]stakingtypes.Delegation, 0)
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, accAddr, func(delegation stakingtypes.Delegation) bool {
+		delegations = append(delegations, delegation)
+		return false
+	})
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, delegation := range delegations {
+		validatorAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			return slashed, err
+		}
+		validator, found := k.stakingKeeper.GetValidator(ctx, validatorAddr)
+		if !found {
+			continue
+		}
+		shares := delegation.Shares.MulInt64(int64(fractionBps)).QuoInt64(types.BasisPoints)
+		if shares.IsZero() {
+			continue
+		}
+		amount, err := k.stakingKeeper.Unbond(ctx, accAddr, validatorAddr, shares)
+		if err != nil {
+			return slashed, err
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+		// the unbonded tokens are still held by the pool of the validator status before unbonding
+		pool := stakingtypes.NotBondedPoolName
+		if validator.IsBonded() {
+			pool = stakingtypes.BondedPoolName
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, pool, sdk.NewCoins(sdk.NewCoin(bondDenom, amount))); err != nil {
+			return slashed, err
+		}
+		slashed = slashed.Add(amount)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashSigner,
+			sdk.NewAttribute(types.AttributeKeySigner, account),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyAmount, slashed.String()),
+		),
+	)
+	return slashed, nil
+}
+
+// HandleEpochLiveness jails and slashes the signers which signed too few attestations in the given epoch, it is
+// called once the next epoch closes so that the signers could add their signatures to the attestations until then.
+// The liveness records and attestations of the epoch are removed afterwards.
+func (k Keeper) HandleEpochLiveness(ctx sdk.Context, epoch uint64, params types.Params) {
+	records := make([]types.SignerLiveness, 0)
+	k.IterateLiveness(ctx, epoch, func(liveness types.SignerLiveness) (stop bool) {
+		records = append(records, liveness)
+		return false
+	})
+	k.DeleteEpochLiveness(ctx, epoch)
+	if params.MinSignedBps == 0 {
+		return
+	}
+	for _, liveness := range records {
+		if liveness.Attestations == 0 || liveness.Attestations < params.MinAttestations {
+			continue
+		}
+		if liveness.Signed*types.BasisPoints >= liveness.Attestations*params.MinSignedBps {
+			continue
+		}
+		k.Logger(ctx).Info(fmt.Sprintf("[BeginBlock] signer %v signed %v of %v attestations in epoch %v", liveness.Account, liveness.Signed, liveness.Attestations, epoch))
+		// apply the punishment atomically, a failed slashing must not leave partial state
+		cacheCtx, write := ctx.CacheContext()
+		// the signer is excluded from the JailEpochs epochs after the closing one, which follows the given epoch
+		if err := k.JailSigner(cacheCtx, liveness.Account, epoch+1+params.JailEpochs, false, types.AttributeValueDowntime); err != nil {
+			k.Logger(ctx).Error("[BeginBlock] failed to jail signer", "signer", liveness.Account, "err", err)
+			continue
+		}
+		if _, err := k.SlashDelegations(cacheCtx, liveness.Account, params.SlashFractionDowntimeBps, types.AttributeValueDowntime); err != nil {
+			k.Logger(ctx).Error("[BeginBlock] failed to slash signer", "signer", liveness.Account, "err", err)
+			continue
+		}
+		write()
+	}
+}
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (k Keeper) GetSignerVrfKey(ctx sdk.Context, account string) (types.SignerVrfKey, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VrfKeyKeyPrefix)
+	key, err := types.GetVrfKeyKey(account)
+	if err != nil {
+		return types.SignerVrfKey{}, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return types.SignerVrfKey{}, false, nil
+	}
+	var vrfKey types.SignerVrfKey
+	k.cdc.MustUnmarshal(bz, &vrfKey)
+	return vrfKey, true, nil
+}
+
+func (k Keeper) SetSignerVrfKey(ctx sdk.Context, vrfKey types.SignerVrfKey) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VrfKeyKeyPrefix)
+	key, err := types.GetVrfKeyKey(vrfKey.Account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, k.cdc.MustMarshal(&vrfKey))
+	return nil
+}
+
+func (k Keeper) IterateSignerVrfKeys(ctx sdk.Context, fn func(vrfKey types.SignerVrfKey) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VrfKeyKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vrfKey types.SignerVrfKey
+		k.cdc.MustUnmarshal(iterator.Value(), &vrfKey)
+		if fn(vrfKey) {
+			break
+		}
+	}
+}
+
+// GetEpochRandomness returns the randomness of the epoch, nil if the epoch has no randomness
+func (k Keeper) GetEpochRandomness(ctx sdk.Context, epoch uint64) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RandomnessKeyPrefix)
+	return store.Get(types.GetRandomnessKey(epoch))
+}
+
+func (k Keeper) SetEpochRandomness(ctx sdk.Context, epoch uint64, randomness []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RandomnessKeyPrefix)
+	store.Set(types.GetRandomnessKey(epoch), randomness)
+}
+
+// GetVrfSeed returns the VRF input of the registrations for the epoch
+func (k Keeper) GetVrfSeed(ctx sdk.Context, epoch uint64, chainID *big.Int) []byte {
+	var randomness []byte
+	if epoch > 0 {
+		randomness = k.GetEpochRandomness(ctx, epoch-1)
+	}
+	return types.EpochVrfSeed(randomness, epoch, chainID)
+}
+
+func (k Keeper) SetVrfOutput(ctx sdk.Context, epoch uint64, account string, output []byte) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochVrfOutputKeyPrefix(epoch))
+	key, err := types.GetVrfOutputKey(account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, output)
+	return nil
+}
+
+// IterateVrfOutputs iterates the VRF outputs of the registrations for the epoch in the order of accounts
+func (k Keeper) IterateVrfOutputs(ctx sdk.Context, epoch uint64, fn func(account []byte, output []byte) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochVrfOutputKeyPrefix(epoch))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(iterator.Key(), iterator.Value()) {
+			break
+		}
+	}
+}
+
+// GenerateEpochRandomness aggregates the VRF outputs of the registrations for the epoch into the epoch randomness,
+// chained with the randomness of the previous epoch. None of the outputs can be chosen by its signer, since the VRF
+// output is unique for the key fixed before the seed is known, and a registration cannot withhold the output of an
+// effective key in VRF mode. A signer can still skip the registration to drop its output, so the randomness is not
+// unbiasable: the last registrant can choose between two values at the cost of its ballots for the epoch, and of the
+// jail imposed by HandleVrfWithholding if it registered for the epoch before.
+func (k Keeper) GenerateEpochRandomness(ctx sdk.Context, epoch uint64) []byte {
+	toHash := make([]byte, 0)
+	if epoch > 0 {
+		toHash = append(toHash, k.GetEpochRandomness(ctx, epoch-1)...)
+	}
+	toHash = append(toHash, sdk.Uint64ToBigEndian(epoch)...)
+	k.IterateVrfOutputs(ctx, epoch, func(account []byte, output []byte) (stop bool) {
+		toHash = append(toHash, account...)
+		toHash = append(toHash, output...)
+		return false
+	})
+	randomness := crypto.Keccak256(toHash)
+	k.SetEpochRandomness(ctx, epoch, randomness)
+	return randomness
+}
+
+// HandleVrfWithholding jails the signers which contributed VRF outputs to the randomness of the given epoch but skip
+// the registration for the next epoch with an effective VRF key, since dropping the output after seeing the others
+// chooses between two randomness values. The deregistered and jailed signers cannot register and are not punished.
+func (k Keeper) HandleVrfWithholding(ctx sdk.Context, epoch uint64, params types.Params) {
+	accounts := make([]string, 0)
+	k.IterateVrfOutputs(ctx, epoch, func(account []byte, _ []byte) (stop bool) {
+		accounts = append(accounts, hex.EncodeToString(account))
+		return false
+	})
+	for _, account := range accounts {
+		if _, registered, err := k.GetRegistration(ctx, epoch+1, account); err != nil || registered {
+			continue
+		}
+		if _, deregistered, err := k.GetDeregistration(ctx, account); err != nil || deregistered {
+			continue
+		}
+		if jailed, err := k.IsJailed(ctx, account, epoch+1); err != nil || jailed {
+			continue
+		}
+		vrfKey, found, err := k.GetSignerVrfKey(ctx, account)
+		if err != nil || !found || len(vrfKey.PubkeyAt(epoch+1)) == 0 {
+			continue
+		}
+		k.Logger(ctx).Info(fmt.Sprintf("[BeginBlock] signer %v withheld its VRF output for epoch %v", account, epoch+1))
+		// the signer is excluded from the JailEpochs epochs after the skipped one
+		if err := k.JailSigner(ctx, account, epoch+1+params.JailEpochs, false, types.AttributeValueVrfWithholding); err != nil {
+			k.Logger(ctx).Error("[BeginBlock] failed to jail signer", "signer", account, "err", err)
+		}
+	}
+}
+
+// ShuffleBallots shuffles the ballots with the Fisher-Yates algorithm, drawing from the randomness in counter mode.
+func ShuffleBallots(ballots []Ballot, randomness []byte) {
+	// start from a canonical order so that the result only depends on the ballots and the randomness
+	sort.SliceStable(ballots, func(i, j int) bool {
+		return ballots[i].account < ballots[j].account
+	})
+	for i := len(ballots) - 1; i > 0; i -= 1 {
+		draw := new(big.Int).SetBytes(crypto.Keccak256(randomness, sdk.Uint64ToBigEndian(uint64(i))))
+		j := draw.Mod(draw, big.NewInt(int64(i+1))).Int64()
+		ballots[i], ballots[j] = ballots[j], ballots[i]
+	}
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
+)
+
+func newBallots(weights []int) []keeper.Ballot {
+	ballots := make([]keeper.Ballot, 0)
+	for i, weight := range weights {
+		for j := 0; j < weight; j += 1 {
+			ballots = append(ballots, keeper.NewBallot(fmt.Sprintf("%040x", i), nil))
+		}
+	}
+	return ballots
+}
+
+func TestShuffleBallots_Deterministic(t *testing.T) {
+	randomness := crypto.Keccak256([]byte("randomness"))
+	a := newBallots([]int{1, 2, 3, 4})
+	b := newBallots([]int{1, 2, 3, 4})
+	// the input order does not matter
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	keeper.ShuffleBallots(a, randomness)
+	keeper.ShuffleBallots(b, randomness)
+	require.Equal(t, a, b)
+
+	c := newBallots([]int{1, 2, 3, 4})
+	keeper.ShuffleBallots(c, crypto.Keccak256([]byte("another randomness")))
+	require.NotEqual(t, a, c)
+}
+
+func TestShuffleBallots_Fairness(t *testing.T) {
+	// signer i holds weights[i] ballots, so it should take each slot with probability weights[i] / total
+	weights := []int{1, 1, 1, 1, 2, 2, 3, 5}
+	total := 0
+	for _, weight := range weights {
+		total += weight
+	}
+	const trials = 4000
+	const quorumSize = 4
+	first := make(map[string]int)
+	member := make(map[string]int)
+	for trial := 0; trial < trials; trial += 1 {
+		ballots := newBallots(weights)
+		keeper.ShuffleBallots(ballots, crypto.Keccak256([]byte(fmt.Sprintf("epoch %v", trial))))
+		first[ballots[0].Account()] += 1
+		for _, ballot := range ballots[:quorumSize] {
+			member[ballot.Account()] += 1
+		}
+	}
+
+	// chi-square test of the first slot, the critical value with 7 degrees of freedom at p = 0.001 is 24.32
+	chiSquare := 0.0
+	for i, weight := range weights {
+		expected := float64(trials*weight) / float64(total)
+		diff := float64(first[fmt.Sprintf("%040x", i)]) - expected
+		chiSquare += diff * diff / expected
+	}
+	require.Less(t, chiSquare, 24.32)
+
+	// the number of slots taken in the first quorum is proportional to the ballots
+	for i, weight := range weights {
+		expected := float64(trials*quorumSize*weight) / float64(total)
+		require.InEpsilon(t, expected, float64(member[fmt.Sprintf("%040x", i)]), 0.1, "signer %v", i)
+	}
+}
@@ -0,0 +1,37 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 adds the epoch_retention param and tracks the earliest kept epoch.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	migrateParams(store, cdc)
+	migrateEarliestEpoch(store)
+	return nil
+}
+
+// migrateParams sets the default epoch retention, the epochs out of the window are pruned gradually in BeginBlock
+func migrateParams(store sdk.KVStore, cdc codec.BinaryCodec) {
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	params.EpochRetention = types.DefaultGenesisState().Params.EpochRetention
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+}
+
+// migrateEarliestEpoch sets the earliest epoch to the first epoch with a stored quorum count
+func migrateEarliestEpoch(store sdk.KVStore) {
+	iterator := sdk.KVStorePrefixIterator(store, types.QuorumCountKeyPrefix)
+	defer iterator.Close()
+	earliest := uint64(0)
+	if iterator.Valid() {
+		earliest = sdk.BigEndianToUint64(iterator.Key()[len(types.QuorumCountKeyPrefix):])
+	}
+	store.Set(types.EarliestEpochKey, sdk.Uint64ToBigEndian(earliest))
+}
@@ -0,0 +1,58 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	v2dasigners "github.com/0glabs/0g-chain/x/dasigners/v1/migrations/v2"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func TestStoreMigrationSetsRetentionAndEarliestEpoch(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	params := types.Params{
+		TokensPerVote:     10,
+		MaxVotesPerSigner: 1024,
+		MaxQuorums:        10,
+		EpochBlocks:       5760,
+		EncodedSlices:     3072,
+	}
+	store.Set(types.ParamsKey, encCfg.Codec.MustMarshal(&params))
+	for _, epoch := range []uint64{3, 4, 5} {
+		store.Set(append(types.QuorumCountKeyPrefix, types.GetQuorumCountKey(epoch)...), sdk.Uint64ToBigEndian(1))
+	}
+
+	// Run migrations.
+	err := v2dasigners.MigrateStore(ctx, storeKey, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the retention is set and other params are kept.
+	var migrated types.Params
+	encCfg.Codec.MustUnmarshal(store.Get(types.ParamsKey), &migrated)
+	params.EpochRetention = types.DefaultGenesisState().Params.EpochRetention
+	require.Equal(t, params, migrated)
+	require.Equal(t, uint64(3), sdk.BigEndianToUint64(store.Get(types.EarliestEpochKey)))
+}
+
+func TestStoreMigrationWithoutQuorums(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, encCfg.Codec.MustMarshal(&types.Params{EpochBlocks: 5760}))
+
+	err := v2dasigners.MigrateStore(ctx, storeKey, encCfg.Codec)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), sdk.BigEndianToUint64(store.Get(types.EarliestEpochKey)))
+}
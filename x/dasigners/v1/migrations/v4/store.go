@@ -0,0 +1,49 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 4
+// V4 derives the structured endpoints of the existing signers from their socket addresses.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	migrateSignerEndpoints(store, cdc)
+	return nil
+}
+
+// migrateSignerEndpoints sets the endpoints of the signers without any,
+// signers with a malformed socket are kept unchanged until they update their socket.
+func migrateSignerEndpoints(store sdk.KVStore, cdc codec.BinaryCodec) {
+	keys, signers := signersWithoutEndpoints(store, cdc)
+	for i, signer := range signers {
+		endpoints, err := types.EndpointsFromSocket(signer.Socket)
+		if err != nil {
+			continue
+		}
+		signer.Endpoints = endpoints
+		store.Set(keys[i], cdc.MustMarshal(&signer))
+	}
+}
+
+// signersWithoutEndpoints returns the store keys and the signers without endpoints,
+// they are collected before being updated so that the store is not written during the iteration.
+func signersWithoutEndpoints(store sdk.KVStore, cdc codec.BinaryCodec) ([][]byte, []types.Signer) {
+	iterator := sdk.KVStorePrefixIterator(store, types.SignerKeyPrefix)
+	defer iterator.Close()
+	keys := make([][]byte, 0)
+	signers := make([]types.Signer, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var signer types.Signer
+		cdc.MustUnmarshal(iterator.Value(), &signer)
+		if len(signer.Endpoints) == 0 {
+			keys = append(keys, iterator.Key())
+			signers = append(signers, signer)
+		}
+	}
+	return keys, signers
+}
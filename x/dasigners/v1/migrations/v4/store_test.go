@@ -0,0 +1,53 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	v4dasigners "github.com/0glabs/0g-chain/x/dasigners/v1/migrations/v4"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func TestStoreMigrationDerivesSignerEndpoints(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	signers := []types.Signer{
+		{Account: "0000000000000000000000000000000000000001", Socket: "10.0.0.1:1234"},
+		{Account: "0000000000000000000000000000000000000002", Socket: "malformed"},
+		{Account: "0000000000000000000000000000000000000003", Socket: "da.example.com:443", Endpoints: []*types.SignerEndpoint{
+			{Kind: types.ENDPOINT_KIND_RETRIEVAL, Protocol: "https", Host: "da.example.com", Port: 443},
+		}},
+	}
+	for _, signer := range signers {
+		key, err := types.GetSignerKeyFromAccount(signer.Account)
+		require.NoError(t, err)
+		store.Set(append(types.SignerKeyPrefix, key...), encCfg.Codec.MustMarshal(&signer))
+	}
+
+	// Run migrations.
+	err := v4dasigners.MigrateStore(ctx, storeKey, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the endpoints are derived from valid sockets only.
+	expected := []types.Signer{signers[0], signers[1], signers[2]}
+	expected[0].Endpoints = []*types.SignerEndpoint{
+		{Kind: types.ENDPOINT_KIND_DISPERSAL, Protocol: types.LegacySocketProtocol, Host: "10.0.0.1", Port: 1234},
+		{Kind: types.ENDPOINT_KIND_RETRIEVAL, Protocol: types.LegacySocketProtocol, Host: "10.0.0.1", Port: 1234},
+	}
+	for _, signer := range expected {
+		key, err := types.GetSignerKeyFromAccount(signer.Account)
+		require.NoError(t, err)
+		var migrated types.Signer
+		encCfg.Codec.MustUnmarshal(store.Get(append(types.SignerKeyPrefix, key...)), &migrated)
+		require.Equal(t, signer, migrated)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	etherminttypes "github.com/evmos/ethermint/types"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -23,7 +24,7 @@ import (
 )
 
 // consensusVersion defines the current x/council module consensus version.
-const consensusVersion = 1
+const consensusVersion = 6
 
 // type check to ensure the interface is properly implemented
 var (
@@ -59,7 +60,8 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
-// ValidateGenesis performs genesis state validation for the inflation module.
+// ValidateGenesis performs genesis state validation for the inflation module. The registration signatures are bound
+// to the chain ID, which is not passed by the basic manager, so they are verified by ValidateGenesisWithChainID.
 func (b AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
 	var genesisState types.GenesisState
 	if err := cdc.UnmarshalJSON(bz, &genesisState); err != nil {
@@ -69,6 +71,23 @@ func (b AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncoding
 	return genesisState.Validate()
 }
 
+// ValidateGenesisWithChainID performs genesis state validation along with the registration signatures, which are
+// verified against the chain ID of the genesis doc.
+func (b AppModuleBasic) ValidateGenesisWithChainID(cdc codec.JSONCodec, bz json.RawMessage, chainID string) error {
+	var genesisState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genesisState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+	if err := genesisState.Validate(); err != nil {
+		return err
+	}
+	evmChainID, err := etherminttypes.ParseChainID(chainID)
+	if err != nil {
+		return err
+	}
+	return genesisState.ValidateRegistrationSignatures(evmChainID)
+}
+
 // RegisterRESTRoutes performs a no-op as the inflation module doesn't expose REST
 // endpoints
 func (AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
@@ -127,6 +146,23 @@ func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
@@ -82,10 +82,14 @@ func (suite *Suite) AddDelegation(from string, to string, amount math.Int) {
 	validator.Tokens = validator.Tokens.Add(amount)
 	validator.DelegatorShares = validator.DelegatorShares.Add(amount.ToLegacyDec())
 	suite.StakingKeeper.SetValidator(suite.Ctx, validator)
-	bonded := suite.Keeper.GetDelegatorBonded(suite.Ctx, accAddr)
+	shares := amount.ToLegacyDec()
+	if delegation, found := suite.StakingKeeper.GetDelegation(suite.Ctx, accAddr, valAddr); found {
+		shares = shares.Add(delegation.Shares)
+	}
 	suite.StakingKeeper.SetDelegation(suite.Ctx, stakingtypes.Delegation{
 		DelegatorAddress: accAddr.String(),
 		ValidatorAddress: valAddr.String(),
-		Shares:           bonded.Add(amount).ToLegacyDec(),
+		Shares:           shares,
 	})
+	suite.Require().NoError(suite.Keeper.Hooks().AfterDelegationModified(suite.Ctx, accAddr, valAddr))
 }
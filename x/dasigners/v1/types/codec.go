@@ -34,6 +34,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterSigner{},
 		&MsgUpdateSocket{},
 		&MsgRegisterNextEpoch{},
+		&MsgRotateSignerKey{},
+		&MsgDeregisterSigner{},
+		&MsgSubmitAttestation{},
+		&MsgSubmitEquivocation{},
+		&MsgSetVrfKey{},
+		&MsgSetOperator{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,223 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	fmt "fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// MaxSignerEndpoints defines the maximal number of endpoints of a signer
+	MaxSignerEndpoints = 8
+	// LegacySocketProtocol defines the protocol of the endpoints derived from a socket address
+	LegacySocketProtocol = "grpc"
+
+	maxHostLength = 253
+)
+
+// endpointProtocols maps the supported protocols to whether they are secured by TLS
+var endpointProtocols = map[string]bool{
+	"tcp":   false,
+	"http":  false,
+	"https": true,
+	"grpc":  false,
+	"grpcs": true,
+}
+
+// Address returns the host:port address of the endpoint.
+func (e *SignerEndpoint) Address() string {
+	return net.JoinHostPort(e.Host, strconv.FormatUint(uint64(e.Port), 10))
+}
+
+// URI returns the endpoint in the form of <protocol>://<host>:<port>?kind=<kind>[&tls_fingerprint=<hex>].
+func (e *SignerEndpoint) URI() string {
+	query := url.Values{}
+	query.Set("kind", strings.ToLower(strings.TrimPrefix(e.Kind.String(), "ENDPOINT_KIND_")))
+	if len(e.TlsFingerprint) > 0 {
+		query.Set("tls_fingerprint", hex.EncodeToString(e.TlsFingerprint))
+	}
+	return (&url.URL{Scheme: e.Protocol, Host: e.Address(), RawQuery: query.Encode()}).String()
+}
+
+// ParseEndpoint parses an endpoint from the form returned by URI, kind defaults to dispersal.
+func ParseEndpoint(uri string) (*SignerEndpoint, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidEndpoint, err.Error())
+	}
+	port, err := strconv.ParseUint(u.Port(), 10, 16)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidEndpoint, "invalid port %s", u.Port())
+	}
+	endpoint := &SignerEndpoint{
+		Kind:     ENDPOINT_KIND_DISPERSAL,
+		Protocol: u.Scheme,
+		Host:     u.Hostname(),
+		Port:     uint32(port),
+	}
+	if kind := u.Query().Get("kind"); kind != "" {
+		value, ok := EndpointKind_value["ENDPOINT_KIND_"+strings.ToUpper(kind)]
+		if !ok {
+			return nil, errorsmod.Wrapf(ErrInvalidEndpoint, "unknown kind %s", kind)
+		}
+		endpoint.Kind = EndpointKind(value)
+	}
+	if fingerprint := u.Query().Get("tls_fingerprint"); fingerprint != "" {
+		endpoint.TlsFingerprint, err = hex.DecodeString(fingerprint)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidEndpoint, "invalid tls fingerprint %s", fingerprint)
+		}
+	}
+	return endpoint, endpoint.Validate()
+}
+
+// Validate checks the kind, protocol, host, port and TLS fingerprint of the endpoint.
+func (e *SignerEndpoint) Validate() error {
+	if _, ok := EndpointKind_name[int32(e.Kind)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidEndpoint, "unknown kind %d", e.Kind)
+	}
+	tls, ok := endpointProtocols[e.Protocol]
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidEndpoint, "unsupported protocol %s", e.Protocol)
+	}
+	if err := validateHost(e.Host); err != nil {
+		return err
+	}
+	if e.Port == 0 || e.Port > 65535 {
+		return errorsmod.Wrapf(ErrInvalidEndpoint, "invalid port %d", e.Port)
+	}
+	if len(e.TlsFingerprint) > 0 {
+		if !tls {
+			return errorsmod.Wrapf(ErrInvalidEndpoint, "tls fingerprint on protocol %s", e.Protocol)
+		}
+		if len(e.TlsFingerprint) != sha256.Size {
+			return errorsmod.Wrap(ErrInvalidEndpoint, "invalid tls fingerprint length")
+		}
+	}
+	return nil
+}
+
+// ValidateEndpoints checks the number of endpoints, each of the endpoints and duplications.
+func ValidateEndpoints(endpoints []*SignerEndpoint) error {
+	if len(endpoints) > MaxSignerEndpoints {
+		return errorsmod.Wrapf(ErrInvalidEndpoint, "too many endpoints %d", len(endpoints))
+	}
+	seen := make(map[string]struct{})
+	for _, endpoint := range endpoints {
+		if err := endpoint.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%v|%v://%v", endpoint.Kind, endpoint.Protocol, endpoint.Address())
+		if _, ok := seen[key]; ok {
+			return errorsmod.Wrapf(ErrInvalidEndpoint, "duplicated endpoint %s", endpoint.URI())
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateSocket checks the socket is a host:port address.
+func ValidateSocket(socket string) error {
+	_, _, err := parseSocket(socket)
+	return err
+}
+
+// parseSocket splits the socket into the host and port.
+func parseSocket(socket string) (string, uint32, error) {
+	host, port, err := net.SplitHostPort(socket)
+	if err != nil {
+		return "", 0, errorsmod.Wrap(ErrInvalidEndpoint, err.Error())
+	}
+	if err := validateHost(host); err != nil {
+		return "", 0, err
+	}
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || p == 0 {
+		return "", 0, errorsmod.Wrapf(ErrInvalidEndpoint, "invalid port %s", port)
+	}
+	return host, uint32(p), nil
+}
+
+// validateSocketAndEndpoints checks the socket and endpoints, at least one of them must be set.
+func validateSocketAndEndpoints(socket string, endpoints []*SignerEndpoint) error {
+	if socket == "" && len(endpoints) == 0 {
+		return errorsmod.Wrap(ErrInvalidEndpoint, "neither socket nor endpoints set")
+	}
+	if socket != "" {
+		if err := ValidateSocket(socket); err != nil {
+			return err
+		}
+	}
+	return ValidateEndpoints(endpoints)
+}
+
+// EndpointsFromSocket derives the dispersal and retrieval endpoints served at a legacy socket address.
+func EndpointsFromSocket(socket string) ([]*SignerEndpoint, error) {
+	host, port, err := parseSocket(socket)
+	if err != nil {
+		return nil, err
+	}
+	return []*SignerEndpoint{
+		{Kind: ENDPOINT_KIND_DISPERSAL, Protocol: LegacySocketProtocol, Host: host, Port: port},
+		{Kind: ENDPOINT_KIND_RETRIEVAL, Protocol: LegacySocketProtocol, Host: host, Port: port},
+	}, nil
+}
+
+// SetEndpoints sets the socket and endpoints of the signer,
+// the endpoints are derived from the socket if empty and the socket mirrors the first dispersal endpoint if empty.
+func (s *Signer) SetEndpoints(socket string, endpoints []*SignerEndpoint) error {
+	if err := validateSocketAndEndpoints(socket, endpoints); err != nil {
+		return err
+	}
+	if len(endpoints) == 0 {
+		endpoints, _ = EndpointsFromSocket(socket)
+	}
+	if socket == "" {
+		socket = endpoints[0].Address()
+		for _, endpoint := range endpoints {
+			if endpoint.Kind == ENDPOINT_KIND_DISPERSAL {
+				socket = endpoint.Address()
+				break
+			}
+		}
+	}
+	s.Socket = socket
+	s.Endpoints = endpoints
+	return nil
+}
+
+// EndpointURIs returns the comma separated URIs of the endpoints of the signer.
+func (s *Signer) EndpointURIs() string {
+	uris := make([]string, len(s.Endpoints))
+	for i, endpoint := range s.Endpoints {
+		uris[i] = endpoint.URI()
+	}
+	return strings.Join(uris, ",")
+}
+
+// validateHost checks the host is an IP address or a domain name.
+func validateHost(host string) error {
+	if net.ParseIP(host) != nil {
+		return nil
+	}
+	if len(host) == 0 || len(host) > maxHostLength {
+		return errorsmod.Wrapf(ErrInvalidEndpoint, "invalid host %s", host)
+	}
+	for _, label := range strings.Split(host, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return errorsmod.Wrapf(ErrInvalidEndpoint, "invalid host %s", host)
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return errorsmod.Wrapf(ErrInvalidEndpoint, "invalid host %s", host)
+			}
+		}
+	}
+	return nil
+}
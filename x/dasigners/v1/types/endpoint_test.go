@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateEndpoints(t *testing.T) {
+	valid := func() *types.SignerEndpoint {
+		return &types.SignerEndpoint{
+			Kind:           types.ENDPOINT_KIND_RETRIEVAL,
+			Protocol:       "grpcs",
+			Host:           "da-1.example.com",
+			Port:           443,
+			TlsFingerprint: bytes.Repeat([]byte{1}, 32),
+		}
+	}
+	testCases := []struct {
+		name     string
+		modify   func(e *types.SignerEndpoint)
+		expError bool
+	}{
+		{name: "valid", modify: func(e *types.SignerEndpoint) {}},
+		{name: "ipv6 host", modify: func(e *types.SignerEndpoint) { e.Host = "::1" }},
+		{name: "unknown kind", modify: func(e *types.SignerEndpoint) { e.Kind = 2 }, expError: true},
+		{name: "unsupported protocol", modify: func(e *types.SignerEndpoint) { e.Protocol = "udp" }, expError: true},
+		{name: "empty host", modify: func(e *types.SignerEndpoint) { e.Host = "" }, expError: true},
+		{name: "host with port", modify: func(e *types.SignerEndpoint) { e.Host = "example.com:80" }, expError: true},
+		{name: "host with invalid label", modify: func(e *types.SignerEndpoint) { e.Host = "-example.com" }, expError: true},
+		{name: "zero port", modify: func(e *types.SignerEndpoint) { e.Port = 0 }, expError: true},
+		{name: "port out of range", modify: func(e *types.SignerEndpoint) { e.Port = 65536 }, expError: true},
+		{name: "fingerprint without tls", modify: func(e *types.SignerEndpoint) { e.Protocol = "grpc" }, expError: true},
+		{name: "invalid fingerprint length", modify: func(e *types.SignerEndpoint) { e.TlsFingerprint = []byte{1} }, expError: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			endpoint := valid()
+			tc.modify(endpoint)
+			err := types.ValidateEndpoints([]*types.SignerEndpoint{endpoint})
+			if tc.expError {
+				assert.ErrorIs(t, err, types.ErrInvalidEndpoint)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	assert.ErrorIs(t, types.ValidateEndpoints([]*types.SignerEndpoint{valid(), valid()}), types.ErrInvalidEndpoint)
+	tooMany := make([]*types.SignerEndpoint, types.MaxSignerEndpoints+1)
+	for i := range tooMany {
+		tooMany[i] = valid()
+		tooMany[i].Port = uint32(1000 + i)
+	}
+	assert.ErrorIs(t, types.ValidateEndpoints(tooMany), types.ErrInvalidEndpoint)
+}
+
+func Test_ParseEndpoint(t *testing.T) {
+	endpoint := &types.SignerEndpoint{
+		Kind:           types.ENDPOINT_KIND_RETRIEVAL,
+		Protocol:       "https",
+		Host:           "::1",
+		Port:           8443,
+		TlsFingerprint: bytes.Repeat([]byte{0xab}, 32),
+	}
+	parsed, err := types.ParseEndpoint(endpoint.URI())
+	require.NoError(t, err)
+	assert.Equal(t, endpoint, parsed)
+
+	parsed, err = types.ParseEndpoint("grpc://10.0.0.1:1234")
+	require.NoError(t, err)
+	assert.Equal(t, &types.SignerEndpoint{Kind: types.ENDPOINT_KIND_DISPERSAL, Protocol: "grpc", Host: "10.0.0.1", Port: 1234}, parsed)
+
+	for _, uri := range []string{"10.0.0.1:1234", "grpc://10.0.0.1", "grpc://10.0.0.1:1234?kind=storage", "grpcs://10.0.0.1:1234?tls_fingerprint=zz"} {
+		_, err := types.ParseEndpoint(uri)
+		assert.Error(t, err, uri)
+	}
+}
+
+func Test_SignerSetEndpoints(t *testing.T) {
+	signer := types.Signer{}
+	require.NoError(t, signer.SetEndpoints("10.0.0.1:1234", nil))
+	assert.Equal(t, "10.0.0.1:1234", signer.Socket)
+	assert.Equal(t, []*types.SignerEndpoint{
+		{Kind: types.ENDPOINT_KIND_DISPERSAL, Protocol: types.LegacySocketProtocol, Host: "10.0.0.1", Port: 1234},
+		{Kind: types.ENDPOINT_KIND_RETRIEVAL, Protocol: types.LegacySocketProtocol, Host: "10.0.0.1", Port: 1234},
+	}, signer.Endpoints)
+
+	// the socket mirrors the first dispersal endpoint
+	endpoints := []*types.SignerEndpoint{
+		{Kind: types.ENDPOINT_KIND_RETRIEVAL, Protocol: "http", Host: "retrieval.example.com", Port: 80},
+		{Kind: types.ENDPOINT_KIND_DISPERSAL, Protocol: "grpc", Host: "dispersal.example.com", Port: 9000},
+	}
+	require.NoError(t, signer.SetEndpoints("", endpoints))
+	assert.Equal(t, "dispersal.example.com:9000", signer.Socket)
+	assert.Equal(t, endpoints, signer.Endpoints)
+
+	assert.ErrorIs(t, signer.SetEndpoints("", nil), types.ErrInvalidEndpoint)
+	assert.ErrorIs(t, signer.SetEndpoints("10.0.0.1", nil), types.ErrInvalidEndpoint)
+	assert.ErrorIs(t, signer.SetEndpoints("10.0.0.1:0", endpoints), types.ErrInvalidEndpoint)
+	assert.Equal(t, "dispersal.example.com:9000", signer.Socket)
+}
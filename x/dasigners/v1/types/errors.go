@@ -13,4 +13,20 @@ var (
 	ErrInsufficientBonded         = errorsmod.Register(ModuleName, 8, "insufficient bonded amount")
 	ErrRowIndexOutOfBound         = errorsmod.Register(ModuleName, 9, "row index out of bound")
 	ErrInvalidEpochBlocks         = errorsmod.Register(ModuleName, 10, "invalid epoch blocks")
+	ErrSignerDeregistered         = errorsmod.Register(ModuleName, 11, "signer deregistered")
+	ErrSignerJailed               = errorsmod.Register(ModuleName, 12, "signer jailed")
+	ErrInvalidEquivocation        = errorsmod.Register(ModuleName, 13, "invalid equivocation evidence")
+	ErrSignerNotInQuorum          = errorsmod.Register(ModuleName, 14, "signer not in quorum")
+	ErrAttestationExists          = errorsmod.Register(ModuleName, 15, "attestation exists")
+	ErrInvalidAttestationEpoch    = errorsmod.Register(ModuleName, 16, "attestation epoch is not current epoch")
+	ErrInsufficientAttestation    = errorsmod.Register(ModuleName, 17, "insufficient signed quorum slots")
+	ErrInvalidBasisPoints         = errorsmod.Register(ModuleName, 18, "basis points out of range")
+	ErrInvalidVrfProof            = errorsmod.Register(ModuleName, 19, "invalid vrf proof")
+	ErrVrfKeyNotFound             = errorsmod.Register(ModuleName, 20, "vrf key not found")
+	ErrInvalidQuorumSelection     = errorsmod.Register(ModuleName, 21, "invalid quorum selection")
+	ErrInvalidMessageHash         = errorsmod.Register(ModuleName, 22, "invalid message hash")
+	ErrInvalidEndpoint            = errorsmod.Register(ModuleName, 23, "invalid signer endpoint")
+	ErrInvalidOperator            = errorsmod.Register(ModuleName, 24, "invalid signer operator")
+	ErrUnauthorizedOperator       = errorsmod.Register(ModuleName, 25, "sender is neither the signer nor its operator")
+	ErrVrfOutputRequired          = errorsmod.Register(ModuleName, 26, "vrf output required")
 )
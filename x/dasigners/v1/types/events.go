@@ -2,11 +2,17 @@ package types
 
 // Module event types
 const (
-	EventTypeUpdateSigner = "update_signer"
-	EventTypeUpdateParams = "update_params"
+	EventTypeUpdateSigner     = "update_signer"
+	EventTypeUpdateParams     = "update_params"
+	EventTypeDeregisterSigner = "deregister_signer"
+	EventTypeJailSigner       = "jail_signer"
+	EventTypeSlashSigner      = "slash_signer"
+	EventTypeAttestation      = "attestation"
+	EventTypeSetOperator      = "set_signer_operator"
 
 	AttributeKeySigner            = "signer"
 	AttributeKeySocket            = "socket"
+	AttributeKeyEndpoints         = "endpoints"
 	AttributeKeyPublicKeyG1       = "pubkey_g1"
 	AttributeKeyPublicKeyG2       = "pubkey_g2"
 	AttributeKeyBlockHeight       = "block_height"
@@ -15,4 +21,17 @@ const (
 	AttributeKeyMaxQuorums        = "max_quorums"
 	AttributeKeyEpochBlocks       = "epoch_blocks"
 	AttributeKeyEncodedSlices     = "encoded_slices"
+	AttributeKeyEpoch             = "epoch"
+	AttributeKeyReason            = "reason"
+	AttributeKeyJailedUntil       = "jailed_until"
+	AttributeKeyTombstoned        = "tombstoned"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyQuorumId          = "quorum_id"
+	AttributeKeyBlobId            = "blob_id"
+	AttributeKeySigned            = "signed"
+	AttributeKeyOperator          = "operator"
+
+	AttributeValueDowntime       = "downtime"
+	AttributeValueEquivocation   = "equivocation"
+	AttributeValueVrfWithholding = "vrf_withholding"
 )
@@ -1,32 +1,61 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	"github.com/coniks-sys/coniks-go/crypto/vrf"
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // NewGenesisState returns a new genesis state object for the module.
-func NewGenesisState(params Params, epoch uint64, signers []*Signer, quorumsByEpoch []*Quorums) *GenesisState {
+func NewGenesisState(params Params, epoch uint64, earliestEpoch uint64, signers []*Signer, quorumsByEpoch []*Quorums, signerKeyHistories []*SignerKeyHistory, deregistrations []*Deregistration, jails []*SignerJail, liveness []*SignerLiveness, registrations []*Registration, vrfKeys []*SignerVrfKey, epochRandomness []byte, operators []*SignerOperator, attestations []*Attestation) *GenesisState {
 	return &GenesisState{
-		Params:         params,
-		EpochNumber:    epoch,
-		Signers:        signers,
-		QuorumsByEpoch: quorumsByEpoch,
+		Params:             params,
+		EpochNumber:        epoch,
+		EarliestEpoch:      earliestEpoch,
+		Signers:            signers,
+		QuorumsByEpoch:     quorumsByEpoch,
+		SignerKeyHistories: signerKeyHistories,
+		Deregistrations:    deregistrations,
+		Jails:              jails,
+		Liveness:           liveness,
+		Registrations:      registrations,
+		VrfKeys:            vrfKeys,
+		EpochRandomness:    epochRandomness,
+		Operators:          operators,
+		Attestations:       attestations,
 	}
 }
 
 // DefaultGenesisState returns the default genesis state for the module.
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(Params{
-		TokensPerVote:     10,
-		MaxVotesPerSigner: 1024,
-		MaxQuorums:        10,
-		EpochBlocks:       5760,
-		EncodedSlices:     3072,
-	}, 0, make([]*Signer, 0), []*Quorums{{
+		TokensPerVote:                10,
+		MaxVotesPerSigner:            1024,
+		MaxQuorums:                   10,
+		EpochBlocks:                  5760,
+		EncodedSlices:                3072,
+		JailEpochs:                   3,
+		MinAttestations:              10,
+		MinSignedBps:                 5000,
+		AttestationThresholdBps:      6667,
+		SlashFractionDowntimeBps:     0,
+		SlashFractionEquivocationBps: 500,
+		EpochRetention:               720,
+		GasParams:                    DefaultGasParams(),
+	}, 0, 0, make([]*Signer, 0), []*Quorums{{
 		Quorums: make([]*Quorum, 0),
-	}})
+	}}, make([]*SignerKeyHistory, 0), make([]*Deregistration, 0), make([]*SignerJail, 0), make([]*SignerLiveness, 0), make([]*Registration, 0), make([]*SignerVrfKey, 0), nil, make([]*SignerOperator, 0), make([]*Attestation, 0))
 }
 
-// Validate performs basic validation of genesis data.
+// Validate performs basic validation of genesis data. The registration signatures are bound to the chain ID, which
+// is not part of the genesis state, see ValidateRegistrationSignatures.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
 	registered := make(map[string]struct{})
 	for _, signer := range gs.Signers {
 		if err := signer.Validate(); err != nil {
@@ -34,7 +63,10 @@ func (gs GenesisState) Validate() error {
 		}
 		registered[signer.Account] = struct{}{}
 	}
-	if len(gs.QuorumsByEpoch) != int(gs.EpochNumber)+1 {
+	if gs.EarliestEpoch > gs.EpochNumber {
+		return fmt.Errorf("invalid earliest epoch")
+	}
+	if len(gs.QuorumsByEpoch) != int(gs.EpochNumber-gs.EarliestEpoch)+1 {
 		return fmt.Errorf("epoch history missing")
 	}
 	for _, quorums := range gs.QuorumsByEpoch {
@@ -49,5 +81,188 @@ func (gs GenesisState) Validate() error {
 			}
 		}
 	}
+	for _, history := range gs.SignerKeyHistories {
+		if err := history.Validate(); err != nil {
+			return err
+		}
+		if _, ok := registered[history.Account]; !ok {
+			return fmt.Errorf("signer of key history not found")
+		}
+		if history.Epoch > gs.EpochNumber {
+			return fmt.Errorf("invalid key history epoch")
+		}
+	}
+	deregistered := make(map[string]struct{})
+	for _, deregistration := range gs.Deregistrations {
+		if err := ValidateHexAddress(deregistration.Account); err != nil {
+			return err
+		}
+		if _, ok := registered[deregistration.Account]; !ok {
+			return fmt.Errorf("deregistered signer not found")
+		}
+		if _, ok := deregistered[deregistration.Account]; ok {
+			return fmt.Errorf("duplicate deregistration")
+		}
+		deregistered[deregistration.Account] = struct{}{}
+	}
+	jailed := make(map[string]struct{})
+	for _, jail := range gs.Jails {
+		if err := ValidateHexAddress(jail.Account); err != nil {
+			return err
+		}
+		if _, ok := registered[jail.Account]; !ok {
+			return fmt.Errorf("jailed signer not found")
+		}
+		if _, ok := jailed[jail.Account]; ok {
+			return fmt.Errorf("duplicate jail")
+		}
+		jailed[jail.Account] = struct{}{}
+	}
+	tracked := make(map[string]struct{})
+	for _, liveness := range gs.Liveness {
+		if err := ValidateHexAddress(liveness.Account); err != nil {
+			return err
+		}
+		if _, ok := registered[liveness.Account]; !ok {
+			return fmt.Errorf("signer of liveness not found")
+		}
+		// the liveness of an epoch is handled once the next epoch closes
+		if liveness.Epoch != gs.EpochNumber && liveness.Epoch+1 != gs.EpochNumber {
+			return fmt.Errorf("invalid liveness epoch")
+		}
+		if liveness.Signed > liveness.Attestations {
+			return fmt.Errorf("invalid liveness record")
+		}
+		key := fmt.Sprintf("%v/%v", liveness.Epoch, liveness.Account)
+		if _, ok := tracked[key]; ok {
+			return fmt.Errorf("duplicate liveness")
+		}
+		tracked[key] = struct{}{}
+	}
+	attestations := make(map[string]struct{})
+	for _, attestation := range gs.Attestations {
+		if attestation.Epoch != gs.EpochNumber && attestation.Epoch+1 != gs.EpochNumber {
+			return fmt.Errorf("invalid attestation epoch")
+		}
+		if attestation.Epoch < gs.EarliestEpoch {
+			return fmt.Errorf("quorums of attestation not found")
+		}
+		quorums := gs.QuorumsByEpoch[attestation.Epoch-gs.EarliestEpoch].Quorums
+		if attestation.QuorumId >= uint64(len(quorums)) {
+			return fmt.Errorf("quorum of attestation not found")
+		}
+		if len(attestation.BlobId) != 32 {
+			return fmt.Errorf("invalid blob id length")
+		}
+		if len(attestation.Commitment) == 0 {
+			return fmt.Errorf("empty commitment")
+		}
+		if len(attestation.QuorumBitmap) != (len(quorums[attestation.QuorumId].Signers)+7)/8 {
+			return ErrQuorumBitmapLengthMismatch
+		}
+		key := fmt.Sprintf("%v/%v/%x", attestation.Epoch, attestation.QuorumId, attestation.BlobId)
+		if _, ok := attestations[key]; ok {
+			return fmt.Errorf("duplicate attestation")
+		}
+		attestations[key] = struct{}{}
+	}
+	registrations := make(map[string]struct{})
+	for _, registration := range gs.Registrations {
+		if err := ValidateHexAddress(registration.Account); err != nil {
+			return err
+		}
+		if _, ok := registered[registration.Account]; !ok {
+			return fmt.Errorf("signer of registration not found")
+		}
+		if registration.Epoch == 0 || registration.Epoch < gs.EarliestEpoch || registration.Epoch > gs.EpochNumber+1 {
+			return fmt.Errorf("invalid registration epoch")
+		}
+		if len(registration.Signature) != bn254util.G1PointSize {
+			return fmt.Errorf("invalid registration signature length")
+		}
+		key := fmt.Sprintf("%v/%v", registration.Epoch, registration.Account)
+		if _, ok := registrations[key]; ok {
+			return fmt.Errorf("duplicate registration")
+		}
+		registrations[key] = struct{}{}
+	}
+	vrfKeys := make(map[string]struct{})
+	for _, vrfKey := range gs.VrfKeys {
+		if err := ValidateHexAddress(vrfKey.Account); err != nil {
+			return err
+		}
+		if _, ok := registered[vrfKey.Account]; !ok {
+			return fmt.Errorf("signer of vrf key not found")
+		}
+		if len(vrfKey.Pubkey) != vrf.PublicKeySize || (len(vrfKey.PreviousPubkey) != 0 && len(vrfKey.PreviousPubkey) != vrf.PublicKeySize) {
+			return fmt.Errorf("invalid vrf pubkey")
+		}
+		if _, ok := vrfKeys[vrfKey.Account]; ok {
+			return fmt.Errorf("duplicate vrf key")
+		}
+		vrfKeys[vrfKey.Account] = struct{}{}
+	}
+	operators := make(map[string]struct{})
+	for _, operator := range gs.Operators {
+		if err := ValidateHexAddress(operator.Account); err != nil {
+			return err
+		}
+		if _, ok := registered[operator.Account]; !ok {
+			return fmt.Errorf("signer of operator not found")
+		}
+		if operator.Operator == "" {
+			return fmt.Errorf("empty operator")
+		}
+		if err := validateOperator(operator.Account, operator.Operator); err != nil {
+			return err
+		}
+		if _, ok := operators[operator.Account]; ok {
+			return fmt.Errorf("duplicate operator")
+		}
+		operators[operator.Account] = struct{}{}
+	}
+	if len(gs.EpochRandomness) != 0 && len(gs.EpochRandomness) != 32 {
+		return fmt.Errorf("invalid epoch randomness")
+	}
+	return nil
+}
+
+// ValidateRegistrationSignatures verifies the signature of each registration against the BN254 keys of the signer.
+// A registration for an epoch is signed during the previous epoch, with the keys effective in either of the two epochs.
+func (gs GenesisState) ValidateRegistrationSignatures(chainID *big.Int) error {
+	signers := make(map[string]*Signer)
+	for _, signer := range gs.Signers {
+		signers[signer.Account] = signer
+	}
+	histories := make(map[string][]*SignerKeyHistory)
+	for _, history := range gs.SignerKeyHistories {
+		histories[history.Account] = append(histories[history.Account], history)
+	}
+	// keyAt returns the signer with the keys effective in the given epoch
+	keyAt := func(account string, epoch uint64) *Signer {
+		signer := *signers[account]
+		var effective *SignerKeyHistory
+		for _, history := range histories[account] {
+			if history.Epoch >= epoch && (effective == nil || history.Epoch < effective.Epoch) {
+				effective = history
+			}
+		}
+		if effective != nil {
+			signer.PubkeyG1 = effective.PubkeyG1
+			signer.PubkeyG2 = effective.PubkeyG2
+		}
+		return &signer
+	}
+	for _, registration := range gs.Registrations {
+		if _, ok := signers[registration.Account]; !ok {
+			return fmt.Errorf("signer of registration not found")
+		}
+		hash := EpochRegistrationHash(common.HexToAddress(registration.Account), registration.Epoch, chainID)
+		signature := bn254util.DeserializeG1(registration.Signature)
+		if !keyAt(registration.Account, registration.Epoch-1).ValidateSignature(hash, signature) &&
+			!keyAt(registration.Account, registration.Epoch).ValidateSignature(hash, signature) {
+			return fmt.Errorf("invalid registration signature of %v for epoch %v", registration.Account, registration.Epoch)
+		}
+	}
 	return nil
 }
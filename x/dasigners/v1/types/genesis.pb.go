@@ -26,12 +26,63 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QuorumSelection enumerates the ways to order the ballots of an epoch.
+type QuorumSelection int32
+
+const (
+	// QUORUM_SELECTION_SIGNATURE_HASH sorts ballots by the chained hash of the registration signatures
+	QUORUM_SELECTION_SIGNATURE_HASH QuorumSelection = 0
+	// QUORUM_SELECTION_VRF shuffles ballots with the epoch randomness aggregated from the VRF outputs of registrations,
+	// every registration of a signer with an effective VRF key must carry its output. The randomness is not
+	// unbiasable, a signer can skip its registration to drop its output at the cost of its ballots for the epoch. A
+	// signer which contributed an output to the previous randomness is jailed for jail_epochs if it skips so.
+	QUORUM_SELECTION_VRF QuorumSelection = 1
+)
+
+var QuorumSelection_name = map[int32]string{
+	0: "QUORUM_SELECTION_SIGNATURE_HASH",
+	1: "QUORUM_SELECTION_VRF",
+}
+
+var QuorumSelection_value = map[string]int32{
+	"QUORUM_SELECTION_SIGNATURE_HASH": 0,
+	"QUORUM_SELECTION_VRF":            1,
+}
+
+func (x QuorumSelection) String() string {
+	return proto.EnumName(QuorumSelection_name, int32(x))
+}
+
+func (QuorumSelection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_896efa766aaca3be, []int{0}
+}
+
 type Params struct {
 	TokensPerVote     uint64 `protobuf:"varint,1,opt,name=tokens_per_vote,json=tokensPerVote,proto3" json:"tokens_per_vote,omitempty"`
 	MaxVotesPerSigner uint64 `protobuf:"varint,2,opt,name=max_votes_per_signer,json=maxVotesPerSigner,proto3" json:"max_votes_per_signer,omitempty"`
 	MaxQuorums        uint64 `protobuf:"varint,3,opt,name=max_quorums,json=maxQuorums,proto3" json:"max_quorums,omitempty"`
 	EpochBlocks       uint64 `protobuf:"varint,4,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
 	EncodedSlices     uint64 `protobuf:"varint,5,opt,name=encoded_slices,json=encodedSlices,proto3" json:"encoded_slices,omitempty"`
+	// jail_epochs defines the number of epochs a jailed signer is excluded from ballots
+	JailEpochs uint64 `protobuf:"varint,6,opt,name=jail_epochs,json=jailEpochs,proto3" json:"jail_epochs,omitempty"`
+	// min_attestations defines the minimal number of attestations of a signer in an epoch to enforce liveness
+	MinAttestations uint64 `protobuf:"varint,7,opt,name=min_attestations,json=minAttestations,proto3" json:"min_attestations,omitempty"`
+	// min_signed_bps defines the minimal ratio in basis points of attestations a signer must sign in an epoch,
+	// zero disables the liveness tracking
+	MinSignedBps uint64 `protobuf:"varint,8,opt,name=min_signed_bps,json=minSignedBps,proto3" json:"min_signed_bps,omitempty"`
+	// attestation_threshold_bps defines the minimal ratio in basis points of quorum slots signing an attestation
+	AttestationThresholdBps uint64 `protobuf:"varint,9,opt,name=attestation_threshold_bps,json=attestationThresholdBps,proto3" json:"attestation_threshold_bps,omitempty"`
+	// slash_fraction_downtime_bps defines the ratio in basis points of delegations slashed for downtime
+	SlashFractionDowntimeBps uint64 `protobuf:"varint,10,opt,name=slash_fraction_downtime_bps,json=slashFractionDowntimeBps,proto3" json:"slash_fraction_downtime_bps,omitempty"`
+	// slash_fraction_equivocation_bps defines the ratio in basis points of delegations slashed for equivocation
+	SlashFractionEquivocationBps uint64 `protobuf:"varint,11,opt,name=slash_fraction_equivocation_bps,json=slashFractionEquivocationBps,proto3" json:"slash_fraction_equivocation_bps,omitempty"`
+	// epoch_retention defines the number of latest epochs whose quorums and registrations are kept,
+	// zero keeps all epochs
+	EpochRetention uint64 `protobuf:"varint,12,opt,name=epoch_retention,json=epochRetention,proto3" json:"epoch_retention,omitempty"`
+	// quorum_selection defines how the ballots of an epoch are ordered before being split into quorums
+	QuorumSelection QuorumSelection `protobuf:"varint,13,opt,name=quorum_selection,json=quorumSelection,proto3,enum=zgc.dasigners.v1.QuorumSelection" json:"quorum_selection,omitempty"`
+	// gas_params defines the gas charged by the precompile according to the size of the inputs
+	GasParams GasParams `protobuf:"bytes,14,opt,name=gas_params,json=gasParams,proto3" json:"gas_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -102,6 +153,143 @@ func (m *Params) GetEncodedSlices() uint64 {
 	return 0
 }
 
+func (m *Params) GetJailEpochs() uint64 {
+	if m != nil {
+		return m.JailEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetMinAttestations() uint64 {
+	if m != nil {
+		return m.MinAttestations
+	}
+	return 0
+}
+
+func (m *Params) GetMinSignedBps() uint64 {
+	if m != nil {
+		return m.MinSignedBps
+	}
+	return 0
+}
+
+func (m *Params) GetAttestationThresholdBps() uint64 {
+	if m != nil {
+		return m.AttestationThresholdBps
+	}
+	return 0
+}
+
+func (m *Params) GetSlashFractionDowntimeBps() uint64 {
+	if m != nil {
+		return m.SlashFractionDowntimeBps
+	}
+	return 0
+}
+
+func (m *Params) GetSlashFractionEquivocationBps() uint64 {
+	if m != nil {
+		return m.SlashFractionEquivocationBps
+	}
+	return 0
+}
+
+func (m *Params) GetEpochRetention() uint64 {
+	if m != nil {
+		return m.EpochRetention
+	}
+	return 0
+}
+
+func (m *Params) GetQuorumSelection() QuorumSelection {
+	if m != nil {
+		return m.QuorumSelection
+	}
+	return QUORUM_SELECTION_SIGNATURE_HASH
+}
+
+func (m *Params) GetGasParams() GasParams {
+	if m != nil {
+		return m.GasParams
+	}
+	return GasParams{}
+}
+
+// GasParams defines the gas charged by the precompile on top of the basic gas of each method and the store accesses.
+type GasParams struct {
+	// per_account defines the gas charged for each account requested by getSigner
+	PerAccount uint64 `protobuf:"varint,1,opt,name=per_account,json=perAccount,proto3" json:"per_account,omitempty"`
+	// per_bitmap_byte defines the gas charged for each byte of the quorum bitmap in getAggPkG1 and verifyQuorumSignature
+	PerBitmapByte uint64 `protobuf:"varint,2,opt,name=per_bitmap_byte,json=perBitmapByte,proto3" json:"per_bitmap_byte,omitempty"`
+	// per_quorum_member defines the gas charged for each signer returned by getQuorum, or aggregated by getAggPkG1
+	// and verifyQuorumSignature
+	PerQuorumMember uint64 `protobuf:"varint,3,opt,name=per_quorum_member,json=perQuorumMember,proto3" json:"per_quorum_member,omitempty"`
+	// per_pairing defines the gas charged for each pairing check in verifyQuorumSignature
+	PerPairing uint64 `protobuf:"varint,4,opt,name=per_pairing,json=perPairing,proto3" json:"per_pairing,omitempty"`
+}
+
+func (m *GasParams) Reset()         { *m = GasParams{} }
+func (m *GasParams) String() string { return proto.CompactTextString(m) }
+func (*GasParams) ProtoMessage()    {}
+func (*GasParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_896efa766aaca3be, []int{1}
+}
+func (m *GasParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasParams.Merge(m, src)
+}
+func (m *GasParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasParams proto.InternalMessageInfo
+
+func (m *GasParams) GetPerAccount() uint64 {
+	if m != nil {
+		return m.PerAccount
+	}
+	return 0
+}
+
+func (m *GasParams) GetPerBitmapByte() uint64 {
+	if m != nil {
+		return m.PerBitmapByte
+	}
+	return 0
+}
+
+func (m *GasParams) GetPerQuorumMember() uint64 {
+	if m != nil {
+		return m.PerQuorumMember
+	}
+	return 0
+}
+
+func (m *GasParams) GetPerPairing() uint64 {
+	if m != nil {
+		return m.PerPairing
+	}
+	return 0
+}
+
 // GenesisState defines the dasigners module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of related to deposit.
@@ -112,13 +300,33 @@ type GenesisState struct {
 	Signers []*Signer `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 	// quorums_by_epoch defines chosen quorums by epoch
 	QuorumsByEpoch []*Quorums `protobuf:"bytes,4,rep,name=quorums_by_epoch,json=quorumsByEpoch,proto3" json:"quorums_by_epoch,omitempty"`
+	// signer_key_histories defines the retired keys of rotated signers
+	SignerKeyHistories []*SignerKeyHistory `protobuf:"bytes,5,rep,name=signer_key_histories,json=signerKeyHistories,proto3" json:"signer_key_histories,omitempty"`
+	// deregistrations defines the signers which have left the signer set
+	Deregistrations []*Deregistration `protobuf:"bytes,6,rep,name=deregistrations,proto3" json:"deregistrations,omitempty"`
+	// jails defines the signers excluded from ballots because of faults
+	Jails []*SignerJail `protobuf:"bytes,7,rep,name=jails,proto3" json:"jails,omitempty"`
+	// liveness defines the attestation records of signers in current and previous epochs
+	Liveness []*SignerLiveness `protobuf:"bytes,8,rep,name=liveness,proto3" json:"liveness,omitempty"`
+	// earliest_epoch defines the epoch of the first entry in quorums_by_epoch
+	EarliestEpoch uint64 `protobuf:"varint,9,opt,name=earliest_epoch,json=earliestEpoch,proto3" json:"earliest_epoch,omitempty"`
+	// registrations defines the registrations of signers from earliest_epoch to the next epoch
+	Registrations []*Registration `protobuf:"bytes,10,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	// vrf_keys defines the VRF public keys of signers
+	VrfKeys []*SignerVrfKey `protobuf:"bytes,11,rep,name=vrf_keys,json=vrfKeys,proto3" json:"vrf_keys,omitempty"`
+	// epoch_randomness defines the randomness of current epoch, which seeds the VRF of the next epoch
+	EpochRandomness []byte `protobuf:"bytes,12,opt,name=epoch_randomness,json=epochRandomness,proto3" json:"epoch_randomness,omitempty"`
+	// operators defines the operators authorized by signers
+	Operators []*SignerOperator `protobuf:"bytes,13,rep,name=operators,proto3" json:"operators,omitempty"`
+	// attestations defines the attestations of current and previous epochs, whose liveness is not handled yet
+	Attestations []*Attestation `protobuf:"bytes,14,rep,name=attestations,proto3" json:"attestations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_896efa766aaca3be, []int{1}
+	return fileDescriptor_896efa766aaca3be, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -175,43 +383,150 @@ func (m *GenesisState) GetQuorumsByEpoch() []*Quorums {
 	return nil
 }
 
+func (m *GenesisState) GetSignerKeyHistories() []*SignerKeyHistory {
+	if m != nil {
+		return m.SignerKeyHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeregistrations() []*Deregistration {
+	if m != nil {
+		return m.Deregistrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetJails() []*SignerJail {
+	if m != nil {
+		return m.Jails
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiveness() []*SignerLiveness {
+	if m != nil {
+		return m.Liveness
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEarliestEpoch() uint64 {
+	if m != nil {
+		return m.EarliestEpoch
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRegistrations() []*Registration {
+	if m != nil {
+		return m.Registrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVrfKeys() []*SignerVrfKey {
+	if m != nil {
+		return m.VrfKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochRandomness() []byte {
+	if m != nil {
+		return m.EpochRandomness
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperators() []*SignerOperator {
+	if m != nil {
+		return m.Operators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestations() []*Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("zgc.dasigners.v1.QuorumSelection", QuorumSelection_name, QuorumSelection_value)
 	proto.RegisterType((*Params)(nil), "zgc.dasigners.v1.Params")
+	proto.RegisterType((*GasParams)(nil), "zgc.dasigners.v1.GasParams")
 	proto.RegisterType((*GenesisState)(nil), "zgc.dasigners.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("zgc/dasigners/v1/genesis.proto", fileDescriptor_896efa766aaca3be) }
 
 var fileDescriptor_896efa766aaca3be = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0x86, 0xb3, 0x24, 0x04, 0xc9, 0x29, 0xa5, 0x58, 0x3d, 0x6c, 0x7a, 0xd8, 0x84, 0x4a, 0x20,
-	0x2e, 0xac, 0xdb, 0x22, 0xf1, 0x00, 0x41, 0x08, 0x71, 0x41, 0x25, 0x91, 0x38, 0x70, 0x59, 0x79,
-	0x9d, 0xc1, 0x59, 0x35, 0xde, 0x59, 0xd6, 0xde, 0x28, 0xe9, 0x53, 0xf0, 0x58, 0x3d, 0x70, 0xe8,
-	0x91, 0x13, 0x42, 0xc9, 0x8b, 0xa0, 0x1d, 0x9b, 0x56, 0xb4, 0xdc, 0xec, 0xff, 0xff, 0x66, 0xf4,
-	0xcf, 0xd8, 0x2c, 0xb9, 0xd4, 0x4a, 0xcc, 0xa5, 0x2d, 0x74, 0x09, 0xb5, 0x15, 0xab, 0x53, 0xa1,
-	0xa1, 0x04, 0x5b, 0xd8, 0xb4, 0xaa, 0xd1, 0x21, 0x3f, 0xb8, 0xd4, 0x2a, 0xbd, 0xf1, 0xd3, 0xd5,
-	0xe9, 0xd1, 0x50, 0xa1, 0x35, 0x68, 0x33, 0xf2, 0x85, 0xbf, 0x78, 0xf8, 0xe8, 0x50, 0xa3, 0x46,
-	0xaf, 0xb7, 0xa7, 0xa0, 0x0e, 0x35, 0xa2, 0x5e, 0x82, 0xa0, 0x5b, 0xde, 0x7c, 0x15, 0xb2, 0xdc,
-	0x04, 0x6b, 0x74, 0xd7, 0x72, 0x85, 0x01, 0xeb, 0xa4, 0xa9, 0x02, 0x30, 0xbe, 0x17, 0xef, 0x36,
-	0x0b, 0x11, 0xc7, 0x3f, 0x22, 0xd6, 0x3f, 0x97, 0xb5, 0x34, 0x96, 0xbf, 0x60, 0x4f, 0x1c, 0x5e,
-	0x40, 0x69, 0xb3, 0x0a, 0xea, 0x6c, 0x85, 0x0e, 0xe2, 0x68, 0x1c, 0xbd, 0xec, 0x4d, 0x1f, 0x7b,
-	0xf9, 0x1c, 0xea, 0xcf, 0xe8, 0x80, 0x0b, 0x76, 0x68, 0xe4, 0x9a, 0x00, 0x8f, 0xfa, 0x8e, 0xf1,
-	0x03, 0x82, 0x9f, 0x1a, 0xb9, 0x6e, 0xb1, 0x16, 0x9f, 0x91, 0xc1, 0x47, 0x6c, 0xd0, 0x16, 0x7c,
-	0x6b, 0xb0, 0x6e, 0x8c, 0x8d, 0xbb, 0xc4, 0x31, 0x23, 0xd7, 0x9f, 0xbc, 0xc2, 0x9f, 0xb1, 0x3d,
-	0xa8, 0x50, 0x2d, 0xb2, 0x7c, 0x89, 0xea, 0xc2, 0xc6, 0x3d, 0x22, 0x06, 0xa4, 0x4d, 0x48, 0xe2,
-	0xcf, 0xd9, 0x3e, 0x94, 0x0a, 0xe7, 0x30, 0xcf, 0xec, 0xb2, 0x50, 0x60, 0xe3, 0x87, 0x3e, 0x5b,
-	0x50, 0x67, 0x24, 0x1e, 0x6f, 0x23, 0xb6, 0xf7, 0xde, 0xbf, 0xc0, 0xcc, 0x49, 0x07, 0xfc, 0x0d,
-	0xeb, 0x57, 0x34, 0x1e, 0xcd, 0x32, 0x38, 0x8b, 0xd3, 0xbb, 0x2f, 0x92, 0xfa, 0xf1, 0x27, 0xbd,
-	0xab, 0x5f, 0xa3, 0xce, 0x34, 0xd0, 0xb7, 0x91, 0xca, 0xc6, 0xe4, 0x37, 0xc3, 0xf9, 0x48, 0x1f,
-	0x49, 0xe2, 0x67, 0xec, 0x51, 0xe8, 0x12, 0x77, 0xc7, 0xdd, 0xff, 0xf7, 0xf6, 0x1b, 0x98, 0xfe,
-	0x05, 0xf9, 0x5b, 0x76, 0x10, 0xd6, 0x90, 0xe5, 0x9b, 0x8c, 0xba, 0xc5, 0x3d, 0x2a, 0x1e, 0xde,
-	0x2f, 0x0e, 0xeb, 0x99, 0xee, 0x87, 0x92, 0xc9, 0xe6, 0x1d, 0x6d, 0xe4, 0xc3, 0xd5, 0x36, 0x89,
-	0xae, 0xb7, 0x49, 0xf4, 0x7b, 0x9b, 0x44, 0xdf, 0x77, 0x49, 0xe7, 0x7a, 0x97, 0x74, 0x7e, 0xee,
-	0x92, 0xce, 0x17, 0xa1, 0x0b, 0xb7, 0x68, 0xf2, 0x54, 0xa1, 0x11, 0x27, 0x7a, 0x29, 0x73, 0x2b,
-	0x4e, 0xf4, 0x2b, 0xb5, 0x90, 0x45, 0x29, 0xd6, 0xff, 0x7e, 0x04, 0xb7, 0xa9, 0xc0, 0xe6, 0x7d,
-	0xfa, 0x05, 0xaf, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xff, 0xe5, 0x90, 0xe2, 0xc8, 0x02, 0x00,
-	0x00,
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xdd, 0x4e, 0x1b, 0x47,
+	0x14, 0xb6, 0x13, 0x63, 0x60, 0x30, 0x36, 0x19, 0x21, 0x75, 0x21, 0xa9, 0x71, 0xe8, 0x1f, 0x8d,
+	0x54, 0x6f, 0x42, 0xa5, 0x4a, 0xad, 0xda, 0xaa, 0x38, 0x38, 0x40, 0x42, 0x80, 0xac, 0x81, 0x8b,
+	0xde, 0xac, 0xc6, 0xeb, 0x61, 0x3d, 0x65, 0x77, 0x67, 0x99, 0x19, 0xbb, 0x6c, 0x9e, 0xa0, 0x97,
+	0x7d, 0x85, 0xaa, 0x4f, 0xd1, 0x37, 0xc8, 0x45, 0x2f, 0x72, 0xd9, 0xab, 0xaa, 0x82, 0x17, 0xa9,
+	0xe6, 0xcc, 0x18, 0xbc, 0xb8, 0xee, 0x15, 0xf8, 0x9b, 0xef, 0xfb, 0xe6, 0x9c, 0x39, 0x3f, 0x8b,
+	0xea, 0x6f, 0xc3, 0xc0, 0xed, 0x11, 0xc9, 0xc2, 0x84, 0x0a, 0xe9, 0x0e, 0x9f, 0xb9, 0x21, 0x4d,
+	0xa8, 0x64, 0xb2, 0x99, 0x0a, 0xae, 0x38, 0x5e, 0x7a, 0x1b, 0x06, 0xcd, 0x9b, 0xf3, 0xe6, 0xf0,
+	0xd9, 0xea, 0x4a, 0xc0, 0x65, 0xcc, 0xa5, 0x0f, 0xe7, 0xae, 0xf9, 0x61, 0xc8, 0xab, 0xcb, 0x21,
+	0x0f, 0xb9, 0xc1, 0xf5, 0x7f, 0x16, 0x5d, 0x09, 0x39, 0x0f, 0x23, 0xea, 0xc2, 0xaf, 0xee, 0xe0,
+	0xcc, 0x25, 0x49, 0x66, 0x8f, 0xd6, 0xee, 0x1e, 0x29, 0x16, 0x53, 0xa9, 0x48, 0x9c, 0x5a, 0x42,
+	0x63, 0x22, 0xbc, 0xdb, 0x58, 0x80, 0xb1, 0xfe, 0xc7, 0x0c, 0x2a, 0x1f, 0x11, 0x41, 0x62, 0x89,
+	0x3f, 0x45, 0x35, 0xc5, 0xcf, 0x69, 0x22, 0xfd, 0x94, 0x0a, 0x7f, 0xc8, 0x15, 0x75, 0x8a, 0x8d,
+	0xe2, 0x46, 0xc9, 0x5b, 0x34, 0xf0, 0x11, 0x15, 0xa7, 0x5c, 0x51, 0xec, 0xa2, 0xe5, 0x98, 0x5c,
+	0x02, 0xc1, 0x50, 0x8d, 0xa3, 0x73, 0x0f, 0xc8, 0x0f, 0x62, 0x72, 0xa9, 0x69, 0x9a, 0xde, 0x81,
+	0x03, 0xbc, 0x86, 0x16, 0xb4, 0xe0, 0x62, 0xc0, 0xc5, 0x20, 0x96, 0xce, 0x7d, 0xe0, 0xa1, 0x98,
+	0x5c, 0xbe, 0x31, 0x08, 0x7e, 0x8c, 0x2a, 0x34, 0xe5, 0x41, 0xdf, 0xef, 0x46, 0x3c, 0x38, 0x97,
+	0x4e, 0x09, 0x18, 0x0b, 0x80, 0xb5, 0x00, 0xc2, 0x9f, 0xa0, 0x2a, 0x4d, 0x02, 0xde, 0xa3, 0x3d,
+	0x5f, 0x46, 0x2c, 0xa0, 0xd2, 0x99, 0x31, 0xb1, 0x59, 0xb4, 0x03, 0xa0, 0xbe, 0xea, 0x27, 0xc2,
+	0x22, 0x1f, 0xa4, 0xd2, 0x29, 0x9b, 0xab, 0x34, 0xd4, 0x06, 0x04, 0x7f, 0x8e, 0x96, 0x62, 0x96,
+	0xf8, 0x44, 0x29, 0xfd, 0x50, 0x8a, 0xf1, 0x44, 0x3a, 0xb3, 0xc0, 0xaa, 0xc5, 0x2c, 0xd9, 0x1a,
+	0x83, 0xf1, 0xc7, 0xa8, 0xaa, 0xa9, 0x90, 0x5d, 0xcf, 0xef, 0xa6, 0xd2, 0x99, 0x03, 0x62, 0x25,
+	0x66, 0x09, 0x64, 0xd6, 0x6b, 0xa5, 0x12, 0x7f, 0x83, 0x56, 0xc6, 0xcc, 0x7c, 0xd5, 0x17, 0x54,
+	0xf6, 0x79, 0x64, 0x04, 0xf3, 0x20, 0xf8, 0x60, 0x8c, 0x70, 0x3c, 0x3a, 0xd7, 0xda, 0xef, 0xd0,
+	0x43, 0x19, 0x11, 0xd9, 0xf7, 0xcf, 0x04, 0x09, 0x40, 0xde, 0xe3, 0x3f, 0x27, 0xba, 0x88, 0xa0,
+	0x46, 0xa0, 0x76, 0x80, 0xf2, 0xc2, 0x32, 0xb6, 0x2d, 0x41, 0xcb, 0xdb, 0x68, 0xed, 0x8e, 0x9c,
+	0x5e, 0x0c, 0xd8, 0x90, 0x07, 0x26, 0x14, 0x6d, 0xb1, 0x00, 0x16, 0x8f, 0x72, 0x16, 0xed, 0x31,
+	0x92, 0xb6, 0xf9, 0x0c, 0xd5, 0xcc, 0xeb, 0x0b, 0xaa, 0x68, 0xa2, 0x51, 0xa7, 0x02, 0xb2, 0x2a,
+	0xc0, 0xde, 0x08, 0xc5, 0xfb, 0x68, 0xc9, 0xd4, 0xd0, 0x97, 0x34, 0xa2, 0xe0, 0xe5, 0x2c, 0x36,
+	0x8a, 0x1b, 0xd5, 0xcd, 0xc7, 0xcd, 0xbb, 0x7d, 0xde, 0x34, 0xb5, 0xed, 0x8c, 0x88, 0x5e, 0xed,
+	0x22, 0x0f, 0xe0, 0x1f, 0x10, 0x0a, 0x89, 0xf4, 0x53, 0x68, 0x3e, 0xa7, 0xda, 0x28, 0x6e, 0x2c,
+	0x6c, 0x3e, 0x9c, 0xf4, 0xd9, 0x21, 0xd2, 0xf4, 0x67, 0xab, 0xf4, 0xee, 0xef, 0xb5, 0x82, 0x37,
+	0x1f, 0x8e, 0x80, 0xf5, 0xdf, 0x8a, 0x68, 0xfe, 0xe6, 0x58, 0x97, 0x5e, 0x37, 0x23, 0x09, 0x02,
+	0x3e, 0x48, 0x94, 0x6d, 0x5d, 0x94, 0x52, 0xb1, 0x65, 0x10, 0xdd, 0xdf, 0x9a, 0xd0, 0x65, 0x2a,
+	0x26, 0xa9, 0xdf, 0xcd, 0x14, 0xb5, 0x2d, 0xbb, 0x98, 0x52, 0xd1, 0x02, 0xb4, 0x95, 0x29, 0x8a,
+	0x9f, 0xa0, 0x07, 0x9a, 0x67, 0x53, 0x8d, 0x69, 0xdc, 0xa5, 0xc2, 0x36, 0xad, 0x36, 0x30, 0x89,
+	0xbd, 0x06, 0x78, 0x74, 0x69, 0x4a, 0x98, 0x60, 0x49, 0xe8, 0x94, 0x6e, 0x2e, 0x3d, 0x32, 0xc8,
+	0xfa, 0x9f, 0x65, 0x54, 0xd9, 0x31, 0x2b, 0xa1, 0xa3, 0x88, 0xa2, 0xf8, 0x2b, 0x54, 0xb6, 0x29,
+	0x17, 0x21, 0x65, 0x67, 0x32, 0xe5, 0x5c, 0xbe, 0x96, 0x7d, 0x3b, 0x23, 0xc9, 0x00, 0x02, 0xba,
+	0x37, 0x36, 0x23, 0x07, 0x00, 0xe1, 0x4d, 0x34, 0x6b, 0x5d, 0x9c, 0xfb, 0x8d, 0xfb, 0xff, 0xed,
+	0x6d, 0x46, 0xd2, 0x1b, 0x11, 0xf1, 0xf3, 0x51, 0x4d, 0xa5, 0xdf, 0xcd, 0xcc, 0xd8, 0x38, 0x25,
+	0x10, 0xaf, 0x4c, 0xab, 0xa9, 0xf4, 0xaa, 0x56, 0xd2, 0xca, 0x60, 0xaa, 0xf0, 0x31, 0x5a, 0x36,
+	0x2c, 0xff, 0x9c, 0x66, 0x7e, 0x9f, 0x49, 0xc5, 0x05, 0x83, 0x11, 0xd5, 0x46, 0xeb, 0xd3, 0xa2,
+	0x78, 0x45, 0xb3, 0x5d, 0xe0, 0x66, 0x1e, 0x96, 0x79, 0x84, 0x51, 0x89, 0x5f, 0xa2, 0x5a, 0x8f,
+	0x0a, 0x1a, 0x32, 0xa9, 0x84, 0x9d, 0xd4, 0x32, 0x18, 0x36, 0x26, 0x0d, 0xb7, 0x73, 0x44, 0xef,
+	0xae, 0x10, 0x6f, 0xa2, 0x19, 0xbd, 0x04, 0xf4, 0xac, 0x6b, 0x87, 0x47, 0xd3, 0x42, 0x7a, 0x49,
+	0x58, 0xe4, 0x19, 0x2a, 0xfe, 0x16, 0xcd, 0x45, 0x6c, 0xa8, 0x6b, 0xa7, 0x27, 0x7f, 0xca, 0xc5,
+	0x46, 0xb6, 0x6f, 0x79, 0xde, 0x8d, 0x02, 0x16, 0x16, 0x11, 0x11, 0xa3, 0x52, 0xd9, 0x67, 0x9d,
+	0xb7, 0x0b, 0xcb, 0xa2, 0xe6, 0xe9, 0xb6, 0xd1, 0x62, 0x3e, 0x45, 0x04, 0x37, 0xd5, 0x27, 0x6f,
+	0xf2, 0xc6, 0x13, 0xcc, 0x8b, 0xf0, 0xd7, 0x68, 0x6e, 0x28, 0xce, 0xf4, 0xeb, 0xeb, 0x91, 0x9f,
+	0x62, 0x60, 0x42, 0x3d, 0x15, 0x67, 0xaf, 0x68, 0xe6, 0xcd, 0x0e, 0xe1, 0x2f, 0x2c, 0x44, 0x3b,
+	0xfd, 0x24, 0xe9, 0xf1, 0x18, 0xb2, 0xd5, 0xe3, 0x5f, 0xf1, 0xcc, 0x56, 0xf0, 0x6e, 0x60, 0xfc,
+	0x3d, 0x9a, 0xe7, 0x29, 0x15, 0x44, 0x71, 0x21, 0x9d, 0xc5, 0xff, 0x7f, 0x91, 0x43, 0x4b, 0xf4,
+	0x6e, 0x25, 0x78, 0x0b, 0x55, 0x72, 0x7b, 0xb7, 0x0a, 0x16, 0x1f, 0x4e, 0x5a, 0x8c, 0xad, 0x61,
+	0x2f, 0x27, 0x79, 0x72, 0x8a, 0x6a, 0x77, 0x16, 0x0b, 0xfe, 0x08, 0xad, 0xbd, 0x39, 0x39, 0xf4,
+	0x4e, 0x5e, 0xfb, 0x9d, 0xf6, 0x7e, 0xfb, 0xf9, 0xf1, 0xde, 0xe1, 0x81, 0xdf, 0xd9, 0xdb, 0x39,
+	0xd8, 0x3a, 0x3e, 0xf1, 0xda, 0xfe, 0xee, 0x56, 0x67, 0x77, 0xa9, 0x80, 0x1d, 0xb4, 0x3c, 0x41,
+	0x3a, 0xf5, 0x5e, 0x2c, 0x15, 0x57, 0x4b, 0xbf, 0xfc, 0x5e, 0x2f, 0xb4, 0xf6, 0xde, 0x5d, 0xd5,
+	0x8b, 0xef, 0xaf, 0xea, 0xc5, 0x7f, 0xae, 0xea, 0xc5, 0x5f, 0xaf, 0xeb, 0x85, 0xf7, 0xd7, 0xf5,
+	0xc2, 0x5f, 0xd7, 0xf5, 0xc2, 0x8f, 0x6e, 0xc8, 0x54, 0x7f, 0xd0, 0x6d, 0x06, 0x3c, 0x76, 0x9f,
+	0x86, 0x11, 0xe9, 0x4a, 0xf7, 0x69, 0xf8, 0x45, 0xd0, 0x27, 0x2c, 0x71, 0x2f, 0xf3, 0xdf, 0x56,
+	0x95, 0xa5, 0x54, 0x76, 0xcb, 0xf0, 0x61, 0xfd, 0xf2, 0xdf, 0x01, 0x00, 0x29, 0xef, 0x51, 0x17,
+	0x1b, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -234,6 +549,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.GasParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.QuorumSelection != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.QuorumSelection))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.EpochRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochRetention))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SlashFractionEquivocationBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashFractionEquivocationBps))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SlashFractionDowntimeBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashFractionDowntimeBps))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AttestationThresholdBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationThresholdBps))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MinSignedBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinSignedBps))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinAttestations != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinAttestations))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.JailEpochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.JailEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.EncodedSlices != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.EncodedSlices))
 		i--
@@ -262,6 +627,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerPairing != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PerPairing))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PerQuorumMember != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PerQuorumMember))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PerBitmapByte != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PerBitmapByte))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerAccount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PerAccount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -282,10 +690,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.QuorumsByEpoch) > 0 {
-		for iNdEx := len(m.QuorumsByEpoch) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.QuorumsByEpoch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -293,13 +701,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x72
 		}
 	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -307,41 +715,165 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x6a
 		}
 	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+	if len(m.EpochRandomness) > 0 {
+		i -= len(m.EpochRandomness)
+		copy(dAtA[i:], m.EpochRandomness)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EpochRandomness)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x62
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.VrfKeys) > 0 {
+		for iNdEx := len(m.VrfKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VrfKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.Registrations) > 0 {
+		for iNdEx := len(m.Registrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Registrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.EarliestEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EarliestEpoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Liveness) > 0 {
+		for iNdEx := len(m.Liveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Jails) > 0 {
+		for iNdEx := len(m.Jails) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jails[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Deregistrations) > 0 {
+		for iNdEx := len(m.Deregistrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deregistrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SignerKeyHistories) > 0 {
+		for iNdEx := len(m.SignerKeyHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerKeyHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.QuorumsByEpoch) > 0 {
+		for iNdEx := len(m.QuorumsByEpoch) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuorumsByEpoch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -360,6 +892,53 @@ func (m *Params) Size() (n int) {
 	if m.EncodedSlices != 0 {
 		n += 1 + sovGenesis(uint64(m.EncodedSlices))
 	}
+	if m.JailEpochs != 0 {
+		n += 1 + sovGenesis(uint64(m.JailEpochs))
+	}
+	if m.MinAttestations != 0 {
+		n += 1 + sovGenesis(uint64(m.MinAttestations))
+	}
+	if m.MinSignedBps != 0 {
+		n += 1 + sovGenesis(uint64(m.MinSignedBps))
+	}
+	if m.AttestationThresholdBps != 0 {
+		n += 1 + sovGenesis(uint64(m.AttestationThresholdBps))
+	}
+	if m.SlashFractionDowntimeBps != 0 {
+		n += 1 + sovGenesis(uint64(m.SlashFractionDowntimeBps))
+	}
+	if m.SlashFractionEquivocationBps != 0 {
+		n += 1 + sovGenesis(uint64(m.SlashFractionEquivocationBps))
+	}
+	if m.EpochRetention != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochRetention))
+	}
+	if m.QuorumSelection != 0 {
+		n += 1 + sovGenesis(uint64(m.QuorumSelection))
+	}
+	l = m.GasParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GasParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerAccount != 0 {
+		n += 1 + sovGenesis(uint64(m.PerAccount))
+	}
+	if m.PerBitmapByte != 0 {
+		n += 1 + sovGenesis(uint64(m.PerBitmapByte))
+	}
+	if m.PerQuorumMember != 0 {
+		n += 1 + sovGenesis(uint64(m.PerQuorumMember))
+	}
+	if m.PerPairing != 0 {
+		n += 1 + sovGenesis(uint64(m.PerPairing))
+	}
 	return n
 }
 
@@ -386,6 +965,61 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SignerKeyHistories) > 0 {
+		for _, e := range m.SignerKeyHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Deregistrations) > 0 {
+		for _, e := range m.Deregistrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Jails) > 0 {
+		for _, e := range m.Jails {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Liveness) > 0 {
+		for _, e := range m.Liveness {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EarliestEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.EarliestEpoch))
+	}
+	if len(m.Registrations) > 0 {
+		for _, e := range m.Registrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VrfKeys) > 0 {
+		for _, e := range m.VrfKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.EpochRandomness)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Operators) > 0 {
+		for _, e := range m.Operators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -519,61 +1153,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailEpochs", wireType)
 			}
-			var msglen int
+			m.JailEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -583,30 +1167,35 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.JailEpochs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAttestations", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MinAttestations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAttestations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedBps", wireType)
 			}
-			m.EpochNumber = 0
+			m.MinSignedBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -616,16 +1205,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
+				m.MinSignedBps |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationThresholdBps", wireType)
 			}
-			var msglen int
+			m.AttestationThresholdBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -635,29 +1224,299 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AttestationThresholdBps |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntimeBps", wireType)
 			}
-			if postIndex > l {
+			m.SlashFractionDowntimeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashFractionDowntimeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEquivocationBps", wireType)
+			}
+			m.SlashFractionEquivocationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashFractionEquivocationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRetention", wireType)
+			}
+			m.EpochRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumSelection", wireType)
+			}
+			m.QuorumSelection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumSelection |= QuorumSelection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, &Signer{})
-			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GasParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerAccount", wireType)
+			}
+			m.PerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerAccount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerBitmapByte", wireType)
+			}
+			m.PerBitmapByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerBitmapByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerQuorumMember", wireType)
+			}
+			m.PerQuorumMember = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerQuorumMember |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerPairing", wireType)
+			}
+			m.PerPairing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerPairing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumsByEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -684,8 +1543,419 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuorumsByEpoch = append(m.QuorumsByEpoch, &Quorums{})
-			if err := m.QuorumsByEpoch[len(m.QuorumsByEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &Signer{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumsByEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumsByEpoch = append(m.QuorumsByEpoch, &Quorums{})
+			if err := m.QuorumsByEpoch[len(m.QuorumsByEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerKeyHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerKeyHistories = append(m.SignerKeyHistories, &SignerKeyHistory{})
+			if err := m.SignerKeyHistories[len(m.SignerKeyHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deregistrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deregistrations = append(m.Deregistrations, &Deregistration{})
+			if err := m.Deregistrations[len(m.Deregistrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jails = append(m.Jails, &SignerJail{})
+			if err := m.Jails[len(m.Jails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liveness = append(m.Liveness, &SignerLiveness{})
+			if err := m.Liveness[len(m.Liveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestEpoch", wireType)
+			}
+			m.EarliestEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarliestEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrations = append(m.Registrations, &Registration{})
+			if err := m.Registrations[len(m.Registrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VrfKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VrfKeys = append(m.VrfKeys, &SignerVrfKey{})
+			if err := m.VrfKeys[len(m.VrfKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRandomness", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochRandomness = append(m.EpochRandomness[:0], dAtA[iNdEx:postIndex]...)
+			if m.EpochRandomness == nil {
+				m.EpochRandomness = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, &SignerOperator{})
+			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -41,3 +41,46 @@ func EpochRegistrationHash(operatorAddress common.Address, epoch uint64, chainId
 	// hash to G1
 	return bn254util.MapToCurve(msgHash32)
 }
+
+func KeyRotationHash(operatorAddress common.Address, pubkeyG1 []byte, pubkeyG2 []byte, epoch uint64, chainId *big.Int) [32]byte {
+	toHash := make([]byte, 0)
+	toHash = append(toHash, operatorAddress.Bytes()...)
+	toHash = append(toHash, pubkeyG1...)
+	toHash = append(toHash, pubkeyG2...)
+	toHash = append(toHash, sdk.Uint64ToBigEndian(epoch)...)
+	toHash = append(toHash, common.LeftPadBytes(chainId.Bytes(), 32)...)
+	toHash = append(toHash, []byte("0G_BN254_Pubkey_Rotation")...)
+
+	msgHash := crypto.Keccak256(toHash)
+	// convert to [32]byte
+	var msgHash32 [32]byte
+	copy(msgHash32[:], msgHash)
+	return msgHash32
+}
+
+func BlobAttestationHash(blobId []byte, commitment []byte, epoch uint64, quorumId uint64, chainId *big.Int) [32]byte {
+	toHash := make([]byte, 0)
+	toHash = append(toHash, blobId...)
+	toHash = append(toHash, commitment...)
+	toHash = append(toHash, sdk.Uint64ToBigEndian(epoch)...)
+	toHash = append(toHash, sdk.Uint64ToBigEndian(quorumId)...)
+	toHash = append(toHash, common.LeftPadBytes(chainId.Bytes(), 32)...)
+	toHash = append(toHash, []byte("0G_DA_Blob_Attestation")...)
+
+	msgHash := crypto.Keccak256(toHash)
+	// convert to [32]byte
+	var msgHash32 [32]byte
+	copy(msgHash32[:], msgHash)
+	return msgHash32
+}
+
+// EpochVrfSeed returns the input of the VRF of the registrations for an epoch,
+// which is seeded with the randomness of the previous epoch.
+func EpochVrfSeed(randomness []byte, epoch uint64, chainId *big.Int) []byte {
+	toHash := make([]byte, 0)
+	toHash = append(toHash, common.LeftPadBytes(randomness, 32)...)
+	toHash = append(toHash, sdk.Uint64ToBigEndian(epoch)...)
+	toHash = append(toHash, common.LeftPadBytes(chainId.Bytes(), 32)...)
+	toHash = append(toHash, []byte("0G_DA_Epoch_Vrf_Seed")...)
+	return crypto.Keccak256(toHash)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -19,14 +20,27 @@ const (
 
 var (
 	// prefix
-	SignerKeyPrefix       = []byte{0x00}
-	EpochQuorumsKeyPrefix = []byte{0x01}
-	RegistrationKeyPrefix = []byte{0x02}
-	QuorumCountKeyPrefix  = []byte{0x03}
+	SignerKeyPrefix           = []byte{0x00}
+	EpochQuorumsKeyPrefix     = []byte{0x01}
+	RegistrationKeyPrefix     = []byte{0x02}
+	QuorumCountKeyPrefix      = []byte{0x03}
+	SignerKeyHistoryKeyPrefix = []byte{0x04}
+	DeregistrationKeyPrefix   = []byte{0x07}
+	JailKeyPrefix             = []byte{0x08}
+	LivenessKeyPrefix         = []byte{0x09}
+	AttestationKeyPrefix      = []byte{0x0a}
+	DelegatorBondedKeyPrefix  = []byte{0x0c}
+	DelegationBondedKeyPrefix = []byte{0x0d}
+	ValidatorBondedKeyPrefix  = []byte{0x0e}
+	VrfKeyKeyPrefix           = []byte{0x0f}
+	RandomnessKeyPrefix       = []byte{0x10}
+	VrfOutputKeyPrefix        = []byte{0x11}
+	SignerOperatorKeyPrefix   = []byte{0x12}
 
 	// keys
-	ParamsKey      = []byte{0x05}
-	EpochNumberKey = []byte{0x06}
+	ParamsKey        = []byte{0x05}
+	EpochNumberKey   = []byte{0x06}
+	EarliestEpochKey = []byte{0x0b}
 )
 
 func GetSignerKeyFromAccount(account string) ([]byte, error) {
@@ -50,3 +64,79 @@ func GetEpochRegistrationKeyPrefix(epoch uint64) []byte {
 func GetRegistrationKey(account string) ([]byte, error) {
 	return hex.DecodeString(account)
 }
+
+func GetSignerKeyHistoryKeyPrefix(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetSignerKeyHistoryKey(account string, epoch uint64) ([]byte, error) {
+	b, err := hex.DecodeString(account)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, sdk.Uint64ToBigEndian(epoch)...), nil
+}
+
+func GetDeregistrationKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetJailKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetEpochLivenessKeyPrefix(epoch uint64) []byte {
+	return append(LivenessKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func GetLivenessKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetEpochAttestationKeyPrefix(epoch uint64) []byte {
+	return append(AttestationKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func GetAttestationKey(quorumId uint64, blobId []byte) []byte {
+	return append(sdk.Uint64ToBigEndian(quorumId), blobId...)
+}
+
+func GetVrfKeyKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetSignerOperatorKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetRandomnessKey(epoch uint64) []byte {
+	return sdk.Uint64ToBigEndian(epoch)
+}
+
+func GetEpochVrfOutputKeyPrefix(epoch uint64) []byte {
+	return append(VrfOutputKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func GetVrfOutputKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetDelegatorBondedKey(delegator sdk.AccAddress) []byte {
+	return append(DelegatorBondedKeyPrefix, address.MustLengthPrefix(delegator)...)
+}
+
+func GetDelegationBondedKeyPrefix(delegator sdk.AccAddress) []byte {
+	return append(DelegationBondedKeyPrefix, address.MustLengthPrefix(delegator)...)
+}
+
+func GetDelegationBondedKey(delegator sdk.AccAddress, validator sdk.ValAddress) []byte {
+	return append(GetDelegationBondedKeyPrefix(delegator), address.MustLengthPrefix(validator)...)
+}
+
+func GetValidatorBondedKeyPrefix(validator sdk.ValAddress) []byte {
+	return append(ValidatorBondedKeyPrefix, address.MustLengthPrefix(validator)...)
+}
+
+func GetValidatorBondedKey(validator sdk.ValAddress, delegator sdk.AccAddress) []byte {
+	return append(GetValidatorBondedKeyPrefix(validator), address.MustLengthPrefix(delegator)...)
+}
@@ -1,17 +1,19 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	fmt "fmt"
 
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/0glabs/0g-chain/crypto/bn254util"
+	"github.com/coniks-sys/coniks-go/crypto/vrf"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _, _, _, _ sdk.Msg = &MsgRegisterSigner{}, &MsgUpdateSocket{}, &MsgRegisterNextEpoch{}, &MsgChangeParams{}
+var _, _, _, _, _, _, _, _, _, _ sdk.Msg = &MsgRegisterSigner{}, &MsgUpdateSocket{}, &MsgRegisterNextEpoch{}, &MsgChangeParams{}, &MsgRotateSignerKey{}, &MsgDeregisterSigner{}, &MsgSubmitAttestation{}, &MsgSubmitEquivocation{}, &MsgSetVrfKey{}, &MsgSetOperator{}
 
 // GetSigners returns the expected signers for a MsgRegisterSigner message.
 func (msg *MsgRegisterSigner) GetSigners() []sdk.AccAddress {
@@ -31,6 +33,9 @@ func (msg *MsgRegisterSigner) ValidateBasic() error {
 	if err := msg.Signer.Validate(); err != nil {
 		return err
 	}
+	if err := validateSocketAndEndpoints(msg.Signer.Socket, msg.Signer.Endpoints); err != nil {
+		return err
+	}
 	if len(msg.Signature) != bn254util.G1PointSize {
 		return fmt.Errorf("invalid signature")
 	}
@@ -42,9 +47,9 @@ func (msg MsgRegisterSigner) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
 }
 
-// GetSigners returns the expected signers for a MsgUpdateSocket message.
+// GetSigners returns the expected signers for a MsgUpdateSocket message, which is the operator if set.
 func (msg *MsgUpdateSocket) GetSigners() []sdk.AccAddress {
-	valAddr, err := sdk.ValAddressFromHex(msg.Account)
+	valAddr, err := sdk.ValAddressFromHex(senderOf(msg.Account, msg.Operator))
 	if err != nil {
 		panic(err)
 	}
@@ -60,6 +65,12 @@ func (msg *MsgUpdateSocket) ValidateBasic() error {
 	if err := ValidateHexAddress(msg.Account); err != nil {
 		return err
 	}
+	if err := validateOperator(msg.Account, msg.Operator); err != nil {
+		return err
+	}
+	if err := validateSocketAndEndpoints(msg.Socket, msg.Endpoints); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -68,9 +79,9 @@ func (msg MsgUpdateSocket) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
 }
 
-// GetSigners returns the expected signers for a MsgRegisterNextEpoch message.
+// GetSigners returns the expected signers for a MsgRegisterNextEpoch message, which is the operator if set.
 func (msg *MsgRegisterNextEpoch) GetSigners() []sdk.AccAddress {
-	valAddr, err := sdk.ValAddressFromHex(msg.Account)
+	valAddr, err := sdk.ValAddressFromHex(senderOf(msg.Account, msg.Operator))
 	if err != nil {
 		panic(err)
 	}
@@ -86,9 +97,17 @@ func (msg *MsgRegisterNextEpoch) ValidateBasic() error {
 	if err := ValidateHexAddress(msg.Account); err != nil {
 		return err
 	}
+	if err := validateOperator(msg.Account, msg.Operator); err != nil {
+		return err
+	}
 	if len(msg.Signature) != bn254util.G1PointSize {
 		return fmt.Errorf("invalid signature")
 	}
+	if len(msg.VrfOutput) != 0 || len(msg.VrfProof) != 0 {
+		if len(msg.VrfOutput) != vrf.Size || len(msg.VrfProof) != vrf.ProofSize {
+			return ErrInvalidVrfProof
+		}
+	}
 	return nil
 }
 
@@ -97,6 +116,184 @@ func (msg MsgRegisterNextEpoch) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
 }
 
+// GetSigners returns the expected signers for a MsgRotateSignerKey message.
+func (msg *MsgRotateSignerKey) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Account)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgRotateSignerKey) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Account); err != nil {
+		return err
+	}
+	if len(msg.PubkeyG1) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid G1 pubkey length")
+	}
+	if len(msg.PubkeyG2) != bn254util.G2PointSize {
+		return fmt.Errorf("invalid G2 pubkey length")
+	}
+	if len(msg.NewKeySignature) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid new key signature")
+	}
+	if len(msg.OldKeySignature) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid old key signature")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRotateSignerKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgDeregisterSigner message.
+func (msg *MsgDeregisterSigner) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Account)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgDeregisterSigner) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Account); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgDeregisterSigner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitAttestation message.
+func (msg *MsgSubmitAttestation) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Submitter)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgSubmitAttestation) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Submitter); err != nil {
+		return err
+	}
+	if len(msg.BlobId) != 32 {
+		return fmt.Errorf("invalid blob id length")
+	}
+	if len(msg.Commitment) == 0 {
+		return fmt.Errorf("empty commitment")
+	}
+	if len(msg.QuorumBitmap) == 0 {
+		return fmt.Errorf("empty quorum bitmap")
+	}
+	if len(msg.AggregatePubkeyG2) != bn254util.G2PointSize {
+		return fmt.Errorf("invalid G2 aggregate pubkey length")
+	}
+	if len(msg.AggregateSignature) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid aggregate signature")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSubmitAttestation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitEquivocation message.
+func (msg *MsgSubmitEquivocation) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Submitter)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgSubmitEquivocation) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Submitter); err != nil {
+		return err
+	}
+	if err := ValidateHexAddress(msg.Account); err != nil {
+		return err
+	}
+	if len(msg.BlobId) != 32 {
+		return fmt.Errorf("invalid blob id length")
+	}
+	if len(msg.CommitmentA) == 0 || len(msg.CommitmentB) == 0 {
+		return fmt.Errorf("empty commitment")
+	}
+	if bytes.Equal(msg.CommitmentA, msg.CommitmentB) {
+		return fmt.Errorf("identical commitments")
+	}
+	if len(msg.SignatureA) != bn254util.G1PointSize || len(msg.SignatureB) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSubmitEquivocation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgSetVrfKey message, which is the operator if set.
+func (msg *MsgSetVrfKey) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(senderOf(msg.Account, msg.Operator))
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgSetVrfKey) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Account); err != nil {
+		return err
+	}
+	if err := validateOperator(msg.Account, msg.Operator); err != nil {
+		return err
+	}
+	if len(msg.Pubkey) != vrf.PublicKeySize {
+		return fmt.Errorf("invalid vrf pubkey")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetVrfKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
 // GetSigners returns the expected signers for a MsgSetParams message.
 func (msg *MsgChangeParams) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
@@ -115,3 +312,51 @@ func (msg *MsgChangeParams) ValidateBasic() error {
 
 	return nil
 }
+
+// GetSigners returns the expected signers for a MsgSetOperator message.
+func (msg *MsgSetOperator) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Account)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgSetOperator) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Account); err != nil {
+		return err
+	}
+	return validateOperator(msg.Account, msg.Operator)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// senderOf returns the operator if set, otherwise the account.
+func senderOf(account string, operator string) string {
+	if operator != "" {
+		return operator
+	}
+	return account
+}
+
+// validateOperator checks the optional operator is a hex address other than the account.
+func validateOperator(account string, operator string) error {
+	if operator == "" {
+		return nil
+	}
+	if err := ValidateHexAddress(operator); err != nil {
+		return errorsmod.Wrap(ErrInvalidOperator, err.Error())
+	}
+	if operator == account {
+		return errorsmod.Wrap(ErrInvalidOperator, "operator is the signer itself")
+	}
+	return nil
+}
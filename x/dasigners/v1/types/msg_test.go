@@ -44,6 +44,15 @@ func (suite *MsgTestSuite) Test_MsgUpdateSocket() {
 	suite.Assert().EqualValues(len(msg.GetSigners()), 1)
 	suite.Assert().EqualValues(msg.GetSigners()[0].String(), "0g1j6zuf6efxzvzpnwxye3ucmxg9u7596ty686hna")
 	suite.Assert().NoError(msg.ValidateBasic())
+
+	msg.Socket = "0.0.0.0"
+	suite.Assert().ErrorIs(msg.ValidateBasic(), types.ErrInvalidEndpoint)
+	msg.Socket = ""
+	suite.Assert().ErrorIs(msg.ValidateBasic(), types.ErrInvalidEndpoint)
+	msg.Endpoints = []*types.SignerEndpoint{{Kind: types.ENDPOINT_KIND_DISPERSAL, Protocol: "grpc", Host: "da.example.com", Port: 9000}}
+	suite.Assert().NoError(msg.ValidateBasic())
+	msg.Endpoints[0].Protocol = "ftp"
+	suite.Assert().ErrorIs(msg.ValidateBasic(), types.ErrInvalidEndpoint)
 }
 
 func (suite *MsgTestSuite) Test_MsgRegisterNextEpoch() {
@@ -1,8 +1,38 @@
 package types
 
+// BasisPoints defines the denominator of the ratios in params
+const BasisPoints = 10000
+
+// DefaultGasParams returns the gas params charging the precompile calls in proportion to their work,
+// the pairing check of two pairs is priced as the EIP-1108 precompile.
+func DefaultGasParams() GasParams {
+	return GasParams{
+		PerAccount:      5000,
+		PerBitmapByte:   100,
+		PerQuorumMember: 1500,
+		PerPairing:      113000,
+	}
+}
+
 func (p *Params) Validate() error {
 	if p.EpochBlocks == 0 {
 		return ErrInvalidEpochBlocks
 	}
+	if _, ok := QuorumSelection_name[int32(p.QuorumSelection)]; !ok {
+		return ErrInvalidQuorumSelection
+	}
+	for _, bps := range []uint64{p.MinSignedBps, p.AttestationThresholdBps, p.SlashFractionDowntimeBps, p.SlashFractionEquivocationBps} {
+		if bps > BasisPoints {
+			return ErrInvalidBasisPoints
+		}
+	}
 	return nil
 }
+
+// EarliestRetainedEpoch returns the first epoch inside the retention window ending at the given epoch
+func (p *Params) EarliestRetainedEpoch(epochNumber uint64) uint64 {
+	if p.EpochRetention == 0 || epochNumber < p.EpochRetention {
+		return 0
+	}
+	return epochNumber - p.EpochRetention + 1
+}
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -179,21 +180,22 @@ func (m *QuerySignerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySignerResponse proto.InternalMessageInfo
 
-type QueryEpochNumberRequest struct {
+type QuerySignersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEpochNumberRequest) Reset()         { *m = QueryEpochNumberRequest{} }
-func (m *QueryEpochNumberRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEpochNumberRequest) ProtoMessage()    {}
-func (*QueryEpochNumberRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySignersRequest) Reset()         { *m = QuerySignersRequest{} }
+func (m *QuerySignersRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignersRequest) ProtoMessage()    {}
+func (*QuerySignersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_991a610b84b5964c, []int{4}
 }
-func (m *QueryEpochNumberRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySignersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEpochNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySignersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEpochNumberRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySignersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -203,34 +205,35 @@ func (m *QueryEpochNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryEpochNumberRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEpochNumberRequest.Merge(m, src)
+func (m *QuerySignersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignersRequest.Merge(m, src)
 }
-func (m *QueryEpochNumberRequest) XXX_Size() int {
+func (m *QuerySignersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEpochNumberRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEpochNumberRequest.DiscardUnknown(m)
+func (m *QuerySignersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEpochNumberRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySignersRequest proto.InternalMessageInfo
 
-type QueryEpochNumberResponse struct {
-	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+type QuerySignersResponse struct {
+	Signers    []*Signer           `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEpochNumberResponse) Reset()         { *m = QueryEpochNumberResponse{} }
-func (m *QueryEpochNumberResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEpochNumberResponse) ProtoMessage()    {}
-func (*QueryEpochNumberResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySignersResponse) Reset()         { *m = QuerySignersResponse{} }
+func (m *QuerySignersResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignersResponse) ProtoMessage()    {}
+func (*QuerySignersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_991a610b84b5964c, []int{5}
 }
-func (m *QueryEpochNumberResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySignersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEpochNumberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEpochNumberResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySignersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -240,34 +243,35 @@ func (m *QueryEpochNumberResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryEpochNumberResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEpochNumberResponse.Merge(m, src)
+func (m *QuerySignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignersResponse.Merge(m, src)
 }
-func (m *QueryEpochNumberResponse) XXX_Size() int {
+func (m *QuerySignersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEpochNumberResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEpochNumberResponse.DiscardUnknown(m)
+func (m *QuerySignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEpochNumberResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySignersResponse proto.InternalMessageInfo
 
-type QueryQuorumCountRequest struct {
-	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+type QueryEpochRegistrationsRequest struct {
+	EpochNumber uint64             `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryQuorumCountRequest) Reset()         { *m = QueryQuorumCountRequest{} }
-func (m *QueryQuorumCountRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryQuorumCountRequest) ProtoMessage()    {}
-func (*QueryQuorumCountRequest) Descriptor() ([]byte, []int) {
+func (m *QueryEpochRegistrationsRequest) Reset()         { *m = QueryEpochRegistrationsRequest{} }
+func (m *QueryEpochRegistrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochRegistrationsRequest) ProtoMessage()    {}
+func (*QueryEpochRegistrationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_991a610b84b5964c, []int{6}
 }
-func (m *QueryQuorumCountRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochRegistrationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryQuorumCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochRegistrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryQuorumCountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochRegistrationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -277,34 +281,35 @@ func (m *QueryQuorumCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryQuorumCountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryQuorumCountRequest.Merge(m, src)
+func (m *QueryEpochRegistrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochRegistrationsRequest.Merge(m, src)
 }
-func (m *QueryQuorumCountRequest) XXX_Size() int {
+func (m *QueryEpochRegistrationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryQuorumCountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryQuorumCountRequest.DiscardUnknown(m)
+func (m *QueryEpochRegistrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochRegistrationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryQuorumCountRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochRegistrationsRequest proto.InternalMessageInfo
 
-type QueryQuorumCountResponse struct {
-	QuorumCount uint64 `protobuf:"varint,1,opt,name=quorum_count,json=quorumCount,proto3" json:"quorum_count,omitempty"`
+type QueryEpochRegistrationsResponse struct {
+	Registrations []*Registration     `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryQuorumCountResponse) Reset()         { *m = QueryQuorumCountResponse{} }
-func (m *QueryQuorumCountResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryQuorumCountResponse) ProtoMessage()    {}
-func (*QueryQuorumCountResponse) Descriptor() ([]byte, []int) {
+func (m *QueryEpochRegistrationsResponse) Reset()         { *m = QueryEpochRegistrationsResponse{} }
+func (m *QueryEpochRegistrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochRegistrationsResponse) ProtoMessage()    {}
+func (*QueryEpochRegistrationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_991a610b84b5964c, []int{7}
 }
-func (m *QueryQuorumCountResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochRegistrationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryQuorumCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochRegistrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryQuorumCountResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochRegistrationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -314,35 +319,36 @@ func (m *QueryQuorumCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryQuorumCountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryQuorumCountResponse.Merge(m, src)
+func (m *QueryEpochRegistrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochRegistrationsResponse.Merge(m, src)
 }
-func (m *QueryQuorumCountResponse) XXX_Size() int {
+func (m *QueryEpochRegistrationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryQuorumCountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryQuorumCountResponse.DiscardUnknown(m)
+func (m *QueryEpochRegistrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochRegistrationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryQuorumCountResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochRegistrationsResponse proto.InternalMessageInfo
 
-type QueryEpochQuorumRequest struct {
-	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	QuorumId    uint64 `protobuf:"varint,2,opt,name=quorum_id,json=quorumId,proto3" json:"quorum_id,omitempty"`
+type QuerySignerEpochsRequest struct {
+	// account defines the hex address of signer without 0x
+	Account    string             `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEpochQuorumRequest) Reset()         { *m = QueryEpochQuorumRequest{} }
-func (m *QueryEpochQuorumRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEpochQuorumRequest) ProtoMessage()    {}
-func (*QueryEpochQuorumRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySignerEpochsRequest) Reset()         { *m = QuerySignerEpochsRequest{} }
+func (m *QuerySignerEpochsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerEpochsRequest) ProtoMessage()    {}
+func (*QuerySignerEpochsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_991a610b84b5964c, []int{8}
 }
-func (m *QueryEpochQuorumRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySignerEpochsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEpochQuorumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySignerEpochsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEpochQuorumRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySignerEpochsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -352,34 +358,36 @@ func (m *QueryEpochQuorumRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryEpochQuorumRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEpochQuorumRequest.Merge(m, src)
+func (m *QuerySignerEpochsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerEpochsRequest.Merge(m, src)
 }
-func (m *QueryEpochQuorumRequest) XXX_Size() int {
+func (m *QuerySignerEpochsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEpochQuorumRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEpochQuorumRequest.DiscardUnknown(m)
+func (m *QuerySignerEpochsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerEpochsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEpochQuorumRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySignerEpochsRequest proto.InternalMessageInfo
 
-type QueryEpochQuorumResponse struct {
-	Quorum *Quorum `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+type QuerySignerEpochsResponse struct {
+	// epochs defines the epochs the signer has registered for
+	Epochs     []uint64            `protobuf:"varint,1,rep,packed,name=epochs,proto3" json:"epochs,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEpochQuorumResponse) Reset()         { *m = QueryEpochQuorumResponse{} }
-func (m *QueryEpochQuorumResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEpochQuorumResponse) ProtoMessage()    {}
-func (*QueryEpochQuorumResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySignerEpochsResponse) Reset()         { *m = QuerySignerEpochsResponse{} }
+func (m *QuerySignerEpochsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerEpochsResponse) ProtoMessage()    {}
+func (*QuerySignerEpochsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_991a610b84b5964c, []int{9}
 }
-func (m *QueryEpochQuorumResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySignerEpochsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEpochQuorumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySignerEpochsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEpochQuorumResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySignerEpochsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -389,36 +397,34 @@ func (m *QueryEpochQuorumResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryEpochQuorumResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEpochQuorumResponse.Merge(m, src)
+func (m *QuerySignerEpochsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerEpochsResponse.Merge(m, src)
 }
-func (m *QueryEpochQuorumResponse) XXX_Size() int {
+func (m *QuerySignerEpochsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEpochQuorumResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEpochQuorumResponse.DiscardUnknown(m)
+func (m *QuerySignerEpochsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerEpochsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEpochQuorumResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySignerEpochsResponse proto.InternalMessageInfo
 
-type QueryEpochQuorumRowRequest struct {
+type QueryEpochRandomnessRequest struct {
 	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	QuorumId    uint64 `protobuf:"varint,2,opt,name=quorum_id,json=quorumId,proto3" json:"quorum_id,omitempty"`
-	RowIndex    uint32 `protobuf:"varint,3,opt,name=row_index,json=rowIndex,proto3" json:"row_index,omitempty"`
 }
 
-func (m *QueryEpochQuorumRowRequest) Reset()         { *m = QueryEpochQuorumRowRequest{} }
-func (m *QueryEpochQuorumRowRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEpochQuorumRowRequest) ProtoMessage()    {}
-func (*QueryEpochQuorumRowRequest) Descriptor() ([]byte, []int) {
+func (m *QueryEpochRandomnessRequest) Reset()         { *m = QueryEpochRandomnessRequest{} }
+func (m *QueryEpochRandomnessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochRandomnessRequest) ProtoMessage()    {}
+func (*QueryEpochRandomnessRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_991a610b84b5964c, []int{10}
 }
-func (m *QueryEpochQuorumRowRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochRandomnessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEpochQuorumRowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochRandomnessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEpochQuorumRowRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochRandomnessRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -428,34 +434,35 @@ func (m *QueryEpochQuorumRowRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryEpochQuorumRowRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEpochQuorumRowRequest.Merge(m, src)
+func (m *QueryEpochRandomnessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochRandomnessRequest.Merge(m, src)
 }
-func (m *QueryEpochQuorumRowRequest) XXX_Size() int {
+func (m *QueryEpochRandomnessRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEpochQuorumRowRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEpochQuorumRowRequest.DiscardUnknown(m)
+func (m *QueryEpochRandomnessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochRandomnessRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEpochQuorumRowRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochRandomnessRequest proto.InternalMessageInfo
 
-type QueryEpochQuorumRowResponse struct {
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+type QueryEpochRandomnessResponse struct {
+	// randomness defines the randomness aggregated from the VRF outputs of the registrations for the epoch
+	Randomness []byte `protobuf:"bytes,1,opt,name=randomness,proto3" json:"randomness,omitempty"`
 }
 
-func (m *QueryEpochQuorumRowResponse) Reset()         { *m = QueryEpochQuorumRowResponse{} }
-func (m *QueryEpochQuorumRowResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEpochQuorumRowResponse) ProtoMessage()    {}
-func (*QueryEpochQuorumRowResponse) Descriptor() ([]byte, []int) {
+func (m *QueryEpochRandomnessResponse) Reset()         { *m = QueryEpochRandomnessResponse{} }
+func (m *QueryEpochRandomnessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochRandomnessResponse) ProtoMessage()    {}
+func (*QueryEpochRandomnessResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_991a610b84b5964c, []int{11}
 }
-func (m *QueryEpochQuorumRowResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochRandomnessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEpochQuorumRowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochRandomnessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEpochQuorumRowResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochRandomnessResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -465,36 +472,35 @@ func (m *QueryEpochQuorumRowResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryEpochQuorumRowResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEpochQuorumRowResponse.Merge(m, src)
+func (m *QueryEpochRandomnessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochRandomnessResponse.Merge(m, src)
 }
-func (m *QueryEpochQuorumRowResponse) XXX_Size() int {
+func (m *QueryEpochRandomnessResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEpochQuorumRowResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEpochQuorumRowResponse.DiscardUnknown(m)
+func (m *QueryEpochRandomnessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochRandomnessResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEpochQuorumRowResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochRandomnessResponse proto.InternalMessageInfo
 
-type QueryAggregatePubkeyG1Request struct {
-	EpochNumber  uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	QuorumId     uint64 `protobuf:"varint,2,opt,name=quorum_id,json=quorumId,proto3" json:"quorum_id,omitempty"`
-	QuorumBitmap []byte `protobuf:"bytes,3,opt,name=quorum_bitmap,json=quorumBitmap,proto3" json:"quorum_bitmap,omitempty"`
+type QueryVrfKeyRequest struct {
+	// account defines the hex address of signer without 0x
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryAggregatePubkeyG1Request) Reset()         { *m = QueryAggregatePubkeyG1Request{} }
-func (m *QueryAggregatePubkeyG1Request) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePubkeyG1Request) ProtoMessage()    {}
-func (*QueryAggregatePubkeyG1Request) Descriptor() ([]byte, []int) {
+func (m *QueryVrfKeyRequest) Reset()         { *m = QueryVrfKeyRequest{} }
+func (m *QueryVrfKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVrfKeyRequest) ProtoMessage()    {}
+func (*QueryVrfKeyRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_991a610b84b5964c, []int{12}
 }
-func (m *QueryAggregatePubkeyG1Request) XXX_Unmarshal(b []byte) error {
+func (m *QueryVrfKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePubkeyG1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVrfKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePubkeyG1Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVrfKeyRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -504,36 +510,34 @@ func (m *QueryAggregatePubkeyG1Request) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePubkeyG1Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePubkeyG1Request.Merge(m, src)
+func (m *QueryVrfKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVrfKeyRequest.Merge(m, src)
 }
-func (m *QueryAggregatePubkeyG1Request) XXX_Size() int {
+func (m *QueryVrfKeyRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePubkeyG1Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePubkeyG1Request.DiscardUnknown(m)
+func (m *QueryVrfKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVrfKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePubkeyG1Request proto.InternalMessageInfo
+var xxx_messageInfo_QueryVrfKeyRequest proto.InternalMessageInfo
 
-type QueryAggregatePubkeyG1Response struct {
-	AggregatePubkeyG1 []byte `protobuf:"bytes,1,opt,name=aggregate_pubkey_g1,json=aggregatePubkeyG1,proto3" json:"aggregate_pubkey_g1,omitempty"`
-	Total             uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Hit               uint64 `protobuf:"varint,3,opt,name=hit,proto3" json:"hit,omitempty"`
+type QueryVrfKeyResponse struct {
+	VrfKey *SignerVrfKey `protobuf:"bytes,1,opt,name=vrf_key,json=vrfKey,proto3" json:"vrf_key,omitempty"`
 }
 
-func (m *QueryAggregatePubkeyG1Response) Reset()         { *m = QueryAggregatePubkeyG1Response{} }
-func (m *QueryAggregatePubkeyG1Response) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePubkeyG1Response) ProtoMessage()    {}
-func (*QueryAggregatePubkeyG1Response) Descriptor() ([]byte, []int) {
+func (m *QueryVrfKeyResponse) Reset()         { *m = QueryVrfKeyResponse{} }
+func (m *QueryVrfKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVrfKeyResponse) ProtoMessage()    {}
+func (*QueryVrfKeyResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_991a610b84b5964c, []int{13}
 }
-func (m *QueryAggregatePubkeyG1Response) XXX_Unmarshal(b []byte) error {
+func (m *QueryVrfKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePubkeyG1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVrfKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePubkeyG1Response.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVrfKeyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -543,1049 +547,3839 @@ func (m *QueryAggregatePubkeyG1Response) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePubkeyG1Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePubkeyG1Response.Merge(m, src)
+func (m *QueryVrfKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVrfKeyResponse.Merge(m, src)
 }
-func (m *QueryAggregatePubkeyG1Response) XXX_Size() int {
+func (m *QueryVrfKeyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePubkeyG1Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePubkeyG1Response.DiscardUnknown(m)
+func (m *QueryVrfKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVrfKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePubkeyG1Response proto.InternalMessageInfo
+var xxx_messageInfo_QueryVrfKeyResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "zgc.dasigners.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "zgc.dasigners.v1.QueryParamsResponse")
-	proto.RegisterType((*QuerySignerRequest)(nil), "zgc.dasigners.v1.QuerySignerRequest")
-	proto.RegisterType((*QuerySignerResponse)(nil), "zgc.dasigners.v1.QuerySignerResponse")
-	proto.RegisterType((*QueryEpochNumberRequest)(nil), "zgc.dasigners.v1.QueryEpochNumberRequest")
-	proto.RegisterType((*QueryEpochNumberResponse)(nil), "zgc.dasigners.v1.QueryEpochNumberResponse")
-	proto.RegisterType((*QueryQuorumCountRequest)(nil), "zgc.dasigners.v1.QueryQuorumCountRequest")
-	proto.RegisterType((*QueryQuorumCountResponse)(nil), "zgc.dasigners.v1.QueryQuorumCountResponse")
-	proto.RegisterType((*QueryEpochQuorumRequest)(nil), "zgc.dasigners.v1.QueryEpochQuorumRequest")
-	proto.RegisterType((*QueryEpochQuorumResponse)(nil), "zgc.dasigners.v1.QueryEpochQuorumResponse")
-	proto.RegisterType((*QueryEpochQuorumRowRequest)(nil), "zgc.dasigners.v1.QueryEpochQuorumRowRequest")
-	proto.RegisterType((*QueryEpochQuorumRowResponse)(nil), "zgc.dasigners.v1.QueryEpochQuorumRowResponse")
-	proto.RegisterType((*QueryAggregatePubkeyG1Request)(nil), "zgc.dasigners.v1.QueryAggregatePubkeyG1Request")
-	proto.RegisterType((*QueryAggregatePubkeyG1Response)(nil), "zgc.dasigners.v1.QueryAggregatePubkeyG1Response")
+type QuerySignerOperatorRequest struct {
+	// account defines the hex address of signer without 0x
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func init() { proto.RegisterFile("zgc/dasigners/v1/query.proto", fileDescriptor_991a610b84b5964c) }
-
-var fileDescriptor_991a610b84b5964c = []byte{
-	// 788 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0xf9, 0x7a, 0x30, 0xc0, 0x13, 0x0c, 0xe8, 0x91, 0x18, 0x30, 0x60, 0xe0, 0x29, 0x3c,
-	0x91, 0x38, 0xe1, 0xa9, 0xbb, 0x76, 0x51, 0xaa, 0x0a, 0x21, 0xb5, 0x15, 0xa4, 0xab, 0x76, 0x13,
-	0x4d, 0x9c, 0xe9, 0xc4, 0x2a, 0xf6, 0x38, 0xf6, 0x98, 0x10, 0x96, 0x55, 0x77, 0xdd, 0x54, 0xea,
-	0xa6, 0x3f, 0xa0, 0x3f, 0x86, 0x25, 0x52, 0x37, 0x5d, 0xb6, 0xa4, 0x3f, 0xa4, 0xf2, 0xcc, 0x24,
-	0x8e, 0xe3, 0x38, 0x64, 0xc1, 0xce, 0x73, 0xef, 0xb9, 0xf7, 0x9c, 0xb9, 0xe3, 0x33, 0x36, 0xd8,
-	0xb8, 0x26, 0xa6, 0x51, 0x47, 0xbe, 0x45, 0x1c, 0xec, 0xf9, 0xc6, 0x65, 0xd9, 0x68, 0x06, 0xd8,
-	0x6b, 0x17, 0x5d, 0x8f, 0x32, 0x0a, 0x97, 0xae, 0x89, 0x59, 0xec, 0x65, 0x8b, 0x97, 0x65, 0x35,
-	0x67, 0x52, 0xdf, 0xa6, 0x7e, 0x95, 0xe7, 0x0d, 0xb1, 0x10, 0x60, 0x75, 0x95, 0x50, 0x42, 0x45,
-	0x3c, 0x7c, 0x92, 0xd1, 0x0d, 0x42, 0x29, 0xb9, 0xc0, 0x06, 0x72, 0x2d, 0x03, 0x39, 0x0e, 0x65,
-	0x88, 0x59, 0xd4, 0xe9, 0xd6, 0xe4, 0x64, 0x96, 0xaf, 0x6a, 0xc1, 0x3b, 0x03, 0x39, 0x92, 0x5b,
-	0xdd, 0x1a, 0x4c, 0x31, 0xcb, 0xc6, 0x3e, 0x43, 0xb6, 0x2b, 0x01, 0xdb, 0x09, 0xe9, 0x91, 0x52,
-	0x81, 0xd0, 0x12, 0x08, 0x82, 0x1d, 0xec, 0x5b, 0x32, 0xaf, 0xaf, 0x02, 0x78, 0x1e, 0xee, 0xf6,
-	0x0c, 0x79, 0xc8, 0xf6, 0x2b, 0xb8, 0x19, 0x60, 0x9f, 0xe9, 0x27, 0x60, 0x25, 0x16, 0xf5, 0x5d,
-	0xea, 0xf8, 0x18, 0x96, 0xc0, 0x8c, 0xcb, 0x23, 0x59, 0x65, 0x5b, 0xc9, 0xcf, 0x1f, 0x65, 0x8b,
-	0x83, 0xc3, 0x29, 0xca, 0x0a, 0x89, 0xd3, 0x4b, 0xb2, 0xfd, 0x6b, 0x8e, 0x90, 0xed, 0xa1, 0x0a,
-	0x66, 0x91, 0x69, 0xd2, 0xc0, 0x61, 0x61, 0xa7, 0xc9, 0xfc, 0x5c, 0xa5, 0xb7, 0xee, 0x51, 0x77,
-	0x2b, 0x22, 0x6a, 0xc1, 0xc2, 0x0b, 0x86, 0x52, 0xcb, 0x0a, 0x89, 0xd3, 0x73, 0x60, 0x8d, 0x37,
-	0x7a, 0xee, 0x52, 0xb3, 0xf1, 0x2a, 0xb0, 0x6b, 0x3d, 0x7e, 0xfd, 0x09, 0xc8, 0x26, 0x53, 0x92,
-	0x68, 0x07, 0x2c, 0xe0, 0x30, 0x5c, 0x75, 0x78, 0x9c, 0xef, 0x74, 0xaa, 0x32, 0x8f, 0x23, 0xa8,
-	0xfe, 0x58, 0x76, 0x3e, 0x0f, 0xa8, 0x17, 0xd8, 0xcf, 0x42, 0xdd, 0xdd, 0x9d, 0x8d, 0x51, 0xdd,
-	0x25, 0x8f, 0x55, 0x47, 0xe4, 0x4d, 0x1e, 0xae, 0xf2, 0x69, 0x74, 0xcb, 0x9b, 0x11, 0x54, 0x7f,
-	0xd3, 0xbf, 0x2d, 0xd1, 0x63, 0x7c, 0x72, 0xb8, 0x0e, 0xe6, 0x24, 0x81, 0x55, 0xcf, 0x4e, 0xf0,
-	0xfc, 0xac, 0x08, 0x9c, 0xd6, 0xf5, 0x17, 0xfd, 0x63, 0xe9, 0xb6, 0x8e, 0xe6, 0x2f, 0x70, 0xe9,
-	0x47, 0x2f, 0x2b, 0x24, 0x4e, 0x6f, 0x03, 0x35, 0xd1, 0x8d, 0xb6, 0x1e, 0x48, 0x6b, 0x98, 0xf4,
-	0x68, 0xab, 0x6a, 0x39, 0x75, 0x7c, 0x95, 0x9d, 0xdc, 0x56, 0xf2, 0x8b, 0x95, 0x59, 0x8f, 0xb6,
-	0x4e, 0xc3, 0xb5, 0xfe, 0x08, 0xac, 0x0f, 0xa5, 0x96, 0x7b, 0xf9, 0xa7, 0xef, 0x5d, 0x52, 0xf2,
-	0x73, 0xbd, 0x37, 0xe6, 0xa3, 0x02, 0x36, 0x79, 0xdd, 0x53, 0x42, 0x3c, 0x4c, 0x10, 0xc3, 0x67,
-	0x41, 0xed, 0x3d, 0x6e, 0x9f, 0x94, 0x1f, 0x4a, 0xf5, 0x2e, 0x58, 0x94, 0xc9, 0x9a, 0xc5, 0x6c,
-	0xe4, 0x72, 0xe5, 0x0b, 0x15, 0x79, 0xe8, 0xc7, 0x3c, 0xa6, 0x5f, 0x01, 0x2d, 0x4d, 0x85, 0xdc,
-	0x40, 0x11, 0xac, 0xa0, 0x6e, 0xb2, 0xea, 0xf2, 0x6c, 0x95, 0x94, 0xb9, 0x9a, 0x85, 0xca, 0x32,
-	0x1a, 0xac, 0x83, 0xab, 0x60, 0x9a, 0x51, 0x86, 0x2e, 0xa4, 0x1e, 0xb1, 0x80, 0x4b, 0x60, 0xb2,
-	0x61, 0x31, 0x2e, 0x61, 0xaa, 0x12, 0x3e, 0x1e, 0x75, 0xfe, 0x02, 0xd3, 0x9c, 0x1a, 0x5e, 0x82,
-	0x19, 0xe1, 0x64, 0xb8, 0x37, 0xec, 0xa0, 0x07, 0x2f, 0x0c, 0x75, 0xff, 0x1e, 0x94, 0x10, 0xae,
-	0x6f, 0x7d, 0xf8, 0xfe, 0xfb, 0xcb, 0x44, 0x0e, 0xae, 0x19, 0x25, 0x12, 0xbf, 0x95, 0xc4, 0x7d,
-	0x01, 0x3f, 0x29, 0x60, 0xbe, 0xcf, 0x95, 0xf0, 0x20, 0xa5, 0x6f, 0xd2, 0xd4, 0xea, 0x7f, 0xe3,
-	0x40, 0xa5, 0x8e, 0x7d, 0xae, 0x63, 0x0b, 0x6e, 0x26, 0x74, 0xf0, 0xa3, 0x2c, 0x88, 0xe3, 0xe5,
-	0x6a, 0xfa, 0x6c, 0x9a, 0xaa, 0x26, 0x79, 0x11, 0xa4, 0xaa, 0x19, 0xe2, 0xfa, 0x11, 0x6a, 0xc4,
-	0x7b, 0x51, 0xe0, 0x97, 0x41, 0x34, 0x1b, 0xd1, 0x63, 0xf4, 0x6c, 0x62, 0x37, 0xc3, 0xe8, 0xd9,
-	0xc4, 0x9d, 0x7e, 0xef, 0x6c, 0x84, 0x26, 0xf8, 0x55, 0x01, 0x7f, 0xc7, 0xfd, 0x05, 0x0f, 0xc7,
-	0x60, 0xe9, 0xdd, 0x00, 0x6a, 0x61, 0x4c, 0xb4, 0x94, 0x75, 0xc0, 0x65, 0xed, 0xc2, 0x9d, 0x91,
-	0xb2, 0x0a, 0x1e, 0x6d, 0xc1, 0x6f, 0x0a, 0x58, 0x4e, 0x98, 0x07, 0x1a, 0x29, 0x7c, 0x69, 0x66,
-	0x57, 0x4b, 0xe3, 0x17, 0x48, 0x8d, 0x87, 0x5c, 0xe3, 0xbf, 0x70, 0x2f, 0xa1, 0xb1, 0xe7, 0xc9,
-	0x82, 0xb0, 0x6b, 0x81, 0x94, 0x43, 0x8f, 0x89, 0x4f, 0x56, 0xaa, 0xc7, 0x62, 0x5f, 0xcd, 0x54,
-	0x8f, 0xc5, 0xbf, 0x94, 0x23, 0x3c, 0x26, 0x1e, 0x8f, 0x5f, 0xde, 0xfc, 0xd2, 0x32, 0x37, 0x77,
-	0x9a, 0x72, 0x7b, 0xa7, 0x29, 0x3f, 0xef, 0x34, 0xe5, 0x73, 0x47, 0xcb, 0xdc, 0x76, 0xb4, 0xcc,
-	0x8f, 0x8e, 0x96, 0x79, 0x6b, 0x10, 0x8b, 0x35, 0x82, 0x5a, 0xd1, 0xa4, 0xb6, 0x51, 0x22, 0x17,
-	0xa8, 0xe6, 0x1b, 0x25, 0x52, 0x30, 0x1b, 0xc8, 0x72, 0x8c, 0xab, 0x78, 0x3f, 0xd6, 0x76, 0xb1,
-	0x5f, 0x9b, 0xe1, 0x3f, 0x12, 0xff, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x6e, 0x10, 0xbe,
-	0x47, 0x09, 0x00, 0x00,
+func (m *QuerySignerOperatorRequest) Reset()         { *m = QuerySignerOperatorRequest{} }
+func (m *QuerySignerOperatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerOperatorRequest) ProtoMessage()    {}
+func (*QuerySignerOperatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{14}
+}
+func (m *QuerySignerOperatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignerOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignerOperatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignerOperatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerOperatorRequest.Merge(m, src)
+}
+func (m *QuerySignerOperatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignerOperatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerOperatorRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySignerOperatorRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	EpochNumber(ctx context.Context, in *QueryEpochNumberRequest, opts ...grpc.CallOption) (*QueryEpochNumberResponse, error)
-	QuorumCount(ctx context.Context, in *QueryQuorumCountRequest, opts ...grpc.CallOption) (*QueryQuorumCountResponse, error)
-	EpochQuorum(ctx context.Context, in *QueryEpochQuorumRequest, opts ...grpc.CallOption) (*QueryEpochQuorumResponse, error)
-	EpochQuorumRow(ctx context.Context, in *QueryEpochQuorumRowRequest, opts ...grpc.CallOption) (*QueryEpochQuorumRowResponse, error)
-	AggregatePubkeyG1(ctx context.Context, in *QueryAggregatePubkeyG1Request, opts ...grpc.CallOption) (*QueryAggregatePubkeyG1Response, error)
-	Signer(ctx context.Context, in *QuerySignerRequest, opts ...grpc.CallOption) (*QuerySignerResponse, error)
+type QuerySignerOperatorResponse struct {
+	// operator defines the hex address of the operator without 0x, empty if not set
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySignerOperatorResponse) Reset()         { *m = QuerySignerOperatorResponse{} }
+func (m *QuerySignerOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerOperatorResponse) ProtoMessage()    {}
+func (*QuerySignerOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{15}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySignerOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySignerOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignerOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) EpochNumber(ctx context.Context, in *QueryEpochNumberRequest, opts ...grpc.CallOption) (*QueryEpochNumberResponse, error) {
-	out := new(QueryEpochNumberResponse)
-	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/EpochNumber", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySignerOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerOperatorResponse.Merge(m, src)
 }
-
-func (c *queryClient) QuorumCount(ctx context.Context, in *QueryQuorumCountRequest, opts ...grpc.CallOption) (*QueryQuorumCountResponse, error) {
-	out := new(QueryQuorumCountResponse)
-	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/QuorumCount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySignerOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignerOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerOperatorResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) EpochQuorum(ctx context.Context, in *QueryEpochQuorumRequest, opts ...grpc.CallOption) (*QueryEpochQuorumResponse, error) {
-	out := new(QueryEpochQuorumResponse)
-	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/EpochQuorum", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QuerySignerOperatorResponse proto.InternalMessageInfo
+
+type QueryEpochNumberRequest struct {
 }
 
-func (c *queryClient) EpochQuorumRow(ctx context.Context, in *QueryEpochQuorumRowRequest, opts ...grpc.CallOption) (*QueryEpochQuorumRowResponse, error) {
-	out := new(QueryEpochQuorumRowResponse)
-	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/EpochQuorumRow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEpochNumberRequest) Reset()         { *m = QueryEpochNumberRequest{} }
+func (m *QueryEpochNumberRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochNumberRequest) ProtoMessage()    {}
+func (*QueryEpochNumberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{16}
+}
+func (m *QueryEpochNumberRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochNumberRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEpochNumberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochNumberRequest.Merge(m, src)
+}
+func (m *QueryEpochNumberRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochNumberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochNumberRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AggregatePubkeyG1(ctx context.Context, in *QueryAggregatePubkeyG1Request, opts ...grpc.CallOption) (*QueryAggregatePubkeyG1Response, error) {
-	out := new(QueryAggregatePubkeyG1Response)
-	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/AggregatePubkeyG1", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryEpochNumberRequest proto.InternalMessageInfo
+
+type QueryEpochNumberResponse struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 }
 
-func (c *queryClient) Signer(ctx context.Context, in *QuerySignerRequest, opts ...grpc.CallOption) (*QuerySignerResponse, error) {
-	out := new(QuerySignerResponse)
-	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/Signer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEpochNumberResponse) Reset()         { *m = QueryEpochNumberResponse{} }
+func (m *QueryEpochNumberResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochNumberResponse) ProtoMessage()    {}
+func (*QueryEpochNumberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{17}
+}
+func (m *QueryEpochNumberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochNumberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochNumberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	EpochNumber(context.Context, *QueryEpochNumberRequest) (*QueryEpochNumberResponse, error)
-	QuorumCount(context.Context, *QueryQuorumCountRequest) (*QueryQuorumCountResponse, error)
-	EpochQuorum(context.Context, *QueryEpochQuorumRequest) (*QueryEpochQuorumResponse, error)
-	EpochQuorumRow(context.Context, *QueryEpochQuorumRowRequest) (*QueryEpochQuorumRowResponse, error)
-	AggregatePubkeyG1(context.Context, *QueryAggregatePubkeyG1Request) (*QueryAggregatePubkeyG1Response, error)
-	Signer(context.Context, *QuerySignerRequest) (*QuerySignerResponse, error)
+func (m *QueryEpochNumberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochNumberResponse.Merge(m, src)
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryEpochNumberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochNumberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochNumberResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryEpochNumberResponse proto.InternalMessageInfo
+
+type QueryQuorumCountRequest struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 }
-func (*UnimplementedQueryServer) EpochNumber(ctx context.Context, req *QueryEpochNumberRequest) (*QueryEpochNumberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochNumber not implemented")
+
+func (m *QueryQuorumCountRequest) Reset()         { *m = QueryQuorumCountRequest{} }
+func (m *QueryQuorumCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuorumCountRequest) ProtoMessage()    {}
+func (*QueryQuorumCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{18}
 }
-func (*UnimplementedQueryServer) QuorumCount(ctx context.Context, req *QueryQuorumCountRequest) (*QueryQuorumCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuorumCount not implemented")
+func (m *QueryQuorumCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EpochQuorum(ctx context.Context, req *QueryEpochQuorumRequest) (*QueryEpochQuorumResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochQuorum not implemented")
+func (m *QueryQuorumCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuorumCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) EpochQuorumRow(ctx context.Context, req *QueryEpochQuorumRowRequest) (*QueryEpochQuorumRowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochQuorumRow not implemented")
+func (m *QueryQuorumCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuorumCountRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AggregatePubkeyG1(ctx context.Context, req *QueryAggregatePubkeyG1Request) (*QueryAggregatePubkeyG1Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePubkeyG1 not implemented")
+func (m *QueryQuorumCountRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Signer(ctx context.Context, req *QuerySignerRequest) (*QuerySignerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Signer not implemented")
+func (m *QueryQuorumCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuorumCountRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryQuorumCountRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zgc.dasigners.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryQuorumCountResponse struct {
+	QuorumCount uint64 `protobuf:"varint,1,opt,name=quorum_count,json=quorumCount,proto3" json:"quorum_count,omitempty"`
 }
 
-func _Query_EpochNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEpochNumberRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochNumber(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zgc.dasigners.v1.Query/EpochNumber",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochNumber(ctx, req.(*QueryEpochNumberRequest))
+func (m *QueryQuorumCountResponse) Reset()         { *m = QueryQuorumCountResponse{} }
+func (m *QueryQuorumCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuorumCountResponse) ProtoMessage()    {}
+func (*QueryQuorumCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{19}
+}
+func (m *QueryQuorumCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuorumCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuorumCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryQuorumCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuorumCountResponse.Merge(m, src)
+}
+func (m *QueryQuorumCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuorumCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuorumCountResponse.DiscardUnknown(m)
 }
 
-func _Query_QuorumCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryQuorumCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuorumCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zgc.dasigners.v1.Query/QuorumCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuorumCount(ctx, req.(*QueryQuorumCountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryQuorumCountResponse proto.InternalMessageInfo
+
+type QueryEpochQuorumRequest struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	QuorumId    uint64 `protobuf:"varint,2,opt,name=quorum_id,json=quorumId,proto3" json:"quorum_id,omitempty"`
 }
 
-func _Query_EpochQuorum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEpochQuorumRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochQuorum(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zgc.dasigners.v1.Query/EpochQuorum",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochQuorum(ctx, req.(*QueryEpochQuorumRequest))
+func (m *QueryEpochQuorumRequest) Reset()         { *m = QueryEpochQuorumRequest{} }
+func (m *QueryEpochQuorumRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochQuorumRequest) ProtoMessage()    {}
+func (*QueryEpochQuorumRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{20}
+}
+func (m *QueryEpochQuorumRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochQuorumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochQuorumRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEpochQuorumRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochQuorumRequest.Merge(m, src)
+}
+func (m *QueryEpochQuorumRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochQuorumRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochQuorumRequest.DiscardUnknown(m)
 }
 
-func _Query_EpochQuorumRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEpochQuorumRowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochQuorumRow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zgc.dasigners.v1.Query/EpochQuorumRow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochQuorumRow(ctx, req.(*QueryEpochQuorumRowRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryEpochQuorumRequest proto.InternalMessageInfo
 
-func _Query_AggregatePubkeyG1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePubkeyG1Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatePubkeyG1(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zgc.dasigners.v1.Query/AggregatePubkeyG1",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePubkeyG1(ctx, req.(*QueryAggregatePubkeyG1Request))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryEpochQuorumResponse struct {
+	Quorum *Quorum `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
 }
 
-func _Query_Signer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySignerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Signer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zgc.dasigners.v1.Query/Signer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Signer(ctx, req.(*QuerySignerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryEpochQuorumResponse) Reset()         { *m = QueryEpochQuorumResponse{} }
+func (m *QueryEpochQuorumResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochQuorumResponse) ProtoMessage()    {}
+func (*QueryEpochQuorumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{21}
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zgc.dasigners.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "EpochNumber",
-			Handler:    _Query_EpochNumber_Handler,
-		},
-		{
-			MethodName: "QuorumCount",
-			Handler:    _Query_QuorumCount_Handler,
-		},
-		{
-			MethodName: "EpochQuorum",
-			Handler:    _Query_EpochQuorum_Handler,
-		},
-		{
-			MethodName: "EpochQuorumRow",
-			Handler:    _Query_EpochQuorumRow_Handler,
-		},
-		{
-			MethodName: "AggregatePubkeyG1",
-			Handler:    _Query_AggregatePubkeyG1_Handler,
-		},
-		{
-			MethodName: "Signer",
-			Handler:    _Query_Signer_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "zgc/dasigners/v1/query.proto",
+func (m *QueryEpochQuorumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEpochQuorumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochQuorumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEpochQuorumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochQuorumResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEpochQuorumResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEpochQuorumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochQuorumResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryEpochQuorumResponse proto.InternalMessageInfo
+
+type QueryEpochQuorumRowRequest struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	QuorumId    uint64 `protobuf:"varint,2,opt,name=quorum_id,json=quorumId,proto3" json:"quorum_id,omitempty"`
+	RowIndex    uint32 `protobuf:"varint,3,opt,name=row_index,json=rowIndex,proto3" json:"row_index,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEpochQuorumRowRequest) Reset()         { *m = QueryEpochQuorumRowRequest{} }
+func (m *QueryEpochQuorumRowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochQuorumRowRequest) ProtoMessage()    {}
+func (*QueryEpochQuorumRowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{22}
+}
+func (m *QueryEpochQuorumRowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochQuorumRowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochQuorumRowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySignerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEpochQuorumRowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochQuorumRowRequest.Merge(m, src)
+}
+func (m *QueryEpochQuorumRowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochQuorumRowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochQuorumRowRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySignerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryEpochQuorumRowRequest proto.InternalMessageInfo
+
+type QueryEpochQuorumRowResponse struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *QuerySignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Accounts[iNdEx])
-			copy(dAtA[i:], m.Accounts[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryEpochQuorumRowResponse) Reset()         { *m = QueryEpochQuorumRowResponse{} }
+func (m *QueryEpochQuorumRowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochQuorumRowResponse) ProtoMessage()    {}
+func (*QueryEpochQuorumRowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{23}
+}
+func (m *QueryEpochQuorumRowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochQuorumRowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochQuorumRowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySignerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEpochQuorumRowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochQuorumRowResponse.Merge(m, src)
 }
-
-func (m *QuerySignerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEpochQuorumRowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochQuorumRowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochQuorumRowResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		for iNdEx := len(m.Signer) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryEpochQuorumRowResponse proto.InternalMessageInfo
+
+type QueryAggregatePubkeyG1Request struct {
+	EpochNumber  uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	QuorumId     uint64 `protobuf:"varint,2,opt,name=quorum_id,json=quorumId,proto3" json:"quorum_id,omitempty"`
+	QuorumBitmap []byte `protobuf:"bytes,3,opt,name=quorum_bitmap,json=quorumBitmap,proto3" json:"quorum_bitmap,omitempty"`
 }
 
-func (m *QueryEpochNumberRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAggregatePubkeyG1Request) Reset()         { *m = QueryAggregatePubkeyG1Request{} }
+func (m *QueryAggregatePubkeyG1Request) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePubkeyG1Request) ProtoMessage()    {}
+func (*QueryAggregatePubkeyG1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{24}
+}
+func (m *QueryAggregatePubkeyG1Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePubkeyG1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePubkeyG1Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryEpochNumberRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAggregatePubkeyG1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePubkeyG1Request.Merge(m, src)
+}
+func (m *QueryAggregatePubkeyG1Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePubkeyG1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePubkeyG1Request.DiscardUnknown(m)
 }
 
-func (m *QueryEpochNumberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryAggregatePubkeyG1Request proto.InternalMessageInfo
+
+type QueryAggregatePubkeyG1Response struct {
+	AggregatePubkeyG1 []byte `protobuf:"bytes,1,opt,name=aggregate_pubkey_g1,json=aggregatePubkeyG1,proto3" json:"aggregate_pubkey_g1,omitempty"`
+	Total             uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Hit               uint64 `protobuf:"varint,3,opt,name=hit,proto3" json:"hit,omitempty"`
 }
 
-func (m *QueryEpochNumberResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAggregatePubkeyG1Response) Reset()         { *m = QueryAggregatePubkeyG1Response{} }
+func (m *QueryAggregatePubkeyG1Response) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePubkeyG1Response) ProtoMessage()    {}
+func (*QueryAggregatePubkeyG1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{25}
+}
+func (m *QueryAggregatePubkeyG1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePubkeyG1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePubkeyG1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAggregatePubkeyG1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePubkeyG1Response.Merge(m, src)
+}
+func (m *QueryAggregatePubkeyG1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePubkeyG1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePubkeyG1Response.DiscardUnknown(m)
 }
 
-func (m *QueryEpochNumberResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAggregatePubkeyG1Response proto.InternalMessageInfo
+
+type QueryVerifyQuorumSignatureRequest struct {
+	EpochNumber  uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	QuorumId     uint64 `protobuf:"varint,2,opt,name=quorum_id,json=quorumId,proto3" json:"quorum_id,omitempty"`
+	QuorumBitmap []byte `protobuf:"bytes,3,opt,name=quorum_bitmap,json=quorumBitmap,proto3" json:"quorum_bitmap,omitempty"`
+	// message_hash defines the 32 bytes hash signed by the quorum members
+	MessageHash []byte `protobuf:"bytes,4,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
+	// aggregate_signature defines the aggregated signature on bn254 G1
+	AggregateSignature []byte `protobuf:"bytes,5,opt,name=aggregate_signature,json=aggregateSignature,proto3" json:"aggregate_signature,omitempty"`
+	// aggregate_pubkey_g2 defines the aggregated public key on bn254 G2 of the quorum members marked in the bitmap
+	AggregatePubkeyG2 []byte `protobuf:"bytes,6,opt,name=aggregate_pubkey_g2,json=aggregatePubkeyG2,proto3" json:"aggregate_pubkey_g2,omitempty"`
 }
 
-func (m *QueryEpochNumberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryVerifyQuorumSignatureRequest) Reset()         { *m = QueryVerifyQuorumSignatureRequest{} }
+func (m *QueryVerifyQuorumSignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyQuorumSignatureRequest) ProtoMessage()    {}
+func (*QueryVerifyQuorumSignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{26}
+}
+func (m *QueryVerifyQuorumSignatureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyQuorumSignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyQuorumSignatureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVerifyQuorumSignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyQuorumSignatureRequest.Merge(m, src)
+}
+func (m *QueryVerifyQuorumSignatureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyQuorumSignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyQuorumSignatureRequest.DiscardUnknown(m)
 }
 
-func (m *QueryQuorumCountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerifyQuorumSignatureRequest proto.InternalMessageInfo
+
+type QueryVerifyQuorumSignatureResponse struct {
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// total defines the number of slots in the quorum
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// hit defines the number of slots signed, hit / total is the signed stake fraction of the quorum
+	Hit uint64 `protobuf:"varint,3,opt,name=hit,proto3" json:"hit,omitempty"`
+}
+
+func (m *QueryVerifyQuorumSignatureResponse) Reset()         { *m = QueryVerifyQuorumSignatureResponse{} }
+func (m *QueryVerifyQuorumSignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyQuorumSignatureResponse) ProtoMessage()    {}
+func (*QueryVerifyQuorumSignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_991a610b84b5964c, []int{27}
+}
+func (m *QueryVerifyQuorumSignatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyQuorumSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyQuorumSignatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryVerifyQuorumSignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyQuorumSignatureResponse.Merge(m, src)
+}
+func (m *QueryVerifyQuorumSignatureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyQuorumSignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyQuorumSignatureResponse.DiscardUnknown(m)
 }
 
-func (m *QueryQuorumCountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryVerifyQuorumSignatureResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "zgc.dasigners.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "zgc.dasigners.v1.QueryParamsResponse")
+	proto.RegisterType((*QuerySignerRequest)(nil), "zgc.dasigners.v1.QuerySignerRequest")
+	proto.RegisterType((*QuerySignerResponse)(nil), "zgc.dasigners.v1.QuerySignerResponse")
+	proto.RegisterType((*QuerySignersRequest)(nil), "zgc.dasigners.v1.QuerySignersRequest")
+	proto.RegisterType((*QuerySignersResponse)(nil), "zgc.dasigners.v1.QuerySignersResponse")
+	proto.RegisterType((*QueryEpochRegistrationsRequest)(nil), "zgc.dasigners.v1.QueryEpochRegistrationsRequest")
+	proto.RegisterType((*QueryEpochRegistrationsResponse)(nil), "zgc.dasigners.v1.QueryEpochRegistrationsResponse")
+	proto.RegisterType((*QuerySignerEpochsRequest)(nil), "zgc.dasigners.v1.QuerySignerEpochsRequest")
+	proto.RegisterType((*QuerySignerEpochsResponse)(nil), "zgc.dasigners.v1.QuerySignerEpochsResponse")
+	proto.RegisterType((*QueryEpochRandomnessRequest)(nil), "zgc.dasigners.v1.QueryEpochRandomnessRequest")
+	proto.RegisterType((*QueryEpochRandomnessResponse)(nil), "zgc.dasigners.v1.QueryEpochRandomnessResponse")
+	proto.RegisterType((*QueryVrfKeyRequest)(nil), "zgc.dasigners.v1.QueryVrfKeyRequest")
+	proto.RegisterType((*QueryVrfKeyResponse)(nil), "zgc.dasigners.v1.QueryVrfKeyResponse")
+	proto.RegisterType((*QuerySignerOperatorRequest)(nil), "zgc.dasigners.v1.QuerySignerOperatorRequest")
+	proto.RegisterType((*QuerySignerOperatorResponse)(nil), "zgc.dasigners.v1.QuerySignerOperatorResponse")
+	proto.RegisterType((*QueryEpochNumberRequest)(nil), "zgc.dasigners.v1.QueryEpochNumberRequest")
+	proto.RegisterType((*QueryEpochNumberResponse)(nil), "zgc.dasigners.v1.QueryEpochNumberResponse")
+	proto.RegisterType((*QueryQuorumCountRequest)(nil), "zgc.dasigners.v1.QueryQuorumCountRequest")
+	proto.RegisterType((*QueryQuorumCountResponse)(nil), "zgc.dasigners.v1.QueryQuorumCountResponse")
+	proto.RegisterType((*QueryEpochQuorumRequest)(nil), "zgc.dasigners.v1.QueryEpochQuorumRequest")
+	proto.RegisterType((*QueryEpochQuorumResponse)(nil), "zgc.dasigners.v1.QueryEpochQuorumResponse")
+	proto.RegisterType((*QueryEpochQuorumRowRequest)(nil), "zgc.dasigners.v1.QueryEpochQuorumRowRequest")
+	proto.RegisterType((*QueryEpochQuorumRowResponse)(nil), "zgc.dasigners.v1.QueryEpochQuorumRowResponse")
+	proto.RegisterType((*QueryAggregatePubkeyG1Request)(nil), "zgc.dasigners.v1.QueryAggregatePubkeyG1Request")
+	proto.RegisterType((*QueryAggregatePubkeyG1Response)(nil), "zgc.dasigners.v1.QueryAggregatePubkeyG1Response")
+	proto.RegisterType((*QueryVerifyQuorumSignatureRequest)(nil), "zgc.dasigners.v1.QueryVerifyQuorumSignatureRequest")
+	proto.RegisterType((*QueryVerifyQuorumSignatureResponse)(nil), "zgc.dasigners.v1.QueryVerifyQuorumSignatureResponse")
 }
 
-func (m *QueryQuorumCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("zgc/dasigners/v1/query.proto", fileDescriptor_991a610b84b5964c) }
+
+var fileDescriptor_991a610b84b5964c = []byte{
+	// 1348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdf, 0x6f, 0xdb, 0xd4,
+	0x17, 0xaf, 0xb7, 0x36, 0xed, 0x4e, 0xbb, 0x7d, 0xb7, 0xbb, 0x7e, 0x59, 0xea, 0x6e, 0x6e, 0xe6,
+	0xad, 0x25, 0x0b, 0x8b, 0x9d, 0x74, 0xfc, 0x10, 0x12, 0x20, 0x18, 0xb0, 0x31, 0x01, 0x63, 0x33,
+	0x12, 0x12, 0x48, 0x28, 0xba, 0x49, 0x6e, 0x1d, 0x6b, 0x8d, 0x9d, 0xda, 0x4e, 0xba, 0x4c, 0x7b,
+	0x02, 0xde, 0x86, 0x04, 0xd2, 0x1e, 0x40, 0xe2, 0x15, 0x89, 0x57, 0x1e, 0xf8, 0x23, 0xf6, 0x38,
+	0x89, 0x17, 0x1e, 0x61, 0xe3, 0x0f, 0x41, 0xbe, 0xf7, 0xc4, 0xb1, 0x6b, 0x3b, 0x76, 0x51, 0xc5,
+	0x53, 0x73, 0xef, 0xf9, 0xf5, 0xb9, 0x9f, 0x7b, 0xce, 0xf1, 0xb9, 0x85, 0xf3, 0x0f, 0xcc, 0x8e,
+	0xde, 0xa5, 0x9e, 0x65, 0xda, 0xcc, 0xf5, 0xf4, 0x51, 0x53, 0xdf, 0x1b, 0x32, 0x77, 0xac, 0x0d,
+	0x5c, 0xc7, 0x77, 0xc8, 0xe9, 0x07, 0x66, 0x47, 0x0b, 0xa5, 0xda, 0xa8, 0x29, 0xd7, 0x3a, 0x8e,
+	0xd7, 0x77, 0x3c, 0xbd, 0x4d, 0x3d, 0x26, 0x54, 0xf5, 0x51, 0xb3, 0xcd, 0x7c, 0xda, 0xd4, 0x07,
+	0xd4, 0xb4, 0x6c, 0xea, 0x5b, 0x8e, 0x2d, 0xac, 0xe5, 0x35, 0xa1, 0xdb, 0xe2, 0x2b, 0x5d, 0x2c,
+	0x50, 0xb4, 0x6a, 0x3a, 0xa6, 0x23, 0xf6, 0x83, 0x5f, 0xb8, 0x7b, 0xde, 0x74, 0x1c, 0x73, 0x97,
+	0xe9, 0x74, 0x60, 0xe9, 0xd4, 0xb6, 0x1d, 0x9f, 0x7b, 0x9b, 0xd8, 0xac, 0xa1, 0x94, 0xaf, 0xda,
+	0xc3, 0x1d, 0x9d, 0xda, 0x88, 0x53, 0xde, 0x38, 0x28, 0xf2, 0xad, 0x3e, 0xf3, 0x7c, 0xda, 0x1f,
+	0xa0, 0x42, 0x25, 0x71, 0xcc, 0xe9, 0xa9, 0x84, 0x86, 0x92, 0xd0, 0x30, 0x99, 0xcd, 0x3c, 0x0b,
+	0xe5, 0xea, 0x2a, 0x90, 0xbb, 0xc1, 0x71, 0xef, 0x50, 0x97, 0xf6, 0x3d, 0x83, 0xed, 0x0d, 0x99,
+	0xe7, 0xab, 0x37, 0xe1, 0x6c, 0x6c, 0xd7, 0x1b, 0x38, 0xb6, 0xc7, 0x48, 0x03, 0x4a, 0x03, 0xbe,
+	0x53, 0x96, 0x2a, 0x52, 0x75, 0x79, 0xbb, 0xac, 0x1d, 0x24, 0x52, 0x43, 0x0b, 0xd4, 0x53, 0x1b,
+	0xe8, 0xfe, 0x53, 0xae, 0x81, 0xee, 0x89, 0x0c, 0x4b, 0xb4, 0xd3, 0x71, 0x86, 0xb6, 0x1f, 0x78,
+	0x3a, 0x5e, 0x3d, 0x61, 0x84, 0xeb, 0x30, 0xf4, 0xc4, 0x62, 0x1a, 0x5a, 0x44, 0xe1, 0x06, 0xa9,
+	0xa1, 0xd1, 0x02, 0xf5, 0xd4, 0x2f, 0x63, 0x8e, 0x26, 0x47, 0x23, 0x37, 0x00, 0xa6, 0x37, 0x8a,
+	0xe7, 0xd8, 0xd2, 0xf0, 0x16, 0x83, 0xeb, 0xd7, 0x44, 0xa6, 0xe0, 0xf5, 0x6b, 0x77, 0xa8, 0xc9,
+	0xd0, 0xd6, 0x88, 0x58, 0xaa, 0x8f, 0x25, 0x58, 0x8d, 0xfb, 0x47, 0xa4, 0xdb, 0xb0, 0x88, 0xa0,
+	0x72, 0xa1, 0x4e, 0x14, 0xc9, 0xcd, 0x18, 0xa8, 0x63, 0x1c, 0xd4, 0x8b, 0xb9, 0xa0, 0x44, 0xc0,
+	0x18, 0xaa, 0x47, 0x12, 0x28, 0x1c, 0xd5, 0xfb, 0x03, 0xa7, 0xd3, 0x33, 0x98, 0x69, 0x79, 0xbe,
+	0xcb, 0x45, 0x21, 0x01, 0x17, 0x61, 0x85, 0x05, 0xc2, 0x96, 0x3d, 0xec, 0xb7, 0x39, 0x9f, 0x52,
+	0x75, 0xde, 0x58, 0xe6, 0x7b, 0xb7, 0xf9, 0x16, 0xb9, 0x91, 0x02, 0xe7, 0xdf, 0x70, 0xf4, 0xab,
+	0x04, 0x1b, 0x99, 0x68, 0x90, 0xae, 0xf7, 0xe0, 0xa4, 0x1b, 0x15, 0x20, 0x69, 0x4a, 0x92, 0xb4,
+	0xa8, 0xbd, 0x11, 0x37, 0x3a, 0x3a, 0x02, 0x1f, 0x42, 0x39, 0x72, 0xab, 0x1c, 0x77, 0xc8, 0x5c,
+	0x19, 0x16, 0x31, 0x4d, 0x39, 0x69, 0x27, 0x8c, 0xc9, 0xf2, 0xc8, 0x08, 0x7b, 0x08, 0x6b, 0x29,
+	0xd1, 0x91, 0xa9, 0x17, 0xa0, 0xc4, 0x2f, 0x49, 0x50, 0x34, 0x6f, 0xe0, 0xea, 0xe8, 0xce, 0xfe,
+	0x36, 0xac, 0x47, 0x6e, 0x8b, 0xda, 0x5d, 0xa7, 0x6f, 0x33, 0xef, 0x10, 0x89, 0xa3, 0xbe, 0x05,
+	0xe7, 0xd3, 0x3d, 0xe0, 0x11, 0x14, 0x00, 0x37, 0xdc, 0xe5, 0x0e, 0x56, 0x8c, 0xc8, 0x8e, 0xaa,
+	0x61, 0xbb, 0xf8, 0xcc, 0xdd, 0xf9, 0x90, 0x8d, 0x73, 0x79, 0x57, 0x6f, 0xc3, 0xd9, 0x98, 0x3e,
+	0x86, 0x79, 0x0d, 0x16, 0x47, 0xee, 0x4e, 0xeb, 0x1e, 0x1b, 0x63, 0x81, 0x2b, 0x59, 0x25, 0x88,
+	0x86, 0xa5, 0x11, 0xff, 0xab, 0xbe, 0x0a, 0x72, 0x84, 0xff, 0x4f, 0x06, 0xcc, 0xa5, 0xbe, 0xe3,
+	0xe6, 0xe3, 0x78, 0x1d, 0xd6, 0x53, 0xed, 0x10, 0x8f, 0x0c, 0x4b, 0x0e, 0xee, 0xa1, 0x65, 0xb8,
+	0x56, 0xd7, 0xe0, 0xdc, 0x94, 0x32, 0x41, 0xe3, 0xa4, 0x0b, 0xbf, 0x09, 0xe5, 0xa4, 0x08, 0x5d,
+	0x16, 0xb8, 0x8c, 0x37, 0xd0, 0xf3, 0xdd, 0xa1, 0xe3, 0x0e, 0xfb, 0xef, 0x06, 0x40, 0x0f, 0x71,
+	0x95, 0x93, 0xe0, 0x31, 0xeb, 0x69, 0xf0, 0x3d, 0xbe, 0xdd, 0x9a, 0xb2, 0x31, 0x6f, 0x2c, 0xef,
+	0x4d, 0x55, 0xd5, 0xcf, 0xa3, 0xc7, 0x12, 0x3e, 0x0e, 0xd1, 0x80, 0xd6, 0xe1, 0x04, 0x06, 0xb0,
+	0xba, 0x3c, 0xa3, 0xe7, 0x8d, 0x25, 0xb1, 0x71, 0xab, 0xab, 0x7e, 0x04, 0xe5, 0xa4, 0xeb, 0xe9,
+	0x67, 0x42, 0xe8, 0x65, 0x7f, 0xa1, 0xd0, 0x02, 0xf5, 0xd4, 0x31, 0xc8, 0x09, 0x6f, 0xce, 0xfe,
+	0x11, 0x61, 0x0d, 0x84, 0xae, 0xb3, 0xdf, 0xb2, 0xec, 0x2e, 0xbb, 0x5f, 0x3e, 0x5e, 0x91, 0xaa,
+	0x27, 0x8d, 0x25, 0xd7, 0xd9, 0xbf, 0x15, 0xac, 0xd5, 0x57, 0x60, 0x3d, 0x35, 0xf4, 0xb4, 0xde,
+	0xc3, 0x4f, 0x5e, 0x90, 0x33, 0xb8, 0x52, 0xbf, 0x91, 0xe0, 0x02, 0xb7, 0x7b, 0xc7, 0x34, 0x5d,
+	0x66, 0x52, 0x9f, 0xdd, 0x19, 0xb6, 0xef, 0xb1, 0xf1, 0xcd, 0xe6, 0x51, 0xa1, 0xbe, 0x04, 0x27,
+	0x51, 0xd8, 0xb6, 0xfc, 0x3e, 0x1d, 0x70, 0xe4, 0x2b, 0x06, 0x5e, 0xfa, 0x75, 0xbe, 0xa7, 0xde,
+	0x07, 0x25, 0x0b, 0x05, 0x1e, 0x40, 0x83, 0xb3, 0x74, 0x22, 0x6c, 0x0d, 0xb8, 0xb4, 0x65, 0x36,
+	0xb1, 0xec, 0xcf, 0xd0, 0x83, 0x76, 0x64, 0x15, 0x16, 0x7c, 0xc7, 0xa7, 0xbb, 0x88, 0x47, 0x2c,
+	0xc8, 0x69, 0x38, 0xde, 0xb3, 0x7c, 0x0e, 0x61, 0xde, 0x08, 0x7e, 0xaa, 0xdf, 0x1e, 0x83, 0x8b,
+	0xa2, 0xec, 0x99, 0x6b, 0xed, 0x60, 0x86, 0x06, 0xa5, 0x47, 0xfd, 0xa1, 0xcb, 0xfe, 0x4b, 0x12,
+	0x82, 0x20, 0x7d, 0xe6, 0x79, 0xd4, 0x64, 0xad, 0x1e, 0xf5, 0x7a, 0xe5, 0x79, 0xae, 0xb3, 0x8c,
+	0x7b, 0x1f, 0x50, 0xaf, 0x47, 0xf4, 0x28, 0x0b, 0xde, 0x04, 0x65, 0x79, 0x81, 0x6b, 0x92, 0x50,
+	0x14, 0xe2, 0x4f, 0xa7, 0x6d, 0xbb, 0x5c, 0x4a, 0xa7, 0x6d, 0x5b, 0x6d, 0x83, 0x3a, 0x8b, 0x0d,
+	0xbc, 0x8c, 0x55, 0x58, 0x18, 0xd1, 0x5d, 0xab, 0xcb, 0x79, 0x58, 0x32, 0xc4, 0xa2, 0x28, 0xe5,
+	0xdb, 0x5f, 0x9f, 0x86, 0x05, 0x1e, 0x84, 0x8c, 0xa0, 0x24, 0x66, 0x3c, 0x72, 0x39, 0xad, 0xb6,
+	0x0e, 0x8e, 0x92, 0xf2, 0x66, 0x8e, 0x96, 0x80, 0xa7, 0x6e, 0x7c, 0xf5, 0xfb, 0xdf, 0x8f, 0x8f,
+	0xad, 0x91, 0x73, 0x7a, 0xc3, 0x8c, 0xcf, 0xab, 0x62, 0x92, 0x24, 0x8f, 0x24, 0x58, 0x8e, 0x34,
+	0x42, 0x72, 0x25, 0xc3, 0x6f, 0xb2, 0x8f, 0xca, 0xb5, 0x22, 0xaa, 0x88, 0x63, 0x93, 0xe3, 0xd8,
+	0x20, 0x17, 0x12, 0x38, 0x78, 0xe2, 0xd4, 0x45, 0x32, 0x71, 0x34, 0x91, 0xce, 0x98, 0x89, 0x26,
+	0xd9, 0x7b, 0xe5, 0x5a, 0x11, 0xd5, 0x5c, 0x34, 0x22, 0x0b, 0xeb, 0x62, 0xfc, 0x08, 0xb9, 0x11,
+	0x3e, 0x66, 0x73, 0x13, 0x6b, 0xc6, 0x72, 0xad, 0x88, 0x6a, 0x41, 0x6e, 0x04, 0x26, 0xf2, 0xa3,
+	0x04, 0xa7, 0xe2, 0x2d, 0x8d, 0x5c, 0x2d, 0x10, 0x25, 0x6c, 0xba, 0x72, 0xbd, 0xa0, 0x36, 0xc2,
+	0xba, 0xc2, 0x61, 0x5d, 0x22, 0x17, 0x67, 0xc2, 0xaa, 0xbb, 0xce, 0x3e, 0xf9, 0x59, 0x82, 0x33,
+	0x89, 0x7e, 0x45, 0xf4, 0x8c, 0x78, 0x59, 0xfd, 0x55, 0x6e, 0x14, 0x37, 0x40, 0x8c, 0x57, 0x39,
+	0xc6, 0x2d, 0x72, 0x39, 0x81, 0x31, 0xac, 0xe7, 0xba, 0x28, 0xf5, 0xba, 0xd9, 0x0c, 0x6a, 0x4c,
+	0x4c, 0x12, 0x99, 0x35, 0x16, 0x7b, 0x4f, 0xc9, 0x9b, 0x39, 0x5a, 0xb9, 0x35, 0x26, 0x7e, 0x92,
+	0x07, 0xb0, 0x28, 0x4c, 0x3c, 0x32, 0xdb, 0x65, 0x58, 0xdd, 0x5b, 0x79, 0x6a, 0x18, 0xba, 0xc2,
+	0x43, 0xcb, 0xa4, 0x9c, 0x11, 0xda, 0x23, 0xbf, 0x48, 0x40, 0x92, 0xcf, 0x04, 0xd2, 0x98, 0x95,
+	0x0b, 0x69, 0xef, 0x1b, 0xb9, 0x79, 0x08, 0x8b, 0xdc, 0xdb, 0x11, 0x19, 0x14, 0x7f, 0x6b, 0x7c,
+	0x27, 0xc1, 0x4a, 0x74, 0x40, 0x27, 0xb5, 0x99, 0x24, 0xc4, 0xde, 0x10, 0xf2, 0x4b, 0x85, 0x74,
+	0x11, 0xd7, 0x16, 0xc7, 0x55, 0x21, 0x4a, 0x06, 0x6b, 0x75, 0x7c, 0x01, 0xfc, 0x24, 0xc1, 0xff,
+	0x0e, 0x8c, 0xdc, 0x64, 0x66, 0x11, 0x25, 0x86, 0x7b, 0x59, 0x2b, 0xaa, 0x5e, 0xb0, 0xe8, 0xa6,
+	0x43, 0x3d, 0xd9, 0x87, 0x92, 0x18, 0xb3, 0x33, 0xb3, 0x39, 0x36, 0xee, 0xcb, 0x9b, 0x39, 0x5a,
+	0xb9, 0x29, 0x35, 0x72, 0x77, 0xea, 0xf7, 0xd8, 0x98, 0xfc, 0x26, 0xc1, 0xff, 0x53, 0x3f, 0x8a,
+	0xe4, 0x5a, 0x56, 0x88, 0x19, 0x03, 0x85, 0xfc, 0xf2, 0xe1, 0x8c, 0x10, 0x66, 0x83, 0xc3, 0xac,
+	0x91, 0x6a, 0x12, 0x26, 0xb7, 0x9b, 0xb4, 0xa7, 0x70, 0x32, 0x20, 0x3f, 0x48, 0x70, 0x2a, 0xfe,
+	0x90, 0xc8, 0xec, 0x9f, 0xa9, 0xef, 0x14, 0xb9, 0x5e, 0x50, 0x1b, 0x11, 0x56, 0x39, 0x42, 0x95,
+	0x54, 0xb2, 0xb2, 0x6c, 0xf2, 0x56, 0xb9, 0xfe, 0xf1, 0x93, 0xbf, 0x94, 0xb9, 0x27, 0xcf, 0x14,
+	0xe9, 0xe9, 0x33, 0x45, 0xfa, 0xf3, 0x99, 0x22, 0x7d, 0xff, 0x5c, 0x99, 0x7b, 0xfa, 0x5c, 0x99,
+	0xfb, 0xe3, 0xb9, 0x32, 0xf7, 0x85, 0x6e, 0x5a, 0x7e, 0x6f, 0xd8, 0xd6, 0x3a, 0x4e, 0x5f, 0x6f,
+	0x98, 0xbb, 0xb4, 0xed, 0xe9, 0x0d, 0xb3, 0xde, 0xe9, 0x51, 0xcb, 0xd6, 0xef, 0xc7, 0x1d, 0xfb,
+	0xe3, 0x01, 0xf3, 0xda, 0x25, 0xfe, 0x2f, 0xa8, 0x6b, 0xff, 0x0c, 0x00, 0x2f, 0xea, 0xa0, 0xb9,
+	0xad, 0x13, 0x00, 0x00,
 }
 
-func (m *QueryQuorumCountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	EpochNumber(ctx context.Context, in *QueryEpochNumberRequest, opts ...grpc.CallOption) (*QueryEpochNumberResponse, error)
+	QuorumCount(ctx context.Context, in *QueryQuorumCountRequest, opts ...grpc.CallOption) (*QueryQuorumCountResponse, error)
+	EpochQuorum(ctx context.Context, in *QueryEpochQuorumRequest, opts ...grpc.CallOption) (*QueryEpochQuorumResponse, error)
+	EpochQuorumRow(ctx context.Context, in *QueryEpochQuorumRowRequest, opts ...grpc.CallOption) (*QueryEpochQuorumRowResponse, error)
+	AggregatePubkeyG1(ctx context.Context, in *QueryAggregatePubkeyG1Request, opts ...grpc.CallOption) (*QueryAggregatePubkeyG1Response, error)
+	Signer(ctx context.Context, in *QuerySignerRequest, opts ...grpc.CallOption) (*QuerySignerResponse, error)
+	Signers(ctx context.Context, in *QuerySignersRequest, opts ...grpc.CallOption) (*QuerySignersResponse, error)
+	EpochRegistrations(ctx context.Context, in *QueryEpochRegistrationsRequest, opts ...grpc.CallOption) (*QueryEpochRegistrationsResponse, error)
+	SignerEpochs(ctx context.Context, in *QuerySignerEpochsRequest, opts ...grpc.CallOption) (*QuerySignerEpochsResponse, error)
+	EpochRandomness(ctx context.Context, in *QueryEpochRandomnessRequest, opts ...grpc.CallOption) (*QueryEpochRandomnessResponse, error)
+	VrfKey(ctx context.Context, in *QueryVrfKeyRequest, opts ...grpc.CallOption) (*QueryVrfKeyResponse, error)
+	VerifyQuorumSignature(ctx context.Context, in *QueryVerifyQuorumSignatureRequest, opts ...grpc.CallOption) (*QueryVerifyQuorumSignatureResponse, error)
+	SignerOperator(ctx context.Context, in *QuerySignerOperatorRequest, opts ...grpc.CallOption) (*QuerySignerOperatorResponse, error)
 }
 
-func (m *QueryQuorumCountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryQuorumCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QuorumCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QuorumCount))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryEpochQuorumRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryEpochQuorumRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryEpochQuorumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QuorumId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QuorumId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) EpochNumber(ctx context.Context, in *QueryEpochNumberRequest, opts ...grpc.CallOption) (*QueryEpochNumberResponse, error) {
+	out := new(QueryEpochNumberResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/EpochNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryEpochQuorumResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuorumCount(ctx context.Context, in *QueryQuorumCountRequest, opts ...grpc.CallOption) (*QueryQuorumCountResponse, error) {
+	out := new(QueryQuorumCountResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/QuorumCount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryEpochQuorumResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryEpochQuorumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Quorum != nil {
-		{
-			size, err := m.Quorum.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) EpochQuorum(ctx context.Context, in *QueryEpochQuorumRequest, opts ...grpc.CallOption) (*QueryEpochQuorumResponse, error) {
+	out := new(QueryEpochQuorumResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/EpochQuorum", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryEpochQuorumRowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EpochQuorumRow(ctx context.Context, in *QueryEpochQuorumRowRequest, opts ...grpc.CallOption) (*QueryEpochQuorumRowResponse, error) {
+	out := new(QueryEpochQuorumRowResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/EpochQuorumRow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryEpochQuorumRowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AggregatePubkeyG1(ctx context.Context, in *QueryAggregatePubkeyG1Request, opts ...grpc.CallOption) (*QueryAggregatePubkeyG1Response, error) {
+	out := new(QueryAggregatePubkeyG1Response)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/AggregatePubkeyG1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryEpochQuorumRowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RowIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RowIndex))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.QuorumId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QuorumId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Signer(ctx context.Context, in *QuerySignerRequest, opts ...grpc.CallOption) (*QuerySignerResponse, error) {
+	out := new(QuerySignerResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/Signer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryEpochQuorumRowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Signers(ctx context.Context, in *QuerySignersRequest, opts ...grpc.CallOption) (*QuerySignersResponse, error) {
+	out := new(QuerySignersResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/Signers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryEpochQuorumRowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EpochRegistrations(ctx context.Context, in *QueryEpochRegistrationsRequest, opts ...grpc.CallOption) (*QueryEpochRegistrationsResponse, error) {
+	out := new(QueryEpochRegistrationsResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/EpochRegistrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryEpochQuorumRowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) SignerEpochs(ctx context.Context, in *QuerySignerEpochsRequest, opts ...grpc.CallOption) (*QuerySignerEpochsResponse, error) {
+	out := new(QuerySignerEpochsResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/SignerEpochs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAggregatePubkeyG1Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EpochRandomness(ctx context.Context, in *QueryEpochRandomnessRequest, opts ...grpc.CallOption) (*QueryEpochRandomnessResponse, error) {
+	out := new(QueryEpochRandomnessResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/EpochRandomness", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAggregatePubkeyG1Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VrfKey(ctx context.Context, in *QueryVrfKeyRequest, opts ...grpc.CallOption) (*QueryVrfKeyResponse, error) {
+	out := new(QueryVrfKeyResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/VrfKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAggregatePubkeyG1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QuorumBitmap) > 0 {
-		i -= len(m.QuorumBitmap)
-		copy(dAtA[i:], m.QuorumBitmap)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuorumBitmap)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.QuorumId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QuorumId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) VerifyQuorumSignature(ctx context.Context, in *QueryVerifyQuorumSignatureRequest, opts ...grpc.CallOption) (*QueryVerifyQuorumSignatureResponse, error) {
+	out := new(QueryVerifyQuorumSignatureResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/VerifyQuorumSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAggregatePubkeyG1Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SignerOperator(ctx context.Context, in *QuerySignerOperatorRequest, opts ...grpc.CallOption) (*QuerySignerOperatorResponse, error) {
+	out := new(QuerySignerOperatorResponse)
+	err := c.cc.Invoke(ctx, "/zgc.dasigners.v1.Query/SignerOperator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAggregatePubkeyG1Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	EpochNumber(context.Context, *QueryEpochNumberRequest) (*QueryEpochNumberResponse, error)
+	QuorumCount(context.Context, *QueryQuorumCountRequest) (*QueryQuorumCountResponse, error)
+	EpochQuorum(context.Context, *QueryEpochQuorumRequest) (*QueryEpochQuorumResponse, error)
+	EpochQuorumRow(context.Context, *QueryEpochQuorumRowRequest) (*QueryEpochQuorumRowResponse, error)
+	AggregatePubkeyG1(context.Context, *QueryAggregatePubkeyG1Request) (*QueryAggregatePubkeyG1Response, error)
+	Signer(context.Context, *QuerySignerRequest) (*QuerySignerResponse, error)
+	Signers(context.Context, *QuerySignersRequest) (*QuerySignersResponse, error)
+	EpochRegistrations(context.Context, *QueryEpochRegistrationsRequest) (*QueryEpochRegistrationsResponse, error)
+	SignerEpochs(context.Context, *QuerySignerEpochsRequest) (*QuerySignerEpochsResponse, error)
+	EpochRandomness(context.Context, *QueryEpochRandomnessRequest) (*QueryEpochRandomnessResponse, error)
+	VrfKey(context.Context, *QueryVrfKeyRequest) (*QueryVrfKeyResponse, error)
+	VerifyQuorumSignature(context.Context, *QueryVerifyQuorumSignatureRequest) (*QueryVerifyQuorumSignatureResponse, error)
+	SignerOperator(context.Context, *QuerySignerOperatorRequest) (*QuerySignerOperatorResponse, error)
 }
 
-func (m *QueryAggregatePubkeyG1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Hit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Hit))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Total != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.AggregatePubkeyG1) > 0 {
-		i -= len(m.AggregatePubkeyG1)
-		copy(dAtA[i:], m.AggregatePubkeyG1)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AggregatePubkeyG1)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) EpochNumber(ctx context.Context, req *QueryEpochNumberRequest) (*QueryEpochNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochNumber not implemented")
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) QuorumCount(ctx context.Context, req *QueryQuorumCountRequest) (*QueryQuorumCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuorumCount not implemented")
 }
-
-func (m *QuerySignerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, s := range m.Accounts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedQueryServer) EpochQuorum(ctx context.Context, req *QueryEpochQuorumRequest) (*QueryEpochQuorumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochQuorum not implemented")
+}
+func (*UnimplementedQueryServer) EpochQuorumRow(ctx context.Context, req *QueryEpochQuorumRowRequest) (*QueryEpochQuorumRowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochQuorumRow not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePubkeyG1(ctx context.Context, req *QueryAggregatePubkeyG1Request) (*QueryAggregatePubkeyG1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePubkeyG1 not implemented")
+}
+func (*UnimplementedQueryServer) Signer(ctx context.Context, req *QuerySignerRequest) (*QuerySignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signer not implemented")
+}
+func (*UnimplementedQueryServer) Signers(ctx context.Context, req *QuerySignersRequest) (*QuerySignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signers not implemented")
+}
+func (*UnimplementedQueryServer) EpochRegistrations(ctx context.Context, req *QueryEpochRegistrationsRequest) (*QueryEpochRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochRegistrations not implemented")
+}
+func (*UnimplementedQueryServer) SignerEpochs(ctx context.Context, req *QuerySignerEpochsRequest) (*QuerySignerEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerEpochs not implemented")
+}
+func (*UnimplementedQueryServer) EpochRandomness(ctx context.Context, req *QueryEpochRandomnessRequest) (*QueryEpochRandomnessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochRandomness not implemented")
+}
+func (*UnimplementedQueryServer) VrfKey(ctx context.Context, req *QueryVrfKeyRequest) (*QueryVrfKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VrfKey not implemented")
+}
+func (*UnimplementedQueryServer) VerifyQuorumSignature(ctx context.Context, req *QueryVerifyQuorumSignatureRequest) (*QueryVerifyQuorumSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyQuorumSignature not implemented")
+}
+func (*UnimplementedQueryServer) SignerOperator(ctx context.Context, req *QuerySignerOperatorRequest) (*QuerySignerOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerOperator not implemented")
 }
 
-func (m *QuerySignerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		for _, e := range m.Signer {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEpochNumberRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EpochNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).EpochNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/EpochNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochNumber(ctx, req.(*QueryEpochNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEpochNumberResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QuorumCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuorumCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+	if interceptor == nil {
+		return srv.(QueryServer).QuorumCount(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/QuorumCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuorumCount(ctx, req.(*QueryQuorumCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryQuorumCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EpochQuorum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochQuorumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+	if interceptor == nil {
+		return srv.(QueryServer).EpochQuorum(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/EpochQuorum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochQuorum(ctx, req.(*QueryEpochQuorumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryQuorumCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EpochQuorumRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochQuorumRowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.QuorumCount != 0 {
-		n += 1 + sovQuery(uint64(m.QuorumCount))
+	if interceptor == nil {
+		return srv.(QueryServer).EpochQuorumRow(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/EpochQuorumRow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochQuorumRow(ctx, req.(*QueryEpochQuorumRowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEpochQuorumRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AggregatePubkeyG1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePubkeyG1Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePubkeyG1(ctx, in)
 	}
-	if m.QuorumId != 0 {
-		n += 1 + sovQuery(uint64(m.QuorumId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/AggregatePubkeyG1",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePubkeyG1(ctx, req.(*QueryAggregatePubkeyG1Request))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEpochQuorumResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Signer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Quorum != nil {
-		l = m.Quorum.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Signer(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/Signer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Signer(ctx, req.(*QuerySignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEpochQuorumRowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Signers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+	if interceptor == nil {
+		return srv.(QueryServer).Signers(ctx, in)
 	}
-	if m.QuorumId != 0 {
-		n += 1 + sovQuery(uint64(m.QuorumId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/Signers",
 	}
-	if m.RowIndex != 0 {
-		n += 1 + sovQuery(uint64(m.RowIndex))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Signers(ctx, req.(*QuerySignersRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEpochQuorumRowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EpochRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EpochRegistrations(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/EpochRegistrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochRegistrations(ctx, req.(*QueryEpochRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregatePubkeyG1Request) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SignerEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignerEpochsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+	if interceptor == nil {
+		return srv.(QueryServer).SignerEpochs(ctx, in)
 	}
-	if m.QuorumId != 0 {
-		n += 1 + sovQuery(uint64(m.QuorumId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/SignerEpochs",
 	}
-	l = len(m.QuorumBitmap)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerEpochs(ctx, req.(*QuerySignerEpochsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregatePubkeyG1Response) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EpochRandomness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochRandomnessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.AggregatePubkeyG1)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EpochRandomness(ctx, in)
 	}
-	if m.Total != 0 {
-		n += 1 + sovQuery(uint64(m.Total))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/EpochRandomness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochRandomness(ctx, req.(*QueryEpochRandomnessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VrfKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVrfKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VrfKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/VrfKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VrfKey(ctx, req.(*QueryVrfKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyQuorumSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyQuorumSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyQuorumSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/VerifyQuorumSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyQuorumSignature(ctx, req.(*QueryVerifyQuorumSignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignerOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.dasigners.v1.Query/SignerOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerOperator(ctx, req.(*QuerySignerOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zgc.dasigners.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EpochNumber",
+			Handler:    _Query_EpochNumber_Handler,
+		},
+		{
+			MethodName: "QuorumCount",
+			Handler:    _Query_QuorumCount_Handler,
+		},
+		{
+			MethodName: "EpochQuorum",
+			Handler:    _Query_EpochQuorum_Handler,
+		},
+		{
+			MethodName: "EpochQuorumRow",
+			Handler:    _Query_EpochQuorumRow_Handler,
+		},
+		{
+			MethodName: "AggregatePubkeyG1",
+			Handler:    _Query_AggregatePubkeyG1_Handler,
+		},
+		{
+			MethodName: "Signer",
+			Handler:    _Query_Signer_Handler,
+		},
+		{
+			MethodName: "Signers",
+			Handler:    _Query_Signers_Handler,
+		},
+		{
+			MethodName: "EpochRegistrations",
+			Handler:    _Query_EpochRegistrations_Handler,
+		},
+		{
+			MethodName: "SignerEpochs",
+			Handler:    _Query_SignerEpochs_Handler,
+		},
+		{
+			MethodName: "EpochRandomness",
+			Handler:    _Query_EpochRandomness_Handler,
+		},
+		{
+			MethodName: "VrfKey",
+			Handler:    _Query_VrfKey_Handler,
+		},
+		{
+			MethodName: "VerifyQuorumSignature",
+			Handler:    _Query_VerifyQuorumSignature_Handler,
+		},
+		{
+			MethodName: "SignerOperator",
+			Handler:    _Query_SignerOperator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "zgc/dasigners/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		for iNdEx := len(m.Signer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochRegistrationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochRegistrationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochRegistrationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochRegistrationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochRegistrationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochRegistrationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Registrations) > 0 {
+		for iNdEx := len(m.Registrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Registrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerEpochsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerEpochsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerEpochsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Epochs) > 0 {
+		dAtA9 := make([]byte, len(m.Epochs)*10)
+		var j8 int
+		for _, num := range m.Epochs {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintQuery(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochRandomnessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochRandomnessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochRandomnessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochRandomnessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochRandomnessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochRandomnessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Randomness) > 0 {
+		i -= len(m.Randomness)
+		copy(dAtA[i:], m.Randomness)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Randomness)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVrfKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVrfKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVrfKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVrfKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVrfKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVrfKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VrfKey != nil {
+		{
+			size, err := m.VrfKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochNumberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochNumberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochNumberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochNumberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochNumberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochNumberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuorumCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuorumCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuorumCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuorumCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuorumCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuorumCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuorumCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuorumCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochQuorumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochQuorumRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochQuorumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuorumId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuorumId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochQuorumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochQuorumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochQuorumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quorum != nil {
+		{
+			size, err := m.Quorum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochQuorumRowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochQuorumRowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochQuorumRowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RowIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RowIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QuorumId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuorumId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochQuorumRowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochQuorumRowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochQuorumRowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePubkeyG1Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePubkeyG1Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePubkeyG1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuorumBitmap) > 0 {
+		i -= len(m.QuorumBitmap)
+		copy(dAtA[i:], m.QuorumBitmap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuorumBitmap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.QuorumId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuorumId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePubkeyG1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePubkeyG1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePubkeyG1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Hit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AggregatePubkeyG1) > 0 {
+		i -= len(m.AggregatePubkeyG1)
+		copy(dAtA[i:], m.AggregatePubkeyG1)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AggregatePubkeyG1)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyQuorumSignatureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyQuorumSignatureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyQuorumSignatureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatePubkeyG2) > 0 {
+		i -= len(m.AggregatePubkeyG2)
+		copy(dAtA[i:], m.AggregatePubkeyG2)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AggregatePubkeyG2)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AggregateSignature) > 0 {
+		i -= len(m.AggregateSignature)
+		copy(dAtA[i:], m.AggregateSignature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AggregateSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MessageHash) > 0 {
+		i -= len(m.MessageHash)
+		copy(dAtA[i:], m.MessageHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QuorumBitmap) > 0 {
+		i -= len(m.QuorumBitmap)
+		copy(dAtA[i:], m.QuorumBitmap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuorumBitmap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.QuorumId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuorumId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyQuorumSignatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyQuorumSignatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyQuorumSignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Hit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		for _, e := range m.Signer {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySignersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochRegistrationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochRegistrationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Registrations) > 0 {
+		for _, e := range m.Registrations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignerEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignerEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		l = 0
+		for _, e := range m.Epochs {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochRandomnessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryEpochRandomnessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Randomness)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVrfKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVrfKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VrfKey != nil {
+		l = m.VrfKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignerOperatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignerOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochNumberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochNumberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryQuorumCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryQuorumCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QuorumCount != 0 {
+		n += 1 + sovQuery(uint64(m.QuorumCount))
+	}
+	return n
+}
+
+func (m *QueryEpochQuorumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.QuorumId != 0 {
+		n += 1 + sovQuery(uint64(m.QuorumId))
+	}
+	return n
+}
+
+func (m *QueryEpochQuorumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochQuorumRowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.QuorumId != 0 {
+		n += 1 + sovQuery(uint64(m.QuorumId))
+	}
+	if m.RowIndex != 0 {
+		n += 1 + sovQuery(uint64(m.RowIndex))
+	}
+	return n
+}
+
+func (m *QueryEpochQuorumRowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePubkeyG1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.QuorumId != 0 {
+		n += 1 + sovQuery(uint64(m.QuorumId))
+	}
+	l = len(m.QuorumBitmap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePubkeyG1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AggregatePubkeyG1)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	if m.Hit != 0 {
+		n += 1 + sovQuery(uint64(m.Hit))
+	}
+	return n
+}
+
+func (m *QueryVerifyQuorumSignatureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.QuorumId != 0 {
+		n += 1 + sovQuery(uint64(m.QuorumId))
+	}
+	l = len(m.QuorumBitmap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MessageHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AggregateSignature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AggregatePubkeyG2)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyQuorumSignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	if m.Hit != 0 {
+		n += 1 + sovQuery(uint64(m.Hit))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer, &Signer{})
+			if err := m.Signer[len(m.Signer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &Signer{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochRegistrationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochRegistrationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochRegistrationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochRegistrationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochRegistrationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochRegistrationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrations = append(m.Registrations, &Registration{})
+			if err := m.Registrations[len(m.Registrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignerEpochsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignerEpochsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignerEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.Hit != 0 {
-		n += 1 + sovQuery(uint64(m.Hit))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignerEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignerEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignerEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Epochs = append(m.Epochs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Epochs) == 0 {
+					m.Epochs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Epochs = append(m.Epochs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochRandomnessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochRandomnessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochRandomnessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochRandomnessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochRandomnessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochRandomnessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Randomness", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Randomness = append(m.Randomness[:0], dAtA[iNdEx:postIndex]...)
+			if m.Randomness == nil {
+				m.Randomness = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVrfKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1608,12 +4402,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVrfKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVrfKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1635,7 +4461,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVrfKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1658,15 +4484,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVrfKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVrfKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VrfKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1693,10 +4519,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			if m.VrfKey == nil {
+				m.VrfKey = &SignerVrfKey{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VrfKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1721,7 +4547,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignerRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignerOperatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1744,15 +4570,15 @@ func (m *QuerySignerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignerOperatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignerOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1780,7 +4606,7 @@ func (m *QuerySignerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1803,7 +4629,7 @@ func (m *QuerySignerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignerResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignerOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1826,17 +4652,17 @@ func (m *QuerySignerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignerOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignerOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1846,25 +4672,23 @@ func (m *QuerySignerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = append(m.Signer, &Signer{})
-			if err := m.Signer[len(m.Signer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2144,7 +4968,181 @@ func (m *QueryQuorumCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochQuorumRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochQuorumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochQuorumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochQuorumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumId", wireType)
+			}
+			m.QuorumId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochQuorumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochQuorumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochQuorumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quorum == nil {
+				m.Quorum = &Quorum{}
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochQuorumRowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2167,10 +5165,10 @@ func (m *QueryEpochQuorumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochQuorumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochQuorumRowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochQuorumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochQuorumRowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2211,6 +5209,25 @@ func (m *QueryEpochQuorumRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowIndex", wireType)
+			}
+			m.RowIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2232,7 +5249,7 @@ func (m *QueryEpochQuorumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochQuorumResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochQuorumRowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2255,17 +5272,17 @@ func (m *QueryEpochQuorumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochQuorumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochQuorumRowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochQuorumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochQuorumRowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2275,27 +5292,23 @@ func (m *QueryEpochQuorumResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Quorum == nil {
-				m.Quorum = &Quorum{}
-			}
-			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2318,7 +5331,7 @@ func (m *QueryEpochQuorumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochQuorumRowRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePubkeyG1Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2341,10 +5354,10 @@ func (m *QueryEpochQuorumRowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochQuorumRowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePubkeyG1Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochQuorumRowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePubkeyG1Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2386,10 +5399,10 @@ func (m *QueryEpochQuorumRowRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumBitmap", wireType)
 			}
-			m.RowIndex = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2399,11 +5412,26 @@ func (m *QueryEpochQuorumRowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RowIndex |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumBitmap = append(m.QuorumBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.QuorumBitmap == nil {
+				m.QuorumBitmap = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2425,7 +5453,7 @@ func (m *QueryEpochQuorumRowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochQuorumRowResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePubkeyG1Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2448,17 +5476,17 @@ func (m *QueryEpochQuorumRowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochQuorumRowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePubkeyG1Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochQuorumRowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePubkeyG1Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePubkeyG1", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2468,24 +5496,64 @@ func (m *QueryEpochQuorumRowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.AggregatePubkeyG1 = append(m.AggregatePubkeyG1[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatePubkeyG1 == nil {
+				m.AggregatePubkeyG1 = []byte{}
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
+			}
+			m.Hit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2507,7 +5575,7 @@ func (m *QueryEpochQuorumRowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePubkeyG1Request) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyQuorumSignatureRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2530,10 +5598,10 @@ func (m *QueryAggregatePubkeyG1Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePubkeyG1Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyQuorumSignatureRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePubkeyG1Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyQuorumSignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2608,6 +5676,108 @@ func (m *QueryAggregatePubkeyG1Request) Unmarshal(dAtA []byte) error {
 				m.QuorumBitmap = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageHash = append(m.MessageHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageHash == nil {
+				m.MessageHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateSignature = append(m.AggregateSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregateSignature == nil {
+				m.AggregateSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePubkeyG2", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatePubkeyG2 = append(m.AggregatePubkeyG2[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatePubkeyG2 == nil {
+				m.AggregatePubkeyG2 = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2629,7 +5799,7 @@ func (m *QueryAggregatePubkeyG1Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePubkeyG1Response) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyQuorumSignatureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2652,17 +5822,17 @@ func (m *QueryAggregatePubkeyG1Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePubkeyG1Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyQuorumSignatureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePubkeyG1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyQuorumSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePubkeyG1", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2672,26 +5842,12 @@ func (m *QueryAggregatePubkeyG1Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AggregatePubkeyG1 = append(m.AggregatePubkeyG1[:0], dAtA[iNdEx:postIndex]...)
-			if m.AggregatePubkeyG1 == nil {
-				m.AggregatePubkeyG1 = []byte{}
-			}
-			iNdEx = postIndex
+			m.Valid = bool(v != 0)
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
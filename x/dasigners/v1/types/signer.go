@@ -29,6 +29,25 @@ func (s *Signer) Validate() error {
 	if err := ValidateHexAddress(s.Account); err != nil {
 		return err
 	}
+	// signers registered before the introduction of endpoints may carry a malformed legacy socket
+	if len(s.Endpoints) > 0 {
+		if err := validateSocketAndEndpoints(s.Socket, s.Endpoints); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *SignerKeyHistory) Validate() error {
+	if len(h.PubkeyG1) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid G1 pubkey length")
+	}
+	if len(h.PubkeyG2) != bn254util.G2PointSize {
+		return fmt.Errorf("invalid G2 pubkey length")
+	}
+	if err := ValidateHexAddress(h.Account); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -53,3 +72,11 @@ func (s *Signer) ValidateSignature(hash *bn254.G1Affine, signature *bn254.G1Affi
 	}
 	return ok
 }
+
+// PubkeyAt returns the VRF public key effective for the registrations of the given epoch
+func (k *SignerVrfKey) PubkeyAt(epoch uint64) []byte {
+	if epoch < k.EffectiveEpoch {
+		return k.PreviousPubkey
+	}
+	return k.Pubkey
+}